@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -209,6 +210,29 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	return 0, false
 }
 
+// FieldExecution describes how the field of the object typeName is executed, see graphql.ExecutionDescriber.
+func (e *executableSchema) FieldExecution(typeName, field string) (graphql.FieldExecution, bool) {
+	switch typeName + "." + field {
+	case "Mutation.createTodo":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}, true
+	case "Query.todos":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Todo.id":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "User.name":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "role.name":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	}
+	if strings.HasPrefix(typeName, "__") || strings.HasPrefix(field, "__") {
+		return graphql.FieldExecution{}, false
+	}
+	if def := e.Schema().Types[typeName]; def != nil && def.Kind == ast.Object && def.Fields.ForName(field) != nil {
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField}, true
+	}
+	return graphql.FieldExecution{}, false
+}
+
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
@@ -328,6 +352,23 @@ var sources = []*ast.Source{
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
+// SchemaSDL returns the source of the schema the package was generated from, including the sources injected by
+// plugins, so it can be served as is whether or not introspection is enabled.
+func SchemaSDL() string {
+	sdl := make([]string, len(sources))
+	for i, src := range sources {
+		sdl[i] = src.Input
+	}
+	return strings.Join(sdl, "\n")
+}
+
+// SchemaHash returns the hex encoded sha256 of SchemaSDL, computed when the package was generated. It changes
+// whenever the schema does, so it can be logged on startup or compared with the schema a persisted operation
+// manifest was built against.
+func SchemaHash() string {
+	return "ebc4b2439abfa733f17a2ba9260a77f417649e3b9d9d003f3a132290feadc2ef"
+}
+
 // endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -230,6 +231,31 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	return 0, false
 }
 
+// FieldExecution describes how the field of the object typeName is executed, see graphql.ExecutionDescriber.
+func (e *executableSchema) FieldExecution(typeName, field string) (graphql.FieldExecution, bool) {
+	switch typeName + "." + field {
+	case "Customer.address":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Customer.orders":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Order.items":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.customers":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.torture1d":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.torture2d":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	}
+	if strings.HasPrefix(typeName, "__") || strings.HasPrefix(field, "__") {
+		return graphql.FieldExecution{}, false
+	}
+	if def := e.Schema().Types[typeName]; def != nil && def.Kind == ast.Object && def.Fields.ForName(field) != nil {
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField}, true
+	}
+	return graphql.FieldExecution{}, false
+}
+
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
@@ -330,6 +356,23 @@ var sources = []*ast.Source{
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
+// SchemaSDL returns the source of the schema the package was generated from, including the sources injected by
+// plugins, so it can be served as is whether or not introspection is enabled.
+func SchemaSDL() string {
+	sdl := make([]string, len(sources))
+	for i, src := range sources {
+		sdl[i] = src.Input
+	}
+	return strings.Join(sdl, "\n")
+}
+
+// SchemaHash returns the hex encoded sha256 of SchemaSDL, computed when the package was generated. It changes
+// whenever the schema does, so it can be logged on startup or compared with the schema a persisted operation
+// manifest was built against.
+func SchemaHash() string {
+	return "a17e986e2c365c7282068b787da6fa916ceb4b655640c049619a5d09caa01ff7"
+}
+
 // endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
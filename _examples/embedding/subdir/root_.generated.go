@@ -8,6 +8,7 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -111,6 +112,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	return 0, false
 }
 
+// FieldExecution describes how the field of the object typeName is executed, see graphql.ExecutionDescriber.
+func (e *executableSchema) FieldExecution(typeName, field string) (graphql.FieldExecution, bool) {
+	switch typeName + "." + field {
+	case "Query.inSchemadir":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.parentdir":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.subdir":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query._service":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod, Concurrent: true}, true
+	}
+	if strings.HasPrefix(typeName, "__") || strings.HasPrefix(field, "__") {
+		return graphql.FieldExecution{}, false
+	}
+	if def := e.Schema().Types[typeName]; def != nil && def.Kind == ast.Object && def.Fields.ForName(field) != nil {
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField}, true
+	}
+	return graphql.FieldExecution{}, false
+}
+
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
@@ -233,3 +255,20 @@ extend type Query {
 `, BuiltIn: true},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// SchemaSDL returns the source of the schema the package was generated from, including the sources injected by
+// plugins, so it can be served as is whether or not introspection is enabled.
+func SchemaSDL() string {
+	sdl := make([]string, len(sources))
+	for i, src := range sources {
+		sdl[i] = src.Input
+	}
+	return strings.Join(sdl, "\n")
+}
+
+// SchemaHash returns the hex encoded sha256 of SchemaSDL, computed when the package was generated. It changes
+// whenever the schema does, so it can be logged on startup or compared with the schema a persisted operation
+// manifest was built against.
+func SchemaHash() string {
+	return "c534449a0145ff9cd6c82d357c1e4ca4ce0b04d3c0ceac2a53026372f403cf60"
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 
@@ -285,6 +286,49 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	return 0, false
 }
 
+// FieldExecution describes how the field of the object typeName is executed, see graphql.ExecutionDescriber.
+func (e *executableSchema) FieldExecution(typeName, field string) (graphql.FieldExecution, bool) {
+	switch typeName + "." + field {
+	case "Query.intTyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.intUntyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.intTypedN":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.intUntypedN":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.stringTyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.stringUntyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.stringTypedN":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.stringUntypedN":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.boolTyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.boolUntyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.boolTypedN":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.boolUntypedN":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.varTyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.varUntyped":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.inPackage":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	}
+	if strings.HasPrefix(typeName, "__") || strings.HasPrefix(field, "__") {
+		return graphql.FieldExecution{}, false
+	}
+	if def := e.Schema().Types[typeName]; def != nil && def.Kind == ast.Object && def.Fields.ForName(field) != nil {
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField}, true
+	}
+	return graphql.FieldExecution{}, false
+}
+
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
@@ -449,6 +493,23 @@ enum InPackage {
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
 
+// SchemaSDL returns the source of the schema the package was generated from, including the sources injected by
+// plugins, so it can be served as is whether or not introspection is enabled.
+func SchemaSDL() string {
+	sdl := make([]string, len(sources))
+	for i, src := range sources {
+		sdl[i] = src.Input
+	}
+	return strings.Join(sdl, "\n")
+}
+
+// SchemaHash returns the hex encoded sha256 of SchemaSDL, computed when the package was generated. It changes
+// whenever the schema does, so it can be logged on startup or compared with the schema a persisted operation
+// manifest was built against.
+func SchemaHash() string {
+	return "d638f8ed98afca29a974141623bc88bdc03ce48adc692a6eff2d05a650c35c60"
+}
+
 // endregion ************************** generated!.gotpl **************************
 
 // region    ***************************** args.gotpl *****************************
@@ -5112,4 +5173,139 @@ func (ec *executionContext) marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgen
 	return ec.___Type(ctx, sel, v)
 }
 
+// AllBoolTyped lists the go values bound to the BoolTyped enum, in schema order.
+var AllBoolTyped = []model.BoolTyped{
+	model.BoolTypedTrue,
+	model.BoolTypedFalse,
+}
+
+// IsValidBoolTyped reports whether v is bound to a value of the BoolTyped enum.
+func IsValidBoolTyped(v model.BoolTyped) bool {
+	switch v {
+	case model.BoolTypedTrue, model.BoolTypedFalse:
+		return true
+	}
+	return false
+}
+
+// AllBoolUntyped lists the go values bound to the BoolUntyped enum, in schema order.
+var AllBoolUntyped = []bool{
+	model.BoolUntypedTrue,
+	model.BoolUntypedFalse,
+}
+
+// IsValidBoolUntyped reports whether v is bound to a value of the BoolUntyped enum.
+func IsValidBoolUntyped(v bool) bool {
+	switch v {
+	case model.BoolUntypedTrue, model.BoolUntypedFalse:
+		return true
+	}
+	return false
+}
+
+// AllInPackage lists the go values bound to the InPackage enum, in schema order.
+var AllInPackage = []InPackage{
+	InPackageTrue,
+	InPackageFalse,
+}
+
+// IsValidInPackage reports whether v is bound to a value of the InPackage enum.
+func IsValidInPackage(v InPackage) bool {
+	switch v {
+	case InPackageTrue, InPackageFalse:
+		return true
+	}
+	return false
+}
+
+// AllIntTyped lists the go values bound to the IntTyped enum, in schema order.
+var AllIntTyped = []model.IntTyped{
+	model.IntTypedOne,
+	model.IntTypedTwo,
+}
+
+// IsValidIntTyped reports whether v is bound to a value of the IntTyped enum.
+func IsValidIntTyped(v model.IntTyped) bool {
+	switch v {
+	case model.IntTypedOne, model.IntTypedTwo:
+		return true
+	}
+	return false
+}
+
+// AllIntUntyped lists the go values bound to the IntUntyped enum, in schema order.
+var AllIntUntyped = []int{
+	model.IntUntypedOne,
+	model.IntUntypedTwo,
+}
+
+// IsValidIntUntyped reports whether v is bound to a value of the IntUntyped enum.
+func IsValidIntUntyped(v int) bool {
+	switch v {
+	case model.IntUntypedOne, model.IntUntypedTwo:
+		return true
+	}
+	return false
+}
+
+// AllStringTyped lists the go values bound to the StringTyped enum, in schema order.
+var AllStringTyped = []model.StringTyped{
+	model.StringTypedOne,
+	model.StringTypedTwo,
+}
+
+// IsValidStringTyped reports whether v is bound to a value of the StringTyped enum.
+func IsValidStringTyped(v model.StringTyped) bool {
+	switch v {
+	case model.StringTypedOne, model.StringTypedTwo:
+		return true
+	}
+	return false
+}
+
+// AllStringUntyped lists the go values bound to the StringUntyped enum, in schema order.
+var AllStringUntyped = []string{
+	model.StringUntypedOne,
+	model.StringUntypedTwo,
+}
+
+// IsValidStringUntyped reports whether v is bound to a value of the StringUntyped enum.
+func IsValidStringUntyped(v string) bool {
+	switch v {
+	case model.StringUntypedOne, model.StringUntypedTwo:
+		return true
+	}
+	return false
+}
+
+// AllVarTyped lists the go values bound to the VarTyped enum, in schema order.
+var AllVarTyped = []model.VarTyped{
+	model.VarTypedTrue,
+	model.VarTypedFalse,
+}
+
+// IsValidVarTyped reports whether v is bound to a value of the VarTyped enum.
+func IsValidVarTyped(v model.VarTyped) bool {
+	switch v {
+	case model.VarTypedTrue, model.VarTypedFalse:
+		return true
+	}
+	return false
+}
+
+// AllVarUntyped lists the go values bound to the VarUntyped enum, in schema order.
+var AllVarUntyped = []bool{
+	model.VarUntypedTrue,
+	model.VarUntypedFalse,
+}
+
+// IsValidVarUntyped reports whether v is bound to a value of the VarUntyped enum.
+func IsValidVarUntyped(v bool) bool {
+	switch v {
+	case model.VarUntypedTrue, model.VarUntypedFalse:
+		return true
+	}
+	return false
+}
+
 // endregion ***************************** type.gotpl *****************************
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/vektah/gqlparser/v2/formatter"
+	"gopkg.in/yaml.v3"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/plugin"
+)
+
+// inputsHash returns the key the exec files of cfg are cached under with build_cache: the hash of the gqlgen version,
+// the plugins, the config, the schema and the types of the loaded packages, everything the data of the exec templates
+// is built from. It is empty when the config can't be hashed.
+func inputsHash(cfg *config.Config, plugins []plugin.Plugin) string {
+	return hashInputs(cfg, plugins, true)
+}
+
+// fileInputsHash is inputsHash without the schema. The files of the follow-schema layout add the definitions they are
+// built from to it, see codegen.Data.FileCacheKey.
+func fileInputsHash(cfg *config.Config, plugins []plugin.Plugin) string {
+	return hashInputs(cfg, plugins, false)
+}
+
+func hashInputs(cfg *config.Config, plugins []plugin.Plugin, schema bool) string {
+	b, err := yaml.Marshal(cfg)
+	if err != nil {
+		return ""
+	}
+
+	h := sha256.New()
+	write := func(s string) {
+		_, _ = io.WriteString(h, s)
+		_, _ = h.Write([]byte{0})
+	}
+	write(graphql.Version)
+	for _, p := range plugins {
+		write(p.Name())
+	}
+	write(string(b))
+	if schema {
+		for _, src := range cfg.Sources {
+			write(src.Name)
+			write(src.Input)
+		}
+		formatter.NewFormatter(h, formatter.WithComments()).FormatSchema(cfg.Schema)
+	}
+	write(cfg.Packages.Fingerprint())
+	return hex.EncodeToString(h.Sum(nil))
+}
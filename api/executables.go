@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin"
+)
+
+// generateExecutables generates the models of the types of every schema in cfg at once, then an executable schema
+// for each of them, all bound to the same models.
+func generateExecutables(cfg *config.Config, plugins []plugin.Plugin) error {
+	exec := cfg.Exec
+	// the models include the elements of every visibility scope
+	exec.VisibilityScope = ""
+	models := cfg.ForExecutable(config.ExecutableConfig{
+		Sources: cfg.AllSources(),
+		Exec:    exec,
+	}, cfg.Models)
+
+	// loading the main schema checks the config of the executables before anything is generated
+	if err := cfg.LoadSchema(); err != nil {
+		return fmt.Errorf("failed to load schema: %w", err)
+	}
+
+	if err := prepare(models, plugins); err != nil {
+		return fmt.Errorf("generating models: %w", err)
+	}
+
+	configs := []*config.Config{cfg}
+	for _, e := range cfg.Executables {
+		_ = os.Remove(e.Exec.Filename)
+		configs = append(configs, cfg.ForExecutable(e, models.Models))
+	}
+	cfg.Models = models.Models.Copy()
+
+	for i, c := range configs {
+		if err := generate(c, plugins); err != nil {
+			if i == 0 {
+				return err
+			}
+			return fmt.Errorf("executables[%d]: %w", i-1, err)
+		}
+	}
+	return nil
+}
@@ -4,15 +4,29 @@ import (
 	"fmt"
 	"regexp"
 	"syscall"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/codegen"
 	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/internal/code"
 	"github.com/99designs/gqlgen/plugin"
+	"github.com/99designs/gqlgen/plugin/docgen"
 	"github.com/99designs/gqlgen/plugin/federation"
+	"github.com/99designs/gqlgen/plugin/lint"
 	"github.com/99designs/gqlgen/plugin/modelgen"
 	"github.com/99designs/gqlgen/plugin/resolvergen"
 )
 
+const (
+	// buildCacheMaxAge is how long build_cache keeps the files it did not use.
+	buildCacheMaxAge = 7 * 24 * time.Hour
+	// loadCacheMaxAge is how long load_cache keeps the packages it did not use.
+	loadCacheMaxAge = 30 * 24 * time.Hour
+)
+
 var (
 	urlRegex     = regexp.MustCompile(`(?s)@link.*\(.*url:.*?"(.*?)"[^)]+\)`) // regex to grab the url of a link directive, should it exist
 	versionRegex = regexp.MustCompile(`v(\d+).(\d+)$`)                        // regex to grab the version number from a url
@@ -24,6 +38,83 @@ func Generate(cfg *config.Config, option ...Option) error {
 		_ = syscall.Unlink(cfg.Model.Filename)
 	}
 
+	plugins := defaultPlugins(cfg)
+	for _, o := range option {
+		o(cfg, &plugins)
+	}
+
+	var err error
+	if len(cfg.Executables) > 0 {
+		err = generateExecutables(cfg, plugins)
+	} else {
+		err = generate(cfg, plugins)
+	}
+	if cfg.BuildCache {
+		_ = templates.PruneCache(cfg.BuildCachePath(), buildCacheMaxAge)
+	}
+	if dir := code.DefaultCacheDir(); cfg.LoadCache && dir != "" {
+		_ = code.PruneCache(dir, loadCacheMaxAge)
+	}
+	return err
+}
+
+// BuildData builds the data Generate would generate code from, without writing anything. Instead of generating the
+// models again, the types modelgen generates are bound to the models written by the last generate.
+func BuildData(cfg *config.Config, option ...Option) (*codegen.Data, error) {
+	plugins := defaultPlugins(cfg)
+	for _, o := range option {
+		o(cfg, &plugins)
+	}
+
+	kept := make([]plugin.Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		switch p.(type) {
+		case *modelgen.Plugin, *lint.Plugin:
+		default:
+			kept = append(kept, p)
+		}
+	}
+	if err := prepare(cfg, kept); err != nil {
+		return nil, err
+	}
+	if cfg.Model.IsDefined() {
+		bindGeneratedModels(cfg)
+	}
+	dataPlugins := make([]interface{}, len(kept))
+	for i := range kept {
+		dataPlugins[i] = kept[i]
+	}
+	data, err := codegen.BuildData(cfg, dataPlugins...)
+	if err != nil {
+		return nil, fmt.Errorf("merging type systems failed: %w", err)
+	}
+	return data, nil
+}
+
+// bindGeneratedModels binds the types modelgen generates a model for to the model of the same name in the model package.
+func bindGeneratedModels(cfg *config.Config) {
+	for _, def := range cfg.Schema.Types {
+		if cfg.Models.UserDefined(def.Name) {
+			continue
+		}
+		switch def.Kind {
+		case ast.Object, ast.InputObject:
+			if cfg.IsRoot(def) && cfg.OmitRootModels {
+				continue
+			}
+			cfg.Models.Add(def.Name, cfg.Model.ImportPath()+"."+templates.ToGo(def.Name))
+		case ast.Interface, ast.Union, ast.Enum:
+			cfg.Models.Add(def.Name, cfg.Model.ImportPath()+"."+templates.ToGo(def.Name))
+		case ast.Scalar:
+			if cfg.Models[def.Name].MapValue == "" {
+				cfg.Models.Add(def.Name, "github.com/99designs/gqlgen/graphql.String")
+			}
+		}
+	}
+}
+
+// defaultPlugins returns the plugins generating with cfg runs, before options are applied.
+func defaultPlugins(cfg *config.Config) []plugin.Plugin {
 	plugins := []plugin.Plugin{}
 	if cfg.Model.IsDefined() {
 		plugins = append(plugins, modelgen.New())
@@ -47,11 +138,17 @@ func Generate(cfg *config.Config, option ...Option) error {
 		}
 		plugins = append([]plugin.Plugin{federation.New(cfg.Federation.Version)}, plugins...)
 	}
-
-	for _, o := range option {
-		o(cfg, &plugins)
+	if cfg.Lint.IsDefined() {
+		plugins = append([]plugin.Plugin{lint.New()}, plugins...)
+	}
+	if cfg.Docs.IsDefined() {
+		plugins = append(plugins, docgen.New())
 	}
+	return plugins
+}
 
+// prepare loads the schema of cfg, with the sources injected by plugins, and lets plugins mutate the config.
+func prepare(cfg *config.Config, plugins []plugin.Plugin) error {
 	for _, p := range plugins {
 		if inj, ok := p.(plugin.EarlySourceInjector); ok {
 			if s := inj.InjectSourceEarly(); s != nil {
@@ -89,6 +186,15 @@ func Generate(cfg *config.Config, option ...Option) error {
 			}
 		}
 	}
+
+	return nil
+}
+
+func generate(cfg *config.Config, plugins []plugin.Plugin) error {
+	if err := prepare(cfg, plugins); err != nil {
+		return err
+	}
+
 	// Merge again now that the generated models have been injected into the typemap
 	data_plugins := make([]interface{}, len(plugins))
 	for index := range plugins {
@@ -98,6 +204,10 @@ func Generate(cfg *config.Config, option ...Option) error {
 	if err != nil {
 		return fmt.Errorf("merging type systems failed: %w", err)
 	}
+	if cfg.BuildCache {
+		data.CacheKey = inputsHash(cfg, plugins)
+		data.FileCacheKey = fileInputsHash(cfg, plugins)
+	}
 
 	if err = codegen.GenerateCode(data); err != nil {
 		return fmt.Errorf("generating core failed: %w", err)
@@ -118,6 +228,35 @@ func Generate(cfg *config.Config, option ...Option) error {
 		}
 	}
 
+	for _, p := range plugins {
+		if gen, ok := p.(plugin.FileGenerator); ok {
+			files, err := gen.GenerateFiles(data)
+			if err != nil {
+				return fmt.Errorf("%s: %w", p.Name(), err)
+			}
+			for _, f := range files {
+				if err := codegen.RenderFile(data, f); err != nil {
+					return fmt.Errorf("%s: %w", p.Name(), err)
+				}
+			}
+		}
+	}
+
+	if data.CacheKey != "" {
+		// the plugins may have changed data since the exec files were first rendered from it
+		if key := inputsHash(cfg, plugins); key != "" {
+			data.CacheKey += key
+		} else {
+			data.CacheKey = ""
+		}
+	}
+	if data.FileCacheKey != "" {
+		if key := fileInputsHash(cfg, plugins); key != "" {
+			data.FileCacheKey += key
+		} else {
+			data.FileCacheKey = ""
+		}
+	}
 	if err = codegen.GenerateCode(data); err != nil {
 		return fmt.Errorf("generating core failed: %w", err)
 	}
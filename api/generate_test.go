@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/99designs/gqlgen/codegen"
 	"github.com/99designs/gqlgen/codegen/config"
 )
 
@@ -51,3 +52,134 @@ func TestGenerate(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildData(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "default")
+	t.Cleanup(func() {
+		cleanup(workDir)
+		_ = os.Chdir(wd)
+	})
+	require.NoError(t, os.Chdir(workDir))
+
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err, "failed to load config")
+	require.NoError(t, Generate(cfg), "failed to generate code")
+	models, err := os.ReadFile(cfg.Model.Filename)
+	require.NoError(t, err)
+
+	cfg, err = config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err, "failed to load config")
+	data, err := BuildData(cfg)
+	require.NoError(t, err)
+	require.Equal(t, "*github.com/99designs/gqlgen/api/testdata/default/graph/model.Todo", data.Objects.ByName("Todo").Reference().String())
+	require.True(t, data.QueryRoot.HasResolvers())
+
+	after, err := os.ReadFile(cfg.Model.Filename)
+	require.NoError(t, err)
+	require.Equal(t, string(models), string(after))
+}
+
+func TestGenerateDocs(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "federation2")
+	t.Cleanup(func() {
+		cleanup(workDir)
+		_ = os.Chdir(wd)
+	})
+	require.NoError(t, os.Chdir(workDir))
+
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err, "failed to load config")
+	cfg.Docs.Filename = filepath.Join(t.TempDir(), "schema.md")
+	require.NoError(t, Generate(cfg), "failed to generate code")
+
+	docs, err := os.ReadFile(cfg.Docs.Filename)
+	require.NoError(t, err)
+	require.Contains(t, string(docs), "### Todo\n\n_object_\n")
+	require.Contains(t, string(docs), "| `user` | [User](#user)! |  |")
+	require.NotContains(t, string(docs), "_entities")
+	require.NotContains(t, string(docs), "_Service")
+}
+
+func TestGenerateExecutables(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "executables")
+	t.Cleanup(func() {
+		for _, f := range []string{"public/generated.go", "public/resolver.go", "public/schema.resolvers.go", "admin/generated.go", "admin/resolver.go", "model/models_gen.go"} {
+			_ = os.Remove(filepath.Join(workDir, "graph", f))
+		}
+		_ = os.Chdir(wd)
+	})
+	require.NoError(t, os.Chdir(workDir))
+
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err, "failed to load config")
+	require.NoError(t, Generate(cfg), "failed to generate code")
+
+	models, err := os.ReadFile(filepath.Join("graph", "model", "models_gen.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(models), "type Todo struct")
+	require.Contains(t, string(models), "type AuditEntry struct")
+	require.Contains(t, string(models), "InternalNote")
+
+	public, err := os.ReadFile(filepath.Join("graph", "public", "generated.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(public), "model.Todo")
+	require.NotContains(t, string(public), "AuditEntry")
+	require.NotContains(t, string(public), "internalNote")
+
+	admin, err := os.ReadFile(filepath.Join("graph", "admin", "generated.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(admin), "model.Todo")
+	require.Contains(t, string(admin), "model.AuditEntry")
+	require.Contains(t, string(admin), "internalNote")
+	require.Contains(t, string(admin), "DeleteTodo(ctx context.Context, id string) (*model.Todo, error)")
+}
+
+type filesPlugin struct{}
+
+func (filesPlugin) Name() string {
+	return "files"
+}
+
+func (filesPlugin) GenerateFiles(data *codegen.Data) ([]codegen.File, error) {
+	return []codegen.File{{
+		Filename: filepath.Join("graph", "objects_gen.go"),
+		Template: `{{ $fmt := lookupImport "fmt" }}
+func ObjectNames() []string {
+	return []string{ {{- range .Objects }}{{ if not (or .Root .BuiltIn) }} {{ .Name | quote }},{{ end }}{{ end }} }
+}
+
+func Describe() string {
+	return {{ $fmt }}.Sprint({{ range .Objects }}{{ if eq .Name "Todo" }}{{ go .Name | quote }}{{ end }}{{ end }})
+}
+`,
+	}}, nil
+}
+
+func TestGenerateFiles(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	workDir := filepath.Join(wd, "testdata", "default")
+	t.Cleanup(func() {
+		cleanup(workDir)
+		_ = os.Remove(filepath.Join(workDir, "graph", "objects_gen.go"))
+		_ = os.Chdir(wd)
+	})
+	require.NoError(t, os.Chdir(workDir))
+
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	require.NoError(t, err, "failed to load config")
+	require.NoError(t, Generate(cfg, AddPlugin(filesPlugin{})), "failed to generate code")
+
+	src, err := os.ReadFile(filepath.Join("graph", "objects_gen.go"))
+	require.NoError(t, err)
+	require.Contains(t, string(src), "// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\npackage graph\n")
+	require.Contains(t, string(src), "\t\"fmt\"\n")
+	require.Contains(t, string(src), `return []string{"Todo", "User"}`)
+	require.Contains(t, string(src), `return fmt.Sprint("Todo")`)
+}
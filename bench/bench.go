@@ -0,0 +1,197 @@
+// Package bench contains representative workloads for measuring the generated executor, and a harness to run them
+// against any server built from Schema.
+//
+// To compare codegen options, generate Schema once with the options under test (copy the server package, edit its
+// gqlgen.yml and run gqlgen), then run the same scenarios against both builds:
+//
+//	func BenchmarkDefault(b *testing.B) { bench.RunAll(b, bench.NewHandler(server.NewDefaultSchema())) }
+//	func BenchmarkMine(b *testing.B)    { bench.RunAll(b, bench.NewHandler(mine.NewDefaultSchema())) }
+//
+// Results can be fed to Compare to fail a build when a change makes a scenario slower.
+package bench
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// Scenario is a single operation against Schema.
+type Scenario struct {
+	Name      string
+	Query     string
+	Variables map[string]interface{}
+	// Stream marks operations that produce more than one response, eg ones using @defer. They are sent with an
+	// Accept: text/event-stream header so the handler picks a transport able to deliver every response.
+	Stream bool
+}
+
+// Scenarios covers the shapes that dominate executor cost in real schemas.
+var Scenarios = []Scenario{
+	{
+		Name: "Wide",
+		Query: `{ wide {
+			id s01 s02 s03 s04 s05 s06 s07 s08 s09 s10
+			i01 i02 i03 i04 i05 i06 i07 i08 i09 i10
+			f01 f02 f03 f04 f05 b01 b02 b03 b04 b05 optional
+		} }`,
+	},
+	{
+		Name:      "Deep",
+		Query:     `query($depth: Int!) { deep(depth: $depth) { ...N } } fragment N on Node { id name child { id name child { id name child { id name child { id name child { id name child { id name child { id name child { id name } } } } } } } } }`,
+		Variables: map[string]interface{}{"depth": 10},
+	},
+	{
+		Name:      "List",
+		Query:     `query($size: Int!) { list(size: $size) { id name price tags details { description stock } } }`,
+		Variables: map[string]interface{}{"size": 1000},
+	},
+	{
+		Name:      "Defer",
+		Query:     `query($size: Int!) { list(size: $size) { id name ... @defer { details { description stock } } } }`,
+		Variables: map[string]interface{}{"size": 100},
+		Stream:    true,
+	},
+}
+
+// NewHandler wraps es in a server configured like a typical production deployment: a parsed query cache and the
+// POST and SSE transports, without any extensions.
+func NewHandler(es graphql.ExecutableSchema) *handler.Server {
+	srv := handler.New(es)
+	srv.AddTransport(transport.SSE{})
+	srv.AddTransport(transport.POST{})
+	srv.SetQueryCache(lru.New(100))
+	return srv
+}
+
+// Run measures s against h. Every response is checked for a 200 status and an absent errors key, so a misconfigured
+// server fails loudly instead of benchmarking its error path. The response size is reported as resp-bytes/op.
+func Run(b *testing.B, h http.Handler, s Scenario) {
+	b.Helper()
+
+	serve, rec, err := newServer(h, s)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	serve()
+	if err := checkResponse(rec); err != nil {
+		b.Fatalf("%s: %s", s.Name, err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		serve()
+	}
+	b.StopTimer()
+
+	if err := checkResponse(rec); err != nil {
+		b.Fatalf("%s: %s", s.Name, err)
+	}
+	b.ReportMetric(float64(rec.Body.Len()), "resp-bytes/op")
+}
+
+// Check sends s to h once and applies the same validation as Run. It is cheap enough to call from a regular test, to
+// catch a broken build before any time is spent benchmarking it.
+func Check(h http.Handler, s Scenario) error {
+	serve, rec, err := newServer(h, s)
+	if err != nil {
+		return err
+	}
+	serve()
+	return checkResponse(rec)
+}
+
+// RunAll runs every scenario in Scenarios as a sub benchmark of b.
+func RunAll(b *testing.B, h http.Handler) {
+	for _, s := range Scenarios {
+		s := s
+		b.Run(s.Name, func(b *testing.B) {
+			Run(b, h, s)
+		})
+	}
+}
+
+// newServer returns a func sending s to h. The request is reused across calls so the harness itself allocates as little
+// as possible.
+func newServer(h http.Handler, s Scenario) (func(), *httptest.ResponseRecorder, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     s.Query,
+		"variables": s.Variables,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var reader bytes.Reader
+	r := httptest.NewRequest(http.MethodPost, "/query", &reader)
+	r.Header.Set("Content-Type", "application/json")
+	if s.Stream {
+		r.Header.Set("Accept", "text/event-stream")
+	}
+
+	rec := httptest.NewRecorder()
+	return func() {
+		reader.Reset(body)
+		rec.Body.Reset()
+		h.ServeHTTP(rec, r)
+	}, rec, nil
+}
+
+func checkResponse(rec *httptest.ResponseRecorder) error {
+	if rec.Code != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", rec.Code, rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), `"errors":`) {
+		return fmt.Errorf("unexpected errors in response: %s", rec.Body.String())
+	}
+	return nil
+}
+
+// Tolerance is the relative slowdown Compare accepts before reporting a regression, eg 0.1 allows results to be 10%
+// worse than the baseline. A zero value disables the check for that measurement.
+type Tolerance struct {
+	NsPerOp     float64
+	AllocsPerOp float64
+	BytesPerOp  float64
+}
+
+// DefaultTolerance is loose enough to absorb the noise of a shared CI machine for timings, while holding allocations,
+// which are deterministic, to a tight bound.
+var DefaultTolerance = Tolerance{
+	NsPerOp:     0.15,
+	AllocsPerOp: 0.02,
+	BytesPerOp:  0.05,
+}
+
+// Compare returns an error describing every measurement where current is worse than baseline by more than t allows.
+func Compare(baseline, current testing.BenchmarkResult, t Tolerance) error {
+	var regressions []string
+	check := func(name string, base, cur, tolerance float64) {
+		if tolerance == 0 || base == 0 {
+			return
+		}
+		if change := (cur - base) / base; change > tolerance {
+			regressions = append(regressions, fmt.Sprintf("%s regressed by %.1f%% (%.0f -> %.0f)", name, change*100, base, cur))
+		}
+	}
+
+	check("ns/op", float64(baseline.NsPerOp()), float64(current.NsPerOp()), t.NsPerOp)
+	check("allocs/op", float64(baseline.AllocsPerOp()), float64(current.AllocsPerOp()), t.AllocsPerOp)
+	check("B/op", float64(baseline.AllocedBytesPerOp()), float64(current.AllocedBytesPerOp()), t.BytesPerOp)
+
+	if len(regressions) > 0 {
+		return fmt.Errorf("%s", strings.Join(regressions, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package bench_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/bench"
+	"github.com/99designs/gqlgen/bench/server"
+)
+
+func BenchmarkDefault(b *testing.B) {
+	bench.RunAll(b, bench.NewHandler(server.NewDefaultSchema()))
+}
+
+func TestScenarios(t *testing.T) {
+	h := bench.NewHandler(server.NewDefaultSchema())
+	for _, s := range bench.Scenarios {
+		s := s
+		t.Run(s.Name, func(t *testing.T) {
+			require.NoError(t, bench.Check(h, s))
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	base := testing.BenchmarkResult{N: 10, T: 1000, MemAllocs: 100, MemBytes: 1000}
+
+	require.NoError(t, bench.Compare(base, base, bench.DefaultTolerance))
+	require.NoError(t, bench.Compare(base, testing.BenchmarkResult{N: 10, T: 1100, MemAllocs: 100, MemBytes: 1000}, bench.DefaultTolerance))
+
+	err := bench.Compare(base, testing.BenchmarkResult{N: 10, T: 2000, MemAllocs: 200, MemBytes: 1000}, bench.DefaultTolerance)
+	require.EqualError(t, err, "ns/op regressed by 100.0% (100 -> 200), allocs/op regressed by 100.0% (10 -> 20)")
+
+	require.NoError(t, bench.Compare(base, testing.BenchmarkResult{N: 10, T: 2000, MemAllocs: 100, MemBytes: 1000}, bench.Tolerance{}))
+}
@@ -0,0 +1,105 @@
+// Package model holds the Go types the benchmark schema is bound to, along with deterministic builders for the data
+// each scenario returns. Keeping them out of the generated package means a server regenerated with different options
+// resolves exactly the same data.
+package model
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type Wide struct {
+	ID       string
+	S01      string
+	S02      string
+	S03      string
+	S04      string
+	S05      string
+	S06      string
+	S07      string
+	S08      string
+	S09      string
+	S10      string
+	I01      int
+	I02      int
+	I03      int
+	I04      int
+	I05      int
+	I06      int
+	I07      int
+	I08      int
+	I09      int
+	I10      int
+	F01      float64
+	F02      float64
+	F03      float64
+	F04      float64
+	F05      float64
+	B01      bool
+	B02      bool
+	B03      bool
+	B04      bool
+	B05      bool
+	Optional *string
+}
+
+type Node struct {
+	ID    int
+	Name  string
+	Child *Node
+}
+
+type Item struct {
+	ID    int
+	Name  string
+	Price float64
+	Tags  []string
+}
+
+type Details struct {
+	Description string
+	Stock       int
+}
+
+func NewWide() *Wide {
+	return &Wide{
+		ID:  "wide",
+		S01: "one", S02: "two", S03: "three", S04: "four", S05: "five",
+		S06: "six", S07: "seven", S08: "eight", S09: "nine", S10: "ten",
+		I01: 1, I02: 2, I03: 3, I04: 4, I05: 5, I06: 6, I07: 7, I08: 8, I09: 9, I10: 10,
+		F01: 1.5, F02: 2.5, F03: 3.5, F04: 4.5, F05: 5.5,
+		B01: true, B03: true, B05: true,
+	}
+}
+
+// NewNode builds a chain of depth nodes, each the child of the previous one.
+func NewNode(depth int) *Node {
+	var node *Node
+	for i := depth; i > 0; i-- {
+		node = &Node{ID: i, Name: "node " + strconv.Itoa(i), Child: node}
+	}
+	if node == nil {
+		node = &Node{}
+	}
+	return node
+}
+
+func NewItems(size int) []*Item {
+	items := make([]*Item, size)
+	for i := range items {
+		items[i] = &Item{
+			ID:    i,
+			Name:  fmt.Sprintf("item %d", i),
+			Price: float64(i) + 0.99,
+			Tags:  []string{"new", "sale"},
+		}
+	}
+	return items
+}
+
+func NewDetails(item *Item) *Details {
+	return &Details{
+		Description: "details for " + item.Name,
+		Stock:       item.ID * 3,
+	}
+}
@@ -0,0 +1,9 @@
+package bench
+
+import _ "embed"
+
+// Schema is the sdl every Scenario is written against. Generate it with the options being compared to build a server
+// for the harness.
+//
+//go:embed schema.graphql
+var Schema string
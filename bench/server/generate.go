@@ -0,0 +1,12 @@
+// Package server is the benchmark schema generated with the default configuration. It is the baseline other
+// configurations are compared against.
+package server
+
+import "github.com/99designs/gqlgen/graphql"
+
+//go:generate go run ../../testdata/gqlgen.go -config gqlgen.yml
+
+// NewDefaultSchema returns the executable schema generated with the default configuration.
+func NewDefaultSchema() graphql.ExecutableSchema {
+	return NewExecutableSchema(Config{Resolvers: &Resolver{}})
+}
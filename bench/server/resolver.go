@@ -0,0 +1,40 @@
+package server
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/bench/model"
+)
+
+// Resolver serves the benchmark schema from the deterministic builders in the model package, so the numbers measure
+// the generated executor rather than resolver work.
+type Resolver struct{}
+
+// Details is the resolver for the details field.
+func (r *itemResolver) Details(ctx context.Context, obj *model.Item) (*model.Details, error) {
+	return model.NewDetails(obj), nil
+}
+
+// Wide is the resolver for the wide field.
+func (r *queryResolver) Wide(ctx context.Context) (*model.Wide, error) {
+	return model.NewWide(), nil
+}
+
+// Deep is the resolver for the deep field.
+func (r *queryResolver) Deep(ctx context.Context, depth int) (*model.Node, error) {
+	return model.NewNode(depth), nil
+}
+
+// List is the resolver for the list field.
+func (r *queryResolver) List(ctx context.Context, size int) ([]*model.Item, error) {
+	return model.NewItems(size), nil
+}
+
+// Item returns ItemResolver implementation.
+func (r *Resolver) Item() ItemResolver { return &itemResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type itemResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
@@ -0,0 +1,139 @@
+package codegen
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// FieldCache is the @cached directive of a field, its resolver is called through graphql.CachedField.
+type FieldCache struct {
+	TTL time.Duration
+	Key []string // The go expressions joined by graphql.CacheKey into the key
+}
+
+// TTLExpr returns TTL as a go expression.
+func (c *FieldCache) TTLExpr() string {
+	return durationExpr(c.TTL)
+}
+
+// durationExpr returns d as a go expression in the largest unit dividing it.
+func durationExpr(d time.Duration) string {
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{{time.Hour, "Hour"}, {time.Minute, "Minute"}, {time.Second, "Second"}, {time.Millisecond, "Millisecond"}} {
+		if d%unit.d == 0 {
+			return fmt.Sprintf("%d * time.%s", d/unit.d, unit.name)
+		}
+	}
+	return fmt.Sprintf("time.Duration(%d)", d)
+}
+
+// KeyExpr returns the key as a go expression.
+func (c *FieldCache) KeyExpr() string {
+	if len(c.Key) == 1 && strings.HasPrefix(c.Key[0], `"`) {
+		return c.Key[0]
+	}
+	return "graphql.CacheKey(" + strings.Join(c.Key, ", ") + ")"
+}
+
+// buildFieldCache returns the @cached directive of field, or nil if the field doesn't use it or the directive
+// isn't the builtin one.
+//
+// The key of the directive is a template: {obj.Name} is replaced with the go field, or method without arguments, Name
+// of the object and {args.name} with the argument name of the field. Fields of root objects default to the name of the
+// field followed by its arguments.
+func (b *builder) buildFieldCache(obj *Object, f *Field) (*FieldCache, error) {
+	d := f.FieldDefinition.Directives.ForName("cached")
+	if d == nil || !b.Config.Directives["cached"].Builtin() {
+		return nil, nil
+	}
+	name := obj.Name + "." + f.Name
+	if obj.Stream || obj.Definition == b.Schema.Mutation {
+		return nil, fmt.Errorf("@cached on %s: only query fields can be cached", name)
+	}
+
+	cache := &FieldCache{}
+	ttl := d.Arguments.ForName("ttl")
+	if ttl == nil || ttl.Value.Kind != ast.StringValue {
+		return nil, fmt.Errorf("@cached on %s needs a ttl", name)
+	}
+	var err error
+	if cache.TTL, err = time.ParseDuration(ttl.Value.Raw); err != nil || cache.TTL <= 0 {
+		return nil, fmt.Errorf("@cached on %s: ttl %q is not a positive duration", name, ttl.Value.Raw)
+	}
+
+	key := d.Arguments.ForName("key")
+	if key == nil || key.Value.Kind == ast.NullValue {
+		if !obj.Root {
+			return nil, fmt.Errorf("@cached on %s needs a key, values of different %s objects would share it", name, obj.Name)
+		}
+		cache.Key = []string{strconv.Quote(name)}
+		if len(f.Args) > 0 {
+			cache.Key = append(cache.Key, "fc.Args")
+		}
+		return cache, nil
+	}
+	if key.Value.Kind != ast.StringValue || key.Value.Raw == "" {
+		return nil, fmt.Errorf("@cached on %s: key must be a non-empty string", name)
+	}
+
+	rest := key.Value.Raw
+	for rest != "" {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			cache.Key = append(cache.Key, strconv.Quote(rest))
+			break
+		}
+		if start > 0 {
+			cache.Key = append(cache.Key, strconv.Quote(rest[:start]))
+		}
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, fmt.Errorf("@cached on %s: unterminated placeholder in key %q", name, key.Value.Raw)
+		}
+		expr, err := b.cacheKeyPlaceholder(obj, f, rest[start+1:start+end])
+		if err != nil {
+			return nil, fmt.Errorf("@cached on %s: %w", name, err)
+		}
+		cache.Key = append(cache.Key, expr)
+		rest = rest[start+end+1:]
+	}
+	return cache, nil
+}
+
+// cacheKeyPlaceholder returns the go expression of the placeholder of a key, without its braces.
+func (b *builder) cacheKeyPlaceholder(obj *Object, f *Field, placeholder string) (string, error) {
+	if name, ok := strings.CutPrefix(placeholder, "args."); ok {
+		for _, arg := range f.Args {
+			if arg.Name == name {
+				return "fc.Args[" + strconv.Quote(name) + "]", nil
+			}
+		}
+		return "", fmt.Errorf("{%s}: the field has no argument %s", placeholder, name)
+	}
+
+	name, ok := strings.CutPrefix(placeholder, "obj.")
+	if !ok {
+		return "", fmt.Errorf("{%s} is not an {obj.Name} or {args.name} placeholder", placeholder)
+	}
+	if obj.Root || obj.Type == nil {
+		return "", fmt.Errorf("{%s}: %s has no object", placeholder, obj.Name)
+	}
+	v, _, _ := types.LookupFieldOrMethod(obj.Type, true, nil, name)
+	switch v := v.(type) {
+	case *types.Var:
+		return "obj." + name, nil
+	case *types.Func:
+		if sig := v.Type().(*types.Signature); sig.Params().Len() == 0 && sig.Results().Len() == 1 {
+			return "obj." + name + "()", nil
+		}
+		return "", fmt.Errorf("{%s}: method %s of %s must have no arguments and return one value", placeholder, name, obj.Type.String())
+	}
+	return "", fmt.Errorf("{%s}: %s has no field or method %s", placeholder, obj.Type.String(), name)
+}
@@ -6,6 +6,7 @@ import (
 	"go/token"
 	"go/types"
 	"strings"
+	"sync"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"golang.org/x/tools/go/packages"
@@ -18,13 +19,19 @@ var ErrTypeNotFound = errors.New("unable to find type")
 
 // Binder connects graphql types to golang types using static analysis
 type Binder struct {
-	pkgs        *code.Packages
-	schema      *ast.Schema
-	cfg         *Config
-	tctx        *types.Context
-	References  []*TypeReference
-	SawInvalid  bool
-	objectCache map[string]map[string]types.Object
+	pkgs       *code.Packages
+	schema     *ast.Schema
+	cfg        *Config
+	References []*TypeReference
+	SawInvalid bool
+	// cache is shared with the forks of the binder
+	cache *binderCache
+}
+
+type binderCache struct {
+	tctx    *types.Context
+	mu      sync.Mutex
+	objects map[string]map[string]types.Object
 }
 
 func (c *Config) NewBinder() *Binder {
@@ -32,9 +39,27 @@ func (c *Config) NewBinder() *Binder {
 		pkgs:   c.Packages,
 		schema: c.Schema,
 		cfg:    c,
+		cache:  &binderCache{tctx: types.NewContext()},
+	}
+}
+
+// Fork returns a binder sharing the packages and caches of b, with its own References and SawInvalid, so that types
+// can be bound concurrently with b and its other forks. Join adds them back to b.
+func (b *Binder) Fork() *Binder {
+	return &Binder{
+		pkgs:   b.pkgs,
+		schema: b.schema,
+		cfg:    b.cfg,
+		cache:  b.cache,
 	}
 }
 
+// Join adds the references bound by the fork f to b.
+func (b *Binder) Join(f *Binder) {
+	b.References = append(b.References, f.References...)
+	b.SawInvalid = b.SawInvalid || f.SawInvalid
+}
+
 func (b *Binder) TypePosition(typ types.Type) token.Position {
 	named, isNamed := typ.(*types.Named)
 	if !isNamed {
@@ -84,16 +109,12 @@ func (b *Binder) FindType(pkgName string, typeName string) (types.Type, error) {
 }
 
 func (b *Binder) InstantiateType(orig types.Type, targs []types.Type) (types.Type, error) {
-	if b.tctx == nil {
-		b.tctx = types.NewContext()
-	}
-
-	return types.Instantiate(b.tctx, orig, targs, false)
+	return types.Instantiate(b.cache.tctx, orig, targs, false)
 }
 
 var (
 	MapType       = types.NewMap(types.Typ[types.String], types.NewInterfaceType(nil, nil).Complete())
-	InterfaceType = types.NewInterfaceType(nil, nil)
+	InterfaceType = types.NewInterfaceType(nil, nil).Complete()
 )
 
 func (b *Binder) DefaultUserObject(name string) (types.Type, error) {
@@ -128,8 +149,9 @@ func (b *Binder) FindObject(pkgName string, typeName string) (types.Object, erro
 		return nil, fmt.Errorf("package cannot be nil")
 	}
 
-	pkg := b.pkgs.LoadWithTypes(pkgName)
-	if pkg == nil {
+	// only the package scope is looked at, so the packages read from the persistent cache don't need to be loaded again
+	pkg := b.pkgs.Load(pkgName)
+	if pkg == nil || pkg.Types == nil {
 		err := b.pkgs.Errors()
 		if err != nil {
 			return nil, fmt.Errorf("package could not be loaded: %s.%s: %w", pkgName, typeName, err)
@@ -137,15 +159,17 @@ func (b *Binder) FindObject(pkgName string, typeName string) (types.Object, erro
 		return nil, fmt.Errorf("required package was not loaded: %s.%s", pkgName, typeName)
 	}
 
-	if b.objectCache == nil {
-		b.objectCache = make(map[string]map[string]types.Object, b.pkgs.Count())
+	b.cache.mu.Lock()
+	if b.cache.objects == nil {
+		b.cache.objects = make(map[string]map[string]types.Object, b.pkgs.Count())
 	}
 
-	defsIndex, ok := b.objectCache[pkgName]
+	defsIndex, ok := b.cache.objects[pkgName]
 	if !ok {
 		defsIndex = indexDefs(pkg)
-		b.objectCache[pkgName] = defsIndex
+		b.cache.objects[pkgName] = defsIndex
 	}
+	b.cache.mu.Unlock()
 
 	// function based marshalers take precedence
 	if val, ok := defsIndex["Marshal"+typeName]; ok {
@@ -159,28 +183,13 @@ func (b *Binder) FindObject(pkgName string, typeName string) (types.Object, erro
 	return nil, fmt.Errorf("%w: %s.%s", ErrTypeNotFound, pkgName, typeName)
 }
 
+// indexDefs indexes the objects declared in the package scope of pkg by their names.
 func indexDefs(pkg *packages.Package) map[string]types.Object {
-	res := make(map[string]types.Object)
-
 	scope := pkg.Types.Scope()
-	for astNode, def := range pkg.TypesInfo.Defs {
-		// only look at defs in the top scope
-		if def == nil {
-			continue
-		}
-		parent := def.Parent()
-		if parent == nil || parent != scope {
-			continue
-		}
-
-		if _, ok := res[astNode.Name]; !ok {
-			// The above check may not be really needed, it is only here to have a consistent behavior with
-			// previous implementation of FindObject() function which only honored the first inclusion of a def.
-			// If this is still needed, we can consider something like sync.Map.LoadOrStore() to avoid two lookups.
-			res[astNode.Name] = def
-		}
+	res := make(map[string]types.Object, scope.Len())
+	for _, name := range scope.Names() {
+		res[name] = scope.Lookup(name)
 	}
-
 	return res
 }
 
@@ -206,6 +215,8 @@ type TypeReference struct {
 	PointersInUmarshalInput bool        // Inverse values and pointers in return.
 	IsRoot                  bool        // Is the type a root level definition such as Query, Mutation or Subscription
 	EnumValues              []EnumValueReference
+	EnumUnknown             EnumUnknownValue // What marshaling does with values that aren't a member of the enum
+	MapValue                *TypeReference   // The values of a scalar bound to map[string]T with map_value
 }
 
 func (ref *TypeReference) Elem() *TypeReference {
@@ -389,11 +400,15 @@ func (b *Binder) TypeReference(schemaType *ast.Type, bindTarget types.Type) (ret
 		}
 	}()
 
+	if valueType := b.cfg.Models[schemaType.Name()].MapValueType(); valueType != nil {
+		return b.mapReference(schemaType, def, valueType, bindTarget)
+	}
+
 	if len(b.cfg.Models[schemaType.Name()].Model) == 0 {
 		return nil, fmt.Errorf("%s was not found", schemaType.Name())
 	}
 
-	for _, model := range b.cfg.Models[schemaType.Name()].Model {
+	for i, model := range b.cfg.Models[schemaType.Name()].Model {
 		if model == "map[string]interface{}" {
 			if !isMap(bindTarget) {
 				continue
@@ -418,9 +433,10 @@ func (b *Binder) TypeReference(schemaType *ast.Type, bindTarget types.Type) (ret
 			}, nil
 		}
 
+		modelKey := fmt.Sprintf("models.%s.model[%d]", schemaType.Name(), i)
 		pkgName, typeName = code.PkgAndType(model)
 		if pkgName == "" {
-			return nil, fmt.Errorf("missing package name for %s", schemaType.Name())
+			return nil, b.cfg.errorAt(modelKey, fmt.Errorf("missing package name for %s", schemaType.Name()))
 		}
 
 		ref := &TypeReference{
@@ -431,7 +447,7 @@ func (b *Binder) TypeReference(schemaType *ast.Type, bindTarget types.Type) (ret
 
 		obj, err := b.FindObject(pkgName, typeName)
 		if err != nil {
-			return nil, err
+			return nil, b.cfg.errorAt(modelKey, err)
 		}
 
 		if values := b.enumValues(def); len(values) > 0 {
@@ -472,7 +488,7 @@ func (b *Binder) TypeReference(schemaType *ast.Type, bindTarget types.Type) (ret
 		ref.GO = b.CopyModifiersFromAst(schemaType, ref.GO)
 
 		if bindTarget != nil {
-			if err = code.CompatibleTypes(ref.GO, bindTarget); err != nil {
+			if err = code.CompatibleTypes(ref.GO, bindTarget); err != nil && !b.convert(schemaType, ref, bindTarget) {
 				continue
 			}
 			ref.GO = bindTarget
@@ -480,12 +496,85 @@ func (b *Binder) TypeReference(schemaType *ast.Type, bindTarget types.Type) (ret
 
 		ref.PointersInUmarshalInput = b.cfg.ReturnPointersInUmarshalInput
 
+		if unknown := b.cfg.Models[def.Name].UnknownValue; unknown.IsDefined() {
+			if err := b.enumUnknownValue(ref, unknown); err != nil {
+				return nil, err
+			}
+		}
+
 		return ref, nil
 	}
 
 	return nil, fmt.Errorf("%s is incompatible with %s", schemaType.Name(), bindTarget.String())
 }
 
+// convert binds ref to bindTarget with a cast when implicit_conversions is enabled and bindTarget is a named type, or
+// pointers or slices of it, whose underlying type is the basic type marshaled by ref.
+func (b *Binder) convert(schemaType *ast.Type, ref *TypeReference, bindTarget types.Type) bool {
+	if !b.cfg.ImplicitConversions || ref.Marshaler == nil || ref.CastType != nil || len(ref.EnumValues) > 0 {
+		return false
+	}
+	basic, ok := ref.Target.(*types.Basic)
+	if !ok {
+		return false
+	}
+
+	elem := bindTarget
+	for {
+		if ptr, ok := elem.(*types.Pointer); ok {
+			elem = ptr.Elem()
+		} else if slice, ok := elem.(*types.Slice); ok {
+			elem = slice.Elem()
+		} else {
+			break
+		}
+	}
+	named, ok := elem.(*types.Named)
+	if !ok || !types.Identical(named.Underlying(), basic) {
+		return false
+	}
+	if code.CompatibleTypes(b.CopyModifiersFromAst(schemaType, named), bindTarget) != nil {
+		return false
+	}
+
+	ref.Target = named
+	ref.CastType = basic
+	return true
+}
+
+// mapReference binds a scalar configured with map_value to map[string]T, T being the go type its values bind to.
+func (b *Binder) mapReference(schemaType *ast.Type, def *ast.Definition, valueType *ast.Type, bindTarget types.Type) (*TypeReference, error) {
+	if def.Kind != ast.Scalar {
+		return nil, fmt.Errorf("%s: map_value can only be used with scalars", schemaType.Name())
+	}
+	if valueDef := b.schema.Types[valueType.Name()]; valueDef == nil || !valueDef.IsLeafType() {
+		return nil, fmt.Errorf("%s: map_value %s must be a scalar or an enum", schemaType.Name(), valueType.String())
+	}
+
+	value, err := b.TypeReference(valueType, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: map_value: %w", schemaType.Name(), err)
+	}
+
+	ref := &TypeReference{
+		Definition: def,
+		GQL:        schemaType,
+		GO:         types.NewMap(types.Typ[types.String], value.GO),
+		MapValue:   value,
+	}
+	ref.Target = ref.GO
+	ref.GO = b.CopyModifiersFromAst(schemaType, ref.GO)
+
+	if bindTarget != nil {
+		if err := code.CompatibleTypes(ref.GO, bindTarget); err != nil {
+			return nil, fmt.Errorf("%s is incompatible with %s", schemaType.Name(), bindTarget.String())
+		}
+		ref.GO = bindTarget
+	}
+
+	return ref, nil
+}
+
 func isValid(t types.Type) bool {
 	basic, isBasic := t.(*types.Basic)
 	if !isBasic {
@@ -601,6 +690,9 @@ func (b *Binder) enumReference(ref *TypeReference, obj types.Object, values map[
 	ref.Marshaler = str.Marshaler
 	ref.Unmarshaler = str.Unmarshaler
 	ref.EnumValues = make([]EnumValueReference, 0, len(values))
+	// constants are compared by value, non-contiguous or aliased constants can bind two enum values to the same one,
+	// which is only an error with EnumHelpers
+	constants := map[string]string{}
 
 	for _, value := range ref.Definition.EnumValues {
 		v, ok := values[value.Name]
@@ -623,6 +715,15 @@ func (b *Binder) enumReference(ref *TypeReference, obj types.Object, values map[
 				valueObj.Type(), value.Name, ref.GO, ref.Definition.Name)
 		}
 
+		// the helpers switch on the constants, which must then be distinct
+		if c, ok := valueObj.(*types.Const); ok && b.cfg.EnumHelpers {
+			if other, ok := constants[c.Val().ExactString()]; ok {
+				return fmt.Errorf("enum values %v and %v of enum: %v are bound to the same go value %v",
+					other, value.Name, ref.Definition.Name, c.Val().ExactString())
+			}
+			constants[c.Val().ExactString()] = value.Name
+		}
+
 		switch valueObj.(type) {
 		case *types.Const, *types.Var:
 			ref.EnumValues = append(ref.EnumValues, EnumValueReference{
@@ -637,3 +738,20 @@ func (b *Binder) enumReference(ref *TypeReference, obj types.Object, values map[
 
 	return nil
 }
+
+// enumUnknownValue checks the unknown_value config of the enum ref is bound for can be generated: the marshaler needs
+// enum_values or an IsValid method to tell which values aren't members.
+func (b *Binder) enumUnknownValue(ref *TypeReference, unknown EnumUnknownValue) error {
+	def := ref.Definition
+	if def.Kind != ast.Enum {
+		return fmt.Errorf("unknown_value is set for %s, which is not an enum", def.Name)
+	}
+	if unknown.Action == EnumUnknownMap && def.EnumValues.ForName(unknown.MapTo) == nil {
+		return fmt.Errorf("unknown_value of %s maps to %s, which is not a value of the enum", def.Name, unknown.MapTo)
+	}
+	if !ref.HasEnumValues() && !hasMethod(ref.Target, "IsValid") {
+		return fmt.Errorf("unknown_value of %s needs enum_values or an IsValid method on %s", def.Name, ref.Target.String())
+	}
+	ref.EnumUnknown = unknown
+	return nil
+}
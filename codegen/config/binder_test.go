@@ -304,3 +304,37 @@ func TestEnumBinding(t *testing.T) {
 	require.Equal(t, bazTwo, baz.EnumValues[1].Object)
 	require.Equal(t, cf.Schema.Types["Baz"].EnumValues[1], baz.EnumValues[1].Definition)
 }
+
+func TestEnumBindingSameValue(t *testing.T) {
+	cf := Config{}
+	cf.Packages = code.NewPackages()
+	cf.Models = TypeMap{
+		"Qux": TypeMapEntry{
+			Model: []string{"github.com/99designs/gqlgen/codegen/config/testdata/enum.Qux"},
+			EnumValues: map[string]EnumValue{
+				"LOW":     {Value: "github.com/99designs/gqlgen/codegen/config/testdata/enum.QuxLow"},
+				"DEFAULT": {Value: "github.com/99designs/gqlgen/codegen/config/testdata/enum.QuxDefault"},
+			},
+		},
+		"String": TypeMapEntry{
+			Model: []string{"github.com/99designs/gqlgen/graphql.String"},
+		},
+	}
+	cf.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "schema", Input: `
+	type Query {
+	    foo: Qux
+	}
+	enum Qux {
+	    LOW
+	    DEFAULT
+	}
+	`})
+
+	_, err := cf.NewBinder().TypeReference(cf.Schema.Query.Fields.ForName("foo").Type, nil)
+	require.NoError(t, err)
+
+	// the helpers can't tell the values apart
+	cf.EnumHelpers = true
+	_, err = cf.NewBinder().TypeReference(cf.Schema.Query.Fields.ForName("foo").Type, nil)
+	require.EqualError(t, err, "enum values LOW and DEFAULT of enum: Qux are bound to the same go value 10")
+}
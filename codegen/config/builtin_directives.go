@@ -0,0 +1,102 @@
+package config
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// builtinDirective is the signature a schema must declare a directive with for gqlgen to implement it.
+type builtinDirective struct {
+	// args are the types of the arguments, an empty type accepts any type, like for values written as literals of
+	// the type of the field
+	args      map[string]string
+	locations []ast.DirectiveLocation
+}
+
+// builtinDirectives are the directives gqlgen implements itself, in the generated code or in an extension.
+var builtinDirectives = map[string]builtinDirective{
+	"default": {
+		args:      map[string]string{"value": ""},
+		locations: []ast.DirectiveLocation{ast.LocationInputFieldDefinition},
+	},
+	"masked": {
+		args:      map[string]string{"reason": "String!"},
+		locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	"encrypted": {
+		args:      map[string]string{"key": "String!"},
+		locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	"fallback": {
+		args:      map[string]string{"value": ""},
+		locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	"cached": {
+		args:      map[string]string{"ttl": "String!", "key": "String"},
+		locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	"retry": {
+		args:      map[string]string{"attempts": "Int!", "backoff": "String"},
+		locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	"initialPayload": {
+		locations: []ast.DirectiveLocation{ast.LocationFieldDefinition},
+	},
+	"patch": {
+		locations: []ast.DirectiveLocation{ast.LocationSubscription},
+	},
+	"example": {
+		args:      map[string]string{"value": "String!"},
+		locations: []ast.DirectiveLocation{ast.LocationFieldDefinition, ast.LocationScalar, ast.LocationEnum},
+	},
+}
+
+// injectBuiltinDirectives claims the builtin directives the schema declares with their signature and the directives
+// config doesn't configure, they are skipped at runtime and DirectiveConfig.Builtin reports them. A directive with
+// the same name and another signature is left to the user, like any other directive.
+func (c *Config) injectBuiltinDirectives() {
+	// the config of the executables shares the map, the directives are claimed again for each schema
+	directives := make(map[string]DirectiveConfig, len(c.Directives))
+	for name, d := range c.Directives {
+		if !d.builtin {
+			directives[name] = d
+		}
+	}
+	c.Directives = directives
+
+	for name, want := range builtinDirectives {
+		if _, configured := c.Directives[name]; configured {
+			continue
+		}
+		if def := c.Schema.Directives[name]; def != nil && want.matches(def) {
+			c.Directives[name] = DirectiveConfig{SkipRuntime: true, builtin: true}
+		}
+	}
+}
+
+// matches reports whether def has the arguments of the builtin directive and no location it doesn't support.
+func (b builtinDirective) matches(def *ast.DirectiveDefinition) bool {
+	if def.IsRepeatable || len(def.Arguments) != len(b.args) {
+		return false
+	}
+	for _, arg := range def.Arguments {
+		typ, ok := b.args[arg.Name]
+		if !ok || (typ != "" && arg.Type.String() != typ) {
+			return false
+		}
+	}
+	for _, loc := range def.Locations {
+		if !hasLocation(b.locations, loc) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasLocation(locations []ast.DirectiveLocation, loc ast.DirectiveLocation) bool {
+	for _, l := range locations {
+		if l == loc {
+			return true
+		}
+	}
+	return false
+}
@@ -1,12 +1,18 @@
+//go:generate go run ../../internal/configschema/gen.go -dir . -out ../../gqlgen.schema.json
+
 package config
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"go/types"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -17,6 +23,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/internal/code"
 )
 
@@ -25,8 +32,13 @@ type Config struct {
 	Exec                          ExecConfig                 `yaml:"exec"`
 	Model                         PackageConfig              `yaml:"model,omitempty"`
 	Federation                    PackageConfig              `yaml:"federation,omitempty"`
+	Lint                          LintConfig                 `yaml:"lint,omitempty"`
+	Docs                          DocsConfig                 `yaml:"docs,omitempty"`
+	Format                        FormatConfig               `yaml:"format,omitempty"`
 	Resolver                      ResolverConfig             `yaml:"resolver,omitempty"`
+	Executables                   []ExecutableConfig         `yaml:"executables,omitempty"`
 	AutoBind                      []string                   `yaml:"autobind"`
+	ForceGenerate                 StringList                 `yaml:"force_generate,omitempty"`
 	Models                        TypeMap                    `yaml:"models,omitempty"`
 	StructTag                     string                     `yaml:"struct_tag,omitempty"`
 	Directives                    map[string]DirectiveConfig `yaml:"directives,omitempty"`
@@ -43,16 +55,52 @@ type Config struct {
 	StructFieldsAlwaysPointers    bool                       `yaml:"struct_fields_always_pointers,omitempty"`
 	ReturnPointersInUmarshalInput bool                       `yaml:"return_pointers_in_unmarshalinput,omitempty"`
 	ResolversAlwaysReturnPointers bool                       `yaml:"resolvers_always_return_pointers,omitempty"`
+	SubscriptionCleanup           bool                       `yaml:"subscription_cleanup,omitempty"`
 	NullableInputOmittable        bool                       `yaml:"nullable_input_omittable,omitempty"`
+	ImplicitConversions           bool                       `yaml:"implicit_conversions,omitempty"`
+	NilSafety                     NilSafety                  `yaml:"nil_safety,omitempty"`
+	ExportInputUnmarshalers       bool                       `yaml:"export_input_unmarshalers,omitempty"`
+	ExportObjectMarshalers        bool                       `yaml:"export_object_marshalers,omitempty"`
+	ExportFieldMeta               bool                       `yaml:"export_field_meta,omitempty"`
+	InterfaceDispatchTables       bool                       `yaml:"interface_dispatch_tables,omitempty"`
+	EnumHelpers                   bool                       `yaml:"enum_helpers,omitempty"`
+	ProvenanceHeader              bool                       `yaml:"provenance_header,omitempty"`
 	EnableModelJsonOmitemptyTag   *bool                      `yaml:"enable_model_json_omitempty_tag,omitempty"`
 	SkipValidation                bool                       `yaml:"skip_validation,omitempty"`
 	SkipModTidy                   bool                       `yaml:"skip_mod_tidy,omitempty"`
+	BuildCache                    bool                       `yaml:"build_cache,omitempty"`
+	LoadCache                     bool                       `yaml:"load_cache,omitempty"`
 	Sources                       []*ast.Source              `yaml:"-"`
 	Packages                      *code.Packages             `yaml:"-"`
 	Schema                        *ast.Schema                `yaml:"-"`
 
 	// Deprecated: use Federation instead. Will be removed next release
 	Federated bool `yaml:"federated,omitempty"`
+
+	// hash is the sha256 of the config file read by ReadConfig
+	hash string
+	// filename is the name of the config file read by LoadConfig
+	filename string
+	// positions is the position of each key of the config file, see errorAt
+	positions map[string]Position
+
+	// autobound is the autobind package of each type bound by autobind
+	autobound map[string]string
+	// rewrittenSources are the sources rewritten by applyVisibility
+	rewrittenSources map[*ast.Source]bool
+}
+
+// Provenance describes the gqlgen version and config file generating code, written below the generated header of
+// files when ProvenanceHeader is set, so tooling can find files generated by another version or config.
+func (c *Config) Provenance() string {
+	if !c.ProvenanceHeader {
+		return ""
+	}
+	provenance := "gqlgen version " + graphql.Version
+	if c.hash != "" {
+		provenance += ", config sha256:" + c.hash
+	}
+	return provenance
 }
 
 var cfgFilenames = []string{".gqlgen.yml", "gqlgen.yml", "gqlgen.yaml"}
@@ -120,13 +168,39 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("unable to read config: %w", err)
 	}
 
-	return ReadConfig(bytes.NewReader(b))
+	return readConfig(filename, b)
 }
 
+// ReadConfig reads a config file, errors are reported at the positions of cfgFile when it has a name, like an
+// *os.File.
 func ReadConfig(cfgFile io.Reader) (*Config, error) {
+	b, err := io.ReadAll(cfgFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read config: %w", err)
+	}
+	var filename string
+	if f, ok := cfgFile.(interface{ Name() string }); ok {
+		filename = f.Name()
+	}
+	return readConfig(filename, b)
+}
+
+func readConfig(filename string, b []byte) (*Config, error) {
 	config := DefaultConfig()
+	config.filename = filename
+	config.positions = map[string]Position{}
+	sum := sha256.Sum256(b)
+	config.hash = hex.EncodeToString(sum[:])
 
-	dec := yaml.NewDecoder(cfgFile)
+	var node yaml.Node
+	if err := yaml.NewDecoder(bytes.NewReader(b)).Decode(&node); err != nil {
+		return nil, fmt.Errorf("unable to parse config: %w", err)
+	}
+	if err := config.readPositions(&node, reflect.TypeOf(config), ""); err != nil {
+		return nil, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(b))
 	dec.KnownFields(true)
 
 	if err := dec.Decode(config); err != nil {
@@ -156,9 +230,35 @@ func CompleteConfig(config *Config) error {
 		}
 	}
 
-	preGlobbing := config.SchemaFilename
-	config.SchemaFilename = StringList{}
-	for _, f := range preGlobbing {
+	var err error
+	if config.SchemaFilename, err = globSchemas(config.SchemaFilename); err != nil {
+		return err
+	}
+	sources, err := loadSources(config.SchemaFilename)
+	if err != nil {
+		return err
+	}
+	config.Sources = append(config.Sources, sources...)
+
+	for i := range config.Executables {
+		e := &config.Executables[i]
+		if e.SchemaFilename, err = globSchemas(e.SchemaFilename); err != nil {
+			return err
+		}
+		if e.Sources, err = loadSources(e.SchemaFilename); err != nil {
+			return err
+		}
+	}
+
+	config.GoInitialisms.setInitialisms()
+
+	return nil
+}
+
+// globSchemas expands the globs of schema filenames, ** matching any number of directories.
+func globSchemas(patterns StringList) (StringList, error) {
+	filenames := StringList{}
+	for _, f := range patterns {
 		var matches []string
 
 		// for ** we want to override default globbing patterns and walk all
@@ -181,46 +281,49 @@ func CompleteConfig(config *Config) error {
 
 				return nil
 			}); err != nil {
-				return fmt.Errorf("failed to walk schema at root %s: %w", pathParts[0], err)
+				return nil, fmt.Errorf("failed to walk schema at root %s: %w", pathParts[0], err)
 			}
 		} else {
 			var err error
 			matches, err = filepath.Glob(f)
 			if err != nil {
-				return fmt.Errorf("failed to glob schema filename %s: %w", f, err)
+				return nil, fmt.Errorf("failed to glob schema filename %s: %w", f, err)
 			}
 		}
 
 		for _, m := range matches {
-			if config.SchemaFilename.Has(m) {
+			if filenames.Has(m) {
 				continue
 			}
-			config.SchemaFilename = append(config.SchemaFilename, m)
+			filenames = append(filenames, m)
 		}
 	}
+	return filenames, nil
+}
 
-	for _, filename := range config.SchemaFilename {
+func loadSources(filenames StringList) ([]*ast.Source, error) {
+	var sources []*ast.Source
+	for _, filename := range filenames {
 		filename = filepath.ToSlash(filename)
 		var err error
 		var schemaRaw []byte
 		schemaRaw, err = os.ReadFile(filename)
 		if err != nil {
-			return fmt.Errorf("unable to open schema: %w", err)
+			return nil, fmt.Errorf("unable to open schema: %w", err)
 		}
 
-		config.Sources = append(config.Sources, &ast.Source{Name: filename, Input: string(schemaRaw)})
+		sources = append(sources, &ast.Source{Name: filename, Input: string(schemaRaw)})
 	}
-
-	config.GoInitialisms.setInitialisms()
-
-	return nil
+	return sources, nil
 }
 
 func (c *Config) Init() error {
+	if c.BuildCache {
+		c.Format.cacheDir = c.BuildCachePath()
+	}
+
 	if c.Packages == nil {
-		c.Packages = code.NewPackages(
-			code.WithBuildTags(c.GoBuildTags...),
-		)
+		c.Packages = c.newPackages()
 	}
 
 	if c.Schema == nil {
@@ -234,11 +337,21 @@ func (c *Config) Init() error {
 		return err
 	}
 
+	err = c.forceGenerate()
+	if err != nil {
+		return err
+	}
+
 	err = c.autobind()
 	if err != nil {
 		return err
 	}
 
+	err = c.inferEnumModels()
+	if err != nil {
+		return err
+	}
+
 	c.injectBuiltins()
 	// prefetch all packages in one big packages.Load call
 	c.Packages.LoadAll(c.packageList()...)
@@ -291,6 +404,8 @@ func (c *Config) injectTypesFromSchema() error {
 		SkipRuntime: true,
 	}
 
+	c.injectBuiltinDirectives()
+
 	for _, schemaType := range c.Schema.Types {
 		if c.IsRoot(schemaType) {
 			continue
@@ -437,11 +552,30 @@ type TypeMapEntry struct {
 	ForceGenerate bool                    `yaml:"forceGenerate,omitempty"`
 	Fields        map[string]TypeMapField `yaml:"fields,omitempty"`
 	EnumValues    map[string]EnumValue    `yaml:"enum_values,omitempty"`
+	UnknownValue  EnumUnknownValue        `yaml:"unknown_value,omitempty"`
+	// MapValue binds a scalar to map[string]T, where T is bound to the named GraphQL type, like Int! or Currency.
+	MapValue string `yaml:"map_value,omitempty"`
+	// ImplementorPriority lists implementors of an interface or union matched before the others, in this order, when
+	// marshaling its values. Values implementing the go types of several implementors are marshaled as the first one.
+	ImplementorPriority []string `yaml:"implementor_priority,omitempty"`
 
 	// Key is the Go name of the field.
 	ExtraFields map[string]ModelExtraField `yaml:"extraFields,omitempty"`
 }
 
+var mapValuePattern = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// MapValueType returns the GraphQL type of the values of a scalar bound with MapValue, or nil if it isn't.
+func (e TypeMapEntry) MapValueType() *ast.Type {
+	if e.MapValue == "" {
+		return nil
+	}
+	return &ast.Type{
+		NamedType: strings.TrimSuffix(e.MapValue, "!"),
+		NonNull:   strings.HasSuffix(e.MapValue, "!"),
+	}
+}
+
 type TypeMapField struct {
 	Resolver        bool   `yaml:"resolver"`
 	FieldName       string `yaml:"fieldName"`
@@ -452,6 +586,46 @@ type EnumValue struct {
 	Value string
 }
 
+type EnumUnknownAction string
+
+const (
+	// EnumUnknownError returns a field error for values that aren't a member of the enum.
+	EnumUnknownError EnumUnknownAction = "error"
+	// EnumUnknownNull returns null and a warning in the response extensions. Where the schema doesn't allow null it
+	// returns a field error instead.
+	EnumUnknownNull EnumUnknownAction = "null"
+	// EnumUnknownMap returns the enum value named by MapTo.
+	EnumUnknownMap EnumUnknownAction = "map"
+)
+
+// EnumUnknownValue configures what marshaling an enum does with a go value that isn't one of its members, such as a
+// value written to the database by a newer version of the schema. Unless set the value is written as is.
+type EnumUnknownValue struct {
+	Action EnumUnknownAction `yaml:"action,omitempty"`
+	// MapTo is the enum value returned for unknown values with the map action, usually something like UNKNOWN.
+	MapTo string `yaml:"map_to,omitempty"`
+}
+
+func (v EnumUnknownValue) IsDefined() bool {
+	return v.Action != ""
+}
+
+func (v EnumUnknownValue) Check() error {
+	switch v.Action {
+	case "", EnumUnknownError, EnumUnknownNull:
+		if v.MapTo != "" {
+			return fmt.Errorf("map_to is only used with the map action")
+		}
+	case EnumUnknownMap:
+		if v.MapTo == "" {
+			return fmt.Errorf("map_to must be set for the map action")
+		}
+	default:
+		return fmt.Errorf("invalid action %q, expected one of error, null or map", v.Action)
+	}
+	return nil
+}
+
 type ModelExtraField struct {
 	// Type is the Go type of the field.
 	//
@@ -520,11 +694,13 @@ func (c *Config) check() error {
 
 	fileList := map[string][]FilenamePackage{}
 
-	if err := c.Models.Check(); err != nil {
-		return fmt.Errorf("config.models: %w", err)
+	for typeName, entry := range c.Models {
+		if field, err := checkTypeMapEntry(typeName, entry); err != nil {
+			return c.errorAt("models."+typeName+"."+field, fmt.Errorf("config.models: %w", err))
+		}
 	}
 	if err := c.Exec.Check(); err != nil {
-		return fmt.Errorf("config.exec: %w", err)
+		return c.errorAt("exec", fmt.Errorf("config.exec: %w", err))
 	}
 	fileList[c.Exec.ImportPath()] = append(fileList[c.Exec.ImportPath()], FilenamePackage{
 		Filename: c.Exec.Filename,
@@ -534,7 +710,7 @@ func (c *Config) check() error {
 
 	if c.Model.IsDefined() {
 		if err := c.Model.Check(); err != nil {
-			return fmt.Errorf("config.model: %w", err)
+			return c.errorAt("model", fmt.Errorf("config.model: %w", err))
 		}
 		fileList[c.Model.ImportPath()] = append(fileList[c.Model.ImportPath()], FilenamePackage{
 			Filename: c.Model.Filename,
@@ -544,7 +720,7 @@ func (c *Config) check() error {
 	}
 	if c.Resolver.IsDefined() {
 		if err := c.Resolver.Check(); err != nil {
-			return fmt.Errorf("config.resolver: %w", err)
+			return c.errorAt("resolver", fmt.Errorf("config.resolver: %w", err))
 		}
 		fileList[c.Resolver.ImportPath()] = append(fileList[c.Resolver.ImportPath()], FilenamePackage{
 			Filename: c.Resolver.Filename,
@@ -554,7 +730,7 @@ func (c *Config) check() error {
 	}
 	if c.Federation.IsDefined() {
 		if err := c.Federation.Check(); err != nil {
-			return fmt.Errorf("config.federation: %w", err)
+			return c.errorAt("federation", fmt.Errorf("config.federation: %w", err))
 		}
 		fileList[c.Federation.ImportPath()] = append(fileList[c.Federation.ImportPath()], FilenamePackage{
 			Filename: c.Federation.Filename,
@@ -562,24 +738,54 @@ func (c *Config) check() error {
 			Declaree: "federation",
 		})
 		if c.Federation.ImportPath() != c.Exec.ImportPath() {
-			return fmt.Errorf("federation and exec must be in the same package")
+			return c.errorAt("federation", fmt.Errorf("federation and exec must be in the same package"))
+		}
+	}
+	for i := range c.Executables {
+		e := &c.Executables[i]
+		if err := e.Check(); err != nil {
+			return c.errorAt(fmt.Sprintf("executables[%d]", i), fmt.Errorf("config.executables[%d]: %w", i, err))
 		}
+		fileList[e.Exec.ImportPath()] = append(fileList[e.Exec.ImportPath()], FilenamePackage{
+			Filename: e.Exec.Filename,
+			Package:  e.Exec.Package,
+			Declaree: fmt.Sprintf("executables[%d].exec", i),
+		})
+		if e.Resolver.IsDefined() {
+			fileList[e.Resolver.ImportPath()] = append(fileList[e.Resolver.ImportPath()], FilenamePackage{
+				Filename: e.Resolver.Filename,
+				Package:  e.Resolver.Package,
+				Declaree: fmt.Sprintf("executables[%d].resolver", i),
+			})
+		}
+	}
+	if err := c.checkExecutables(); err != nil {
+		return c.errorAt("executables", fmt.Errorf("config.executables: %w", err))
+	}
+	if err := c.Lint.Check(); err != nil {
+		return c.errorAt("lint", fmt.Errorf("config.lint: %w", err))
+	}
+	if err := c.Docs.Check(); err != nil {
+		return c.errorAt("docs", fmt.Errorf("config.docs: %w", err))
+	}
+	if err := c.NilSafety.Check(); err != nil {
+		return c.errorAt("nil_safety", fmt.Errorf("config.nil_safety: %w", err))
 	}
 	if c.Federated {
-		return fmt.Errorf("federated has been removed, instead use\nfederation:\n    filename: path/to/federated.go")
+		return c.errorAt("federated", fmt.Errorf("federated has been removed, instead use\nfederation:\n    filename: path/to/federated.go"))
 	}
 
 	for importPath, pkg := range fileList {
 		for _, file1 := range pkg {
 			for _, file2 := range pkg {
 				if file1.Package != file2.Package {
-					return fmt.Errorf("%s and %s define the same import path (%s) with different package names (%s vs %s)",
+					return c.errorAt(file2.Declaree, fmt.Errorf("%s and %s define the same import path (%s) with different package names (%s vs %s)",
 						file1.Declaree,
 						file2.Declaree,
 						importPath,
 						file1.Package,
 						file2.Package,
-					)
+					))
 				}
 			}
 		}
@@ -595,6 +801,15 @@ func (tm TypeMap) Exists(typeName string) bool {
 	return ok
 }
 
+// Copy returns a copy of the type map, sharing the entries.
+func (tm TypeMap) Copy() TypeMap {
+	res := make(TypeMap, len(tm))
+	for typeName, entry := range tm {
+		res[typeName] = entry
+	}
+	return res
+}
+
 func (tm TypeMap) UserDefined(typeName string) bool {
 	m, ok := tm[typeName]
 	return ok && len(m.Model) > 0
@@ -602,21 +817,36 @@ func (tm TypeMap) UserDefined(typeName string) bool {
 
 func (tm TypeMap) Check() error {
 	for typeName, entry := range tm {
-		for _, model := range entry.Model {
-			if strings.LastIndex(model, ".") < strings.LastIndex(model, "/") {
-				return fmt.Errorf("model %s: invalid type specifier \"%s\" - you need to specify a struct to map to", typeName, entry.Model)
-			}
+		if _, err := checkTypeMapEntry(typeName, entry); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		if len(entry.Model) == 0 {
-			for enum, v := range entry.EnumValues {
-				if v.Value != "" {
-					return fmt.Errorf("model is empty for: %v, but enum value is specified for %v", typeName, enum)
-				}
-			}
+// checkTypeMapEntry checks the entry of typeName, returning the key of the invalid field.
+func checkTypeMapEntry(typeName string, entry TypeMapEntry) (string, error) {
+	for _, model := range entry.Model {
+		if strings.LastIndex(model, ".") < strings.LastIndex(model, "/") {
+			return "model", fmt.Errorf("model %s: invalid type specifier \"%s\" - you need to specify a struct to map to", typeName, entry.Model)
 		}
 	}
-	return nil
+
+	if err := entry.UnknownValue.Check(); err != nil {
+		return "unknown_value", fmt.Errorf("model %s: unknown_value: %w", typeName, err)
+	}
+
+	if entry.MapValue != "" {
+		if len(entry.Model) > 0 {
+			return "map_value", fmt.Errorf("model %s: map_value can't be used with model", typeName)
+		}
+		if name := entry.MapValueType().NamedType; !mapValuePattern.MatchString(name) {
+			return "map_value", fmt.Errorf("model %s: map_value %q must name a type, like Int or Int!", typeName, entry.MapValue)
+		}
+	}
+
+	// enums with values and no model are checked by inferEnumModels, once the schema is loaded
+	return "", nil
 }
 
 func (tm TypeMap) ReferencedPackages() []string {
@@ -655,6 +885,18 @@ func (tm TypeMap) ForceGenerate(name string, forceGenerate bool) {
 
 type DirectiveConfig struct {
 	SkipRuntime bool `yaml:"skip_runtime"`
+
+	// WrapFields applies the directive, where it annotates an object type, to every field of the object instead of
+	// the fields returning it. The directive then receives the object holding the field as its parent argument, the
+	// definition of the object is the ObjectDefinition of graphql.GetFieldContext(ctx).Field.
+	WrapFields bool `yaml:"wrap_fields,omitempty"`
+
+	builtin bool
+}
+
+// Builtin reports whether gqlgen implements the directive itself, see injectBuiltinDirectives.
+func (d DirectiveConfig) Builtin() bool {
+	return d.builtin
 }
 
 func inStrSlice(haystack []string, needle string) bool {
@@ -667,6 +909,11 @@ func inStrSlice(haystack []string, needle string) bool {
 	return false
 }
 
+// FindConfig returns the path of the config file LoadConfigFromDefaultLocations would load.
+func FindConfig() (string, error) {
+	return findCfg()
+}
+
 // findCfg searches for the config file in this directory and all parents up the tree
 // looking for the closest match
 func findCfg() (string, error) {
@@ -699,6 +946,35 @@ func findCfgInDir(dir string) string {
 	return ""
 }
 
+// AutobindPackage returns the autobind package the type was bound from, or "" if it wasn't bound by autobind.
+func (c *Config) AutobindPackage(typeName string) string {
+	return c.autobound[typeName]
+}
+
+// forceGenerate marks the types matching the force_generate patterns to be generated, even when autobind finds a
+// type of the same name. Types with a model are still bound to it.
+func (c *Config) forceGenerate() error {
+	for _, pattern := range c.ForceGenerate {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return c.errorAt("force_generate", fmt.Errorf("force_generate: invalid pattern %q: %w", pattern, err))
+		}
+	}
+
+	for _, t := range c.Schema.Types {
+		if t.BuiltIn || t.Kind == ast.Scalar || c.IsRoot(t) || c.Models.UserDefined(t.Name) {
+			continue
+		}
+		for _, pattern := range c.ForceGenerate {
+			if ok, _ := path.Match(pattern, t.Name); ok {
+				c.Models.ForceGenerate(t.Name, true)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 func (c *Config) autobind() error {
 	if len(c.AutoBind) == 0 {
 		return nil
@@ -713,12 +989,16 @@ func (c *Config) autobind() error {
 
 		for i, p := range ps {
 			if p == nil || p.Module == nil {
-				return fmt.Errorf("unable to load %s - make sure you're using an import path to a package that exists", c.AutoBind[i])
+				return c.errorAt(fmt.Sprintf("autobind[%d]", i), fmt.Errorf("unable to load %s - make sure you're using an import path to a package that exists", c.AutoBind[i]))
 			}
 
 			autobindType := c.lookupAutobindType(p, t)
 			if autobindType != nil {
 				c.Models.Add(t.Name, autobindType.Pkg().Path()+"."+autobindType.Name())
+				if c.autobound == nil {
+					c.autobound = map[string]string{}
+				}
+				c.autobound[t.Name] = c.AutoBind[i]
 				break
 			}
 		}
@@ -752,6 +1032,56 @@ func (c *Config) autobind() error {
 	return nil
 }
 
+// inferEnumModels binds enums that only have their values bound, with enum_values or @goEnum, to the named type of
+// those values. Enums bound to untyped constants still need a model.
+func (c *Config) inferEnumModels() error {
+	for name, entry := range c.Models {
+		if len(entry.Model) > 0 || len(entry.EnumValues) == 0 {
+			continue
+		}
+
+		values := make([]string, 0, len(entry.EnumValues))
+		for value := range entry.EnumValues {
+			values = append(values, value)
+		}
+		sort.Strings(values)
+
+		model := ""
+		for _, value := range values {
+			if entry.EnumValues[value].Value == "" {
+				continue
+			}
+			pkgName, typeName := code.PkgAndType(entry.EnumValues[value].Value)
+			if pkgName == "" {
+				return fmt.Errorf("missing package name for %v", value)
+			}
+			pkg := c.Packages.Load(pkgName)
+			if pkg == nil || pkg.Types == nil {
+				return fmt.Errorf("unable to load %s for enum value %s of %s", pkgName, value, name)
+			}
+			obj := pkg.Types.Scope().Lookup(typeName)
+			if obj == nil {
+				return fmt.Errorf("enum value not found for: %v, of enum: %v", value, name)
+			}
+			named, ok := obj.Type().(*types.Named)
+			if !ok {
+				return fmt.Errorf("model is empty for: %v, but enum value %v is an untyped constant", name, value)
+			}
+			m := named.Obj().Pkg().Path() + "." + named.Obj().Name()
+			if model != "" && model != m {
+				return fmt.Errorf("values of enum %s are bound to go values of different types %s and %s, set its model", name, model, m)
+			}
+			model = m
+		}
+
+		if model != "" {
+			entry.Model = StringList{model}
+			c.Models[name] = entry
+		}
+	}
+	return nil
+}
+
 func (c *Config) lookupAutobindType(p *packages.Package, schemaType *ast.Definition) types.Object {
 	// Try binding to either the original schema type name, or the normalized go type name
 	for _, lookupName := range []string{schemaType.Name, templates.ToGo(schemaType.Name)} {
@@ -801,6 +1131,8 @@ func (c *Config) injectBuiltins() {
 		"Map":    {Model: StringList{"github.com/99designs/gqlgen/graphql.Map"}},
 		"Upload": {Model: StringList{"github.com/99designs/gqlgen/graphql.Upload"}},
 		"Any":    {Model: StringList{"github.com/99designs/gqlgen/graphql.Any"}},
+		// the type of the value of @fallback, whose literals are checked against the type of the field instead
+		"_FallbackValue": {Model: StringList{"github.com/99designs/gqlgen/graphql.Any"}},
 	}
 
 	for typeName, entry := range extraBuiltins {
@@ -810,17 +1142,31 @@ func (c *Config) injectBuiltins() {
 	}
 }
 
+// newPackages returns the package loader of c, which keeps the types of module cache packages in
+// code.DefaultCacheDir with load_cache.
+func (c *Config) newPackages() *code.Packages {
+	opts := []code.Option{code.WithBuildTags(c.GoBuildTags...)}
+	if c.LoadCache {
+		if dir := code.DefaultCacheDir(); dir != "" {
+			opts = append(opts, code.WithPersistentCache(dir))
+		}
+	}
+	return code.NewPackages(opts...)
+}
+
 func (c *Config) LoadSchema() error {
-	if c.Packages != nil {
-		c.Packages = code.NewPackages(
-			code.WithBuildTags(c.GoBuildTags...),
-		)
+	if c.Packages == nil {
+		c.Packages = c.newPackages()
 	}
 
 	if err := c.check(); err != nil {
 		return err
 	}
 
+	if err := c.applyVisibility(); err != nil {
+		return err
+	}
+
 	schema, err := gqlparser.LoadSchema(c.Sources...)
 	if err != nil {
 		return err
@@ -1,6 +1,9 @@
 package config
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -13,6 +16,7 @@ import (
 	"github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/internal/code"
 )
 
@@ -23,6 +27,16 @@ func TestLoadConfig(t *testing.T) {
 	})
 }
 
+func TestBuildCachePath(t *testing.T) {
+	cfg, err := LoadConfig("testdata/cfg/gqlgen.yml")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("testdata", "cfg", ".gqlgen-cache"), cfg.BuildCachePath())
+
+	cfg, err = ReadConfig(strings.NewReader("schema: schema.graphql\n"))
+	require.NoError(t, err)
+	require.Equal(t, ".gqlgen-cache", cfg.BuildCachePath())
+}
+
 func TestReadConfig(t *testing.T) {
 	t.Run("empty config", func(t *testing.T) {
 		_, err := ReadConfig(strings.NewReader(""))
@@ -42,7 +56,28 @@ func TestReadConfig(t *testing.T) {
 		require.NoError(t, err)
 		t.Cleanup(func() { _ = cfgFile.Close() })
 		_, err = ReadConfig(cfgFile)
-		require.EqualError(t, err, "unable to parse config: yaml: unmarshal errors:\n  line 2: field unknown not found in type config.Config")
+		require.EqualError(t, err, "testdata/cfg/unknownkeys.yml:2:1: unknown key unknown")
+	})
+
+	t.Run("suggests keys", func(t *testing.T) {
+		_, err := ReadConfig(strings.NewReader("schema: outer\nexec:\n  filename: generated.go\n  pakage: generated\n"))
+		require.EqualError(t, err, "4:3: unknown key pakage, did you mean package?")
+		var cerr *Error
+		require.ErrorAs(t, err, &cerr)
+		require.Equal(t, "exec.pakage", cerr.Key)
+
+		_, err = ReadConfig(strings.NewReader("schema: outer\nmodels:\n  User:\n    modle: github.com/example/model.User\n"))
+		require.EqualError(t, err, "4:5: unknown key modle, did you mean model?")
+	})
+
+	t.Run("validation errors have positions", func(t *testing.T) {
+		c, err := ReadConfig(strings.NewReader("schema: outer\nexec:\n  filename: generated.go\nmodels:\n  User:\n    model: github.com/example/model\n"))
+		require.NoError(t, err)
+		err = c.check()
+		require.ErrorContains(t, err, "6:12: config.models: model User: invalid type specifier")
+		var cerr *Error
+		require.ErrorAs(t, err, &cerr)
+		require.Equal(t, Position{Line: 6, Column: 12}, cerr.Position)
 	})
 
 	t.Run("globbed filenames", func(t *testing.T) {
@@ -61,6 +96,20 @@ func TestReadConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("provenance", func(t *testing.T) {
+		b, err := os.ReadFile("testdata/cfg/glob.yml")
+		require.NoError(t, err)
+		b = append(b, "provenance_header: true\n"...)
+		c, err := ReadConfig(bytes.NewReader(b))
+		require.NoError(t, err)
+
+		sum := sha256.Sum256(b)
+		require.Equal(t, "gqlgen version "+graphql.Version+", config sha256:"+hex.EncodeToString(sum[:]), c.Provenance())
+
+		c.ProvenanceHeader = false
+		require.Empty(t, c.Provenance())
+	})
+
 	t.Run("unwalkable path", func(t *testing.T) {
 		cfgFile, err := os.Open("testdata/cfg/unwalkable.yml")
 		require.NoError(t, err)
@@ -149,6 +198,97 @@ func TestReferencedPackages(t *testing.T) {
 	})
 }
 
+func TestEnumUnknownValueCheck(t *testing.T) {
+	require.NoError(t, TypeMap{"Color": {UnknownValue: EnumUnknownValue{Action: EnumUnknownNull}}}.Check())
+	require.NoError(t, TypeMap{"Color": {UnknownValue: EnumUnknownValue{Action: EnumUnknownMap, MapTo: "UNKNOWN"}}}.Check())
+
+	err := TypeMap{"Color": {UnknownValue: EnumUnknownValue{Action: EnumUnknownMap}}}.Check()
+	require.EqualError(t, err, "model Color: unknown_value: map_to must be set for the map action")
+
+	err = TypeMap{"Color": {UnknownValue: EnumUnknownValue{Action: EnumUnknownError, MapTo: "UNKNOWN"}}}.Check()
+	require.EqualError(t, err, "model Color: unknown_value: map_to is only used with the map action")
+
+	err = TypeMap{"Color": {UnknownValue: EnumUnknownValue{Action: "ignore"}}}.Check()
+	require.EqualError(t, err, `model Color: unknown_value: invalid action "ignore", expected one of error, null or map`)
+}
+
+func TestMapValueCheck(t *testing.T) {
+	require.NoError(t, TypeMap{"Stock": {MapValue: "Int!"}}.Check())
+	require.Equal(t, &ast.Type{NamedType: "Int", NonNull: true}, TypeMapEntry{MapValue: "Int!"}.MapValueType())
+	require.Equal(t, &ast.Type{NamedType: "Status"}, TypeMapEntry{MapValue: "Status"}.MapValueType())
+	require.Nil(t, TypeMapEntry{}.MapValueType())
+
+	err := TypeMap{"Stock": {MapValue: "[Int!]"}}.Check()
+	require.EqualError(t, err, `model Stock: map_value "[Int!]" must name a type, like Int or Int!`)
+
+	err = TypeMap{"Stock": {Model: StringList{"github.com/example/stock.Stock"}, MapValue: "Int"}}.Check()
+	require.EqualError(t, err, "model Stock: map_value can't be used with model")
+}
+
+func TestBuiltinDirectives(t *testing.T) {
+	cfg := Config{
+		Directives: map[string]DirectiveConfig{"cached": {}},
+		Schema: gqlparser.MustLoadSchema(&ast.Source{Input: `
+			directive @default(value: Int) on INPUT_FIELD_DEFINITION
+			directive @retry(attempts: Int!, backoff: String) on FIELD_DEFINITION
+			directive @cached(ttl: String!, key: String) on FIELD_DEFINITION
+			directive @masked on FIELD_DEFINITION
+			directive @example(value: String!) repeatable on FIELD_DEFINITION
+			directive @patch on SUBSCRIPTION | QUERY
+			type Query { name: String }
+		`}),
+	}
+	cfg.injectBuiltinDirectives()
+
+	require.True(t, cfg.Directives["default"].Builtin(), "the value of @default can have any type")
+	require.True(t, cfg.Directives["retry"].Builtin())
+	require.False(t, cfg.Directives["cached"].Builtin(), "configured directives are left to the user")
+	require.False(t, cfg.Directives["masked"].Builtin(), "the arguments don't match")
+	require.False(t, cfg.Directives["example"].Builtin(), "the builtin isn't repeatable")
+	require.False(t, cfg.Directives["patch"].Builtin(), "the builtin isn't supported on queries")
+	require.NotContains(t, cfg.Directives, "encrypted", "undeclared directives aren't claimed")
+
+	// the executables share the map of the config they are made from
+	exec := cfg
+	exec.Schema = gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @retry(times: Int!) on FIELD_DEFINITION
+		type Query { name: String }
+	`})
+	exec.injectBuiltinDirectives()
+	require.False(t, exec.Directives["retry"].Builtin())
+	require.True(t, cfg.Directives["retry"].Builtin())
+}
+
+func TestInferEnumModels(t *testing.T) {
+	cfg := Config{
+		Packages: code.NewPackages(),
+		Models: TypeMap{
+			"Qux": {EnumValues: map[string]EnumValue{
+				"LOW":  {Value: "github.com/99designs/gqlgen/codegen/config/testdata/enum.QuxLow"},
+				"HIGH": {Value: "github.com/99designs/gqlgen/codegen/config/testdata/enum.QuxHigh"},
+			}},
+			"Mixed": {EnumValues: map[string]EnumValue{
+				"LOW": {Value: "github.com/99designs/gqlgen/codegen/config/testdata/enum.QuxLow"},
+				"ONE": {Value: "github.com/99designs/gqlgen/codegen/config/testdata/enum.BarOne"},
+			}},
+		},
+	}
+
+	err := cfg.inferEnumModels()
+	require.EqualError(t, err, "values of enum Mixed are bound to go values of different types github.com/99designs/gqlgen/codegen/config/testdata/enum.Qux and github.com/99designs/gqlgen/codegen/config/testdata/enum.Bar, set its model")
+
+	delete(cfg.Models, "Mixed")
+	cfg.Models["Baz"] = TypeMapEntry{EnumValues: map[string]EnumValue{
+		"ONE": {Value: "github.com/99designs/gqlgen/codegen/config/testdata/enum.BazOne"},
+	}}
+	// untyped constants don't say which type to use
+	require.EqualError(t, cfg.inferEnumModels(), "model is empty for: Baz, but enum value ONE is an untyped constant")
+
+	delete(cfg.Models, "Baz")
+	require.NoError(t, cfg.inferEnumModels())
+	require.Equal(t, StringList{"github.com/99designs/gqlgen/codegen/config/testdata/enum.Qux"}, cfg.Models["Qux"].Model)
+}
+
 func TestConfigCheck(t *testing.T) {
 	for _, execLayout := range []ExecLayout{ExecLayoutSingleFile, ExecLayoutFollowSchema} {
 		t.Run(string(execLayout), func(t *testing.T) {
@@ -191,6 +331,59 @@ func TestConfigCheck(t *testing.T) {
 	}
 }
 
+func TestExecBuildTags(t *testing.T) {
+	exec := ExecConfig{Filename: "generated/exec.go", BuildTags: "!graphql_stub"}
+	require.NoError(t, exec.Check())
+	require.Equal(t, "graphql_stub", exec.StubBuildTags())
+	require.Equal(t, "exec_stub.go", filepath.Base(exec.StubFilename()))
+
+	exec.BuildTags = "linux && !graphql_stub"
+	require.Equal(t, "!(linux && !graphql_stub)", exec.StubBuildTags())
+
+	exec.BuildTags = "linux &&"
+	require.EqualError(t, exec.Check(), `invalid build_tags "linux &&": unexpected end of expression`)
+}
+
+func TestExecutables(t *testing.T) {
+	newConfig := func(executables ...ExecutableConfig) *Config {
+		cfg := DefaultConfig()
+		cfg.Exec = ExecConfig{Filename: "public/generated.go", Package: "public"}
+		cfg.Executables = executables
+		return cfg
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		cfg := newConfig(ExecutableConfig{
+			SchemaFilename: StringList{"public.graphql", "admin.graphql"},
+			Exec:           ExecConfig{Filename: "admin/generated.go", Package: "admin"},
+		})
+		require.NoError(t, cfg.check())
+	})
+
+	t.Run("same package", func(t *testing.T) {
+		cfg := newConfig(ExecutableConfig{
+			SchemaFilename: StringList{"admin.graphql"},
+			Exec:           ExecConfig{Filename: "public/admin_generated.go", Package: "public"},
+		})
+		require.ErrorContains(t, cfg.check(), "config.executables: exec and executables[0].exec generate to the same package")
+	})
+
+	t.Run("no schema", func(t *testing.T) {
+		cfg := newConfig(ExecutableConfig{
+			Exec: ExecConfig{Filename: "admin/generated.go", Package: "admin"},
+		})
+		require.EqualError(t, cfg.check(), "config.executables[0]: schema must be specified")
+	})
+
+	t.Run("all sources", func(t *testing.T) {
+		public := &ast.Source{Name: "public.graphql"}
+		admin := &ast.Source{Name: "admin.graphql"}
+		cfg := newConfig(ExecutableConfig{Sources: []*ast.Source{{Name: "public.graphql"}, admin}})
+		cfg.Sources = []*ast.Source{public}
+		require.Equal(t, []*ast.Source{public, admin}, cfg.AllSources())
+	})
+}
+
 func TestAutobinding(t *testing.T) {
 	t.Run("valid paths", func(t *testing.T) {
 		cfg := Config{
@@ -238,6 +431,38 @@ func TestAutobinding(t *testing.T) {
 		require.Equal(t, "github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat.ChatAPI", cfg.Models["ChatAPI"].Model[0])
 	})
 
+	t.Run("force generated types", func(t *testing.T) {
+		cfg := Config{
+			Models: TypeMap{"ChatAPI": {Model: StringList{"github.com/99designs/gqlgen/graphql.Map"}}},
+			AutoBind: []string{
+				"github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat",
+				"github.com/99designs/gqlgen/codegen/config/testdata/autobinding/scalars/model",
+			},
+			ForceGenerate: StringList{"Mess*", "Chat*"},
+			Packages:      code.NewPackages(),
+		}
+
+		cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "TestAutobinding.schema", Input: `
+			scalar Banned
+			type Message { id: ID }
+			enum ProductSKU { ProductSkuTrial }
+			type ChatAPI { id: ID }
+		`})
+
+		require.NoError(t, cfg.forceGenerate())
+		require.NoError(t, cfg.autobind())
+
+		require.True(t, cfg.Models["Message"].ForceGenerate)
+		require.False(t, cfg.Models.UserDefined("Message"))
+		require.Equal(t, "github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat.ProductSku", cfg.Models["ProductSKU"].Model[0])
+		require.Equal(t, StringList{"github.com/99designs/gqlgen/graphql.Map"}, cfg.Models["ChatAPI"].Model)
+	})
+
+	t.Run("invalid force_generate pattern", func(t *testing.T) {
+		cfg := Config{ForceGenerate: StringList{"Chat["}}
+		require.EqualError(t, cfg.forceGenerate(), `force_generate: invalid pattern "Chat[": syntax error in pattern`)
+	})
+
 	t.Run("with file path", func(t *testing.T) {
 		cfg := Config{
 			Models: TypeMap{},
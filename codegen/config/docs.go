@@ -0,0 +1,37 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// DocsConfig configures the schema documentation written during generate. The format follows the extension of
+// Filename, .md for markdown or .html for a standalone page.
+type DocsConfig struct {
+	Filename string `yaml:"filename,omitempty"`
+	// Title heads the page, the default is "Schema".
+	Title string `yaml:"title,omitempty"`
+}
+
+func (c *DocsConfig) IsDefined() bool {
+	return c.Filename != ""
+}
+
+// IsHTML reports whether the docs are written as html rather than markdown.
+func (c *DocsConfig) IsHTML() bool {
+	ext := strings.ToLower(filepath.Ext(c.Filename))
+	return ext == ".html" || ext == ".htm"
+}
+
+func (c *DocsConfig) Check() error {
+	if !c.IsDefined() {
+		return nil
+	}
+	switch strings.ToLower(filepath.Ext(c.Filename)) {
+	case ".md", ".markdown", ".html", ".htm":
+		return nil
+	default:
+		return fmt.Errorf("filename %s must end in .md or .html", c.Filename)
+	}
+}
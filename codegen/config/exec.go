@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"go/build/constraint"
 	"go/types"
 	"path/filepath"
 	"strings"
@@ -19,6 +20,18 @@ type ExecConfig struct {
 	// Only for follow-schema layout:
 	FilenameTemplate string `yaml:"filename_template,omitempty"` // String template with {name} as placeholder for base name.
 	DirName          string `yaml:"dir"`
+
+	// BuildTags is a build constraint expression, such as "!graphql_stub", written to the generated files. A stub file
+	// built under the negated constraint declares Config, the resolver interfaces and a NewExecutableSchema that
+	// panics, so the code referring to them still compiles without the executable schema.
+	BuildTags string `yaml:"build_tags,omitempty"`
+
+	// VisibilityScope generates the executable schema without the types, fields, arguments and enum values annotated
+	// with @visibility(scopes: [...]) naming other scopes, as if the schema didn't declare them.
+	VisibilityScope string `yaml:"visibility_scope,omitempty"`
+
+	// TemplateOverrides are template files redefining blocks of the exec templates, see templates.Options.Overrides.
+	TemplateOverrides []string `yaml:"template_overrides,omitempty"`
 }
 
 type ExecLayout string
@@ -61,9 +74,40 @@ func (r *ExecConfig) Check() error {
 		r.Package = code.NameForDir(r.Dir())
 	}
 
+	if r.BuildTags != "" {
+		if _, err := constraint.Parse("//go:build " + r.BuildTags); err != nil {
+			return fmt.Errorf("invalid build_tags %q: %w", r.BuildTags, err)
+		}
+	}
+
 	return nil
 }
 
+// StubFilename is the file of the stubs built in place of the generated files when BuildTags excludes them.
+func (r *ExecConfig) StubFilename() string {
+	switch r.Layout {
+	case ExecLayoutSingleFile:
+		return strings.TrimSuffix(r.Filename, ".go") + "_stub.go"
+	case ExecLayoutFollowSchema:
+		return filepath.Join(r.DirName, "stub_.generated.go")
+	default:
+		panic("invalid layout " + r.Layout)
+	}
+}
+
+// StubBuildTags is the build constraint of the stub file, the negation of BuildTags.
+func (r *ExecConfig) StubBuildTags() string {
+	expr, err := constraint.Parse("//go:build " + r.BuildTags)
+	if err != nil {
+		panic(err)
+	}
+	if not, ok := expr.(*constraint.NotExpr); ok {
+		// go:build lines can not negate twice
+		return not.X.String()
+	}
+	return (&constraint.NotExpr{X: expr}).String()
+}
+
 func (r *ExecConfig) ImportPath() string {
 	if r.Dir() == "" {
 		return ""
@@ -0,0 +1,86 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// ExecutableConfig generates another executable schema from its own set of schema files, such as an admin API
+// extending the public one. It shares the models, their bindings and every other option with the main config.
+type ExecutableConfig struct {
+	SchemaFilename StringList     `yaml:"schema"`
+	Exec           ExecConfig     `yaml:"exec"`
+	Resolver       ResolverConfig `yaml:"resolver,omitempty"`
+	Sources        []*ast.Source  `yaml:"-"`
+}
+
+func (e *ExecutableConfig) Check() error {
+	if len(e.SchemaFilename) == 0 {
+		return fmt.Errorf("schema must be specified")
+	}
+	if err := e.Exec.Check(); err != nil {
+		return fmt.Errorf("exec: %w", err)
+	}
+	if e.Resolver.IsDefined() {
+		if err := e.Resolver.Check(); err != nil {
+			return fmt.Errorf("resolver: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkExecutables makes sure every executable schema is generated to its own package, as they declare the same
+// names.
+func (c *Config) checkExecutables() error {
+	if len(c.Executables) == 0 {
+		return nil
+	}
+	if c.Federation.IsDefined() {
+		return fmt.Errorf("executables can't be used with federation")
+	}
+
+	packages := map[string]string{c.Exec.ImportPath(): "exec"}
+	for i, e := range c.Executables {
+		declaree := fmt.Sprintf("executables[%d].exec", i)
+		if other, ok := packages[e.Exec.ImportPath()]; ok {
+			return fmt.Errorf("%s and %s generate to the same package %s", other, declaree, e.Exec.ImportPath())
+		}
+		packages[e.Exec.ImportPath()] = declaree
+	}
+	return nil
+}
+
+// AllSources returns the sources of the main schema and of every executable, each source once, to generate models
+// for the types of all of them.
+func (c *Config) AllSources() []*ast.Source {
+	sources := append([]*ast.Source{}, c.Sources...)
+	seen := map[string]bool{}
+	for _, s := range sources {
+		seen[s.Name] = true
+	}
+	for _, e := range c.Executables {
+		for _, s := range e.Sources {
+			if !seen[s.Name] {
+				seen[s.Name] = true
+				sources = append(sources, s)
+			}
+		}
+	}
+	return sources
+}
+
+// ForExecutable returns a copy of the config generating the executable schema e, with models bound to the given
+// type map.
+func (c *Config) ForExecutable(e ExecutableConfig, models TypeMap) *Config {
+	cfg := *c
+	cfg.SchemaFilename = e.SchemaFilename
+	cfg.Sources = append([]*ast.Source{}, e.Sources...)
+	cfg.Exec = e.Exec
+	cfg.Resolver = e.Resolver
+	cfg.Executables = nil
+	cfg.Docs = DocsConfig{}
+	cfg.Schema = nil
+	cfg.Models = models.Copy()
+	return &cfg
+}
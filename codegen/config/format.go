@@ -0,0 +1,34 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// BuildCacheDir is the directory the formatted source of generated files is cached in with build_cache, next to the
+// config file.
+const BuildCacheDir = ".gqlgen-cache"
+
+// BuildCachePath returns the directory build_cache keeps its files in: BuildCacheDir in the directory of the config
+// file, or in the working directory when the config wasn't read from a file.
+func (c *Config) BuildCachePath() string {
+	return filepath.Join(filepath.Dir(c.filename), BuildCacheDir)
+}
+
+// FormatConfig configures how generated files are formatted, so they pass stricter format checks than gofmt.
+type FormatConfig struct {
+	// Command formats every generated file after gofmt, such as gofumpt. It receives the source on stdin and writes
+	// the formatted source to stdout.
+	Command StringList `yaml:"command,omitempty"`
+	// LocalPrefix lists comma separated import path prefixes grouped after third party imports, like goimports -local.
+	LocalPrefix string `yaml:"local_prefix,omitempty"`
+
+	// cacheDir is BuildCachePath once Init ran with build_cache
+	cacheDir string
+}
+
+// Options returns the format options of templates.Render.
+func (c FormatConfig) Options() templates.Format {
+	return templates.Format{Command: c.Command, LocalPrefix: c.LocalPrefix, CacheDir: c.cacheDir}
+}
@@ -0,0 +1,121 @@
+package config
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+// InterfaceMismatch is a difference between the possible types of an interface or union and the go types implementing
+// the go interface it is bound to.
+type InterfaceMismatch struct {
+	// Type is the interface or union of the schema.
+	Type    string
+	Message string
+}
+
+// InterfaceCoverage compares the interfaces and unions bound to a go interface by the models config with the types of
+// the models and autobind packages: it returns the go types implementing the interface that no possible type is bound
+// to, and the possible types whose model does not implement it. Possible types without a models entry are skipped.
+func (c *Config) InterfaceCoverage() ([]InterfaceMismatch, error) {
+	var defs []*ast.Definition
+	for _, def := range c.Schema.Types {
+		if (def.Kind == ast.Interface || def.Kind == ast.Union) && len(c.Models[def.Name].Model) > 0 {
+			defs = append(defs, def)
+		}
+	}
+	if len(defs) == 0 {
+		return nil, nil
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+
+	if c.Packages == nil {
+		c.Packages = c.newPackages()
+	}
+	pkgs := c.Packages.LoadAll(c.packageList()...)
+
+	var mismatches []InterfaceMismatch
+	for _, def := range defs {
+		named, iface := c.boundInterface(def.Name)
+		if iface == nil {
+			continue
+		}
+		report := func(format string, args ...interface{}) {
+			mismatches = append(mismatches, InterfaceMismatch{Type: def.Name, Message: fmt.Sprintf(format, args...)})
+		}
+
+		var bound []types.Type
+		for _, impl := range c.Schema.GetPossibleTypes(def) {
+			models := c.modelTypes(impl.Name)
+			if len(models) == 0 {
+				continue
+			}
+			bound = append(bound, models...)
+			implements := false
+			for _, m := range models {
+				implements = implements || types.Implements(m, iface) || types.Implements(types.NewPointer(m), iface)
+			}
+			if !implements {
+				report("%s is a possible type of %s, but its model %s does not implement %s", impl.Name, def.Name, types.TypeString(models[0], nil), named)
+			}
+		}
+
+		for _, p := range pkgs {
+			if p == nil || p.Types == nil || strings.HasPrefix(p.PkgPath, "github.com/99designs/gqlgen/graphql") {
+				continue
+			}
+			scope := p.Types.Scope()
+			for _, name := range scope.Names() {
+				obj, ok := scope.Lookup(name).(*types.TypeName)
+				if !ok || !obj.Exported() || obj.IsAlias() || types.IsInterface(obj.Type()) {
+					continue
+				}
+				if !types.Implements(obj.Type(), iface) && !types.Implements(types.NewPointer(obj.Type()), iface) {
+					continue
+				}
+				isBound := false
+				for _, b := range bound {
+					isBound = isBound || types.Identical(b, obj.Type())
+				}
+				if !isBound {
+					report("%s.%s implements %s, but is not the model of a possible type of %s", p.PkgPath, name, named, def.Name)
+				}
+			}
+		}
+	}
+	return mismatches, nil
+}
+
+// boundInterface returns the first go interface the models entry of name binds to, skipping empty interfaces.
+func (c *Config) boundInterface(name string) (string, *types.Interface) {
+	for _, t := range c.modelTypes(name) {
+		if iface, ok := t.Underlying().(*types.Interface); ok && !iface.Empty() {
+			return types.TypeString(t, nil), iface
+		}
+	}
+	return "", nil
+}
+
+// modelTypes returns the go types of the models entry of name that can be found.
+func (c *Config) modelTypes(name string) []types.Type {
+	var res []types.Type
+	for _, model := range c.Models[name].Model {
+		pkgName, typeName := code.PkgAndType(model)
+		if pkgName == "" {
+			continue
+		}
+		p := c.Packages.Load(pkgName)
+		if p == nil || p.Types == nil {
+			continue
+		}
+		if obj, ok := p.Types.Scope().Lookup(typeName).(*types.TypeName); ok {
+			res = append(res, obj.Type())
+		}
+	}
+	return res
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+func TestInterfaceCoverage(t *testing.T) {
+	const pkg = "github.com/99designs/gqlgen/codegen/config/testdata/coverage"
+	cfg := Config{
+		Models: TypeMap{
+			"Node": {Model: StringList{pkg + ".Node"}},
+			"User": {Model: StringList{pkg + ".User"}},
+			"Post": {Model: StringList{pkg + ".Post"}},
+			"Tag":  {Model: StringList{pkg + ".Tag"}},
+		},
+		Packages: code.NewPackages(),
+	}
+	cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "TestInterfaceCoverage.schema", Input: `
+		type Query { node: Node result: Result }
+		interface Node { id: ID }
+		type User implements Node { id: ID }
+		type Post implements Node { id: ID }
+		type Tag implements Node { id: ID }
+		type Generated implements Node { id: ID }
+		union Result = User | Post
+	`})
+
+	mismatches, err := cfg.InterfaceCoverage()
+	require.NoError(t, err)
+	require.Equal(t, []InterfaceMismatch{
+		{Type: "Node", Message: "Tag is a possible type of Node, but its model " + pkg + ".Tag does not implement " + pkg + ".Node"},
+		{Type: "Node", Message: pkg + ".Comment implements " + pkg + ".Node, but is not the model of a possible type of Node"},
+	}, mismatches)
+}
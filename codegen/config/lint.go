@@ -0,0 +1,44 @@
+package config
+
+import "fmt"
+
+// LintSeverity controls what happens when a lint rule finds a problem.
+type LintSeverity string
+
+const (
+	LintOff   LintSeverity = "off"
+	LintWarn  LintSeverity = "warn"
+	LintError LintSeverity = "error"
+)
+
+// LintConfig configures the schema linter. When defined, it runs as part of generate and fails it if any rule
+// configured as an error finds a problem.
+type LintConfig struct {
+	// Rules maps rule names to their severity, rules that aren't listed are reported as warnings.
+	Rules map[string]LintSeverity `yaml:"rules,omitempty"`
+	// SkipOnGenerate only runs the linter from the gqlgen lint command.
+	SkipOnGenerate bool `yaml:"skip_on_generate,omitempty"`
+}
+
+func (c *LintConfig) IsDefined() bool {
+	return c.Rules != nil || c.SkipOnGenerate
+}
+
+// Severity returns the configured severity of rule.
+func (c *LintConfig) Severity(rule string) LintSeverity {
+	if s, ok := c.Rules[rule]; ok {
+		return s
+	}
+	return LintWarn
+}
+
+func (c *LintConfig) Check() error {
+	for rule, severity := range c.Rules {
+		switch severity {
+		case LintOff, LintWarn, LintError:
+		default:
+			return fmt.Errorf("rule %s has invalid severity %q, expected one of off, warn or error", rule, severity)
+		}
+	}
+	return nil
+}
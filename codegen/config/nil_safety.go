@@ -0,0 +1,32 @@
+package config
+
+import "fmt"
+
+// NilSafety controls how generated code handles resolvers returning nil, or a value of the wrong type, for fields the
+// schema doesn't allow to be null.
+type NilSafety string
+
+const (
+	// NilSafetyOff keeps the default behaviour, where some of these cases panic or silently null the parent.
+	NilSafetyOff NilSafety = ""
+	// NilSafetyErrors always reports them as field errors with the path and location of the field, and never panics.
+	NilSafetyErrors NilSafety = "errors"
+	// NilSafetyStrict reports them like NilSafetyErrors and also logs the resolver and the go type of the value.
+	NilSafetyStrict NilSafety = "strict"
+)
+
+func (n NilSafety) IsEnabled() bool {
+	return n != NilSafetyOff
+}
+
+func (n NilSafety) IsStrict() bool {
+	return n == NilSafetyStrict
+}
+
+func (n NilSafety) Check() error {
+	switch n {
+	case NilSafetyOff, NilSafetyErrors, NilSafetyStrict:
+		return nil
+	}
+	return fmt.Errorf("invalid value %q, expected errors or strict", string(n))
+}
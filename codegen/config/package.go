@@ -15,6 +15,17 @@ type PackageConfig struct {
 	Version       int             `yaml:"version,omitempty"`
 	ModelTemplate string          `yaml:"model_template,omitempty"`
 	Options       map[string]bool `yaml:"options,omitempty"`
+	// EntityConcurrency limits how many federation entity resolvers run at once, 0 means no limit.
+	EntityConcurrency int `yaml:"entity_concurrency,omitempty"`
+	// Overrides maps Type.field to the @override emitted for it in the federation SDL, only used by federation 2.
+	Overrides map[string]FederationOverride `yaml:"overrides,omitempty"`
+}
+
+// FederationOverride takes over a field from another subgraph, a Label makes the router only send the share of
+// traffic it selects, eg percent(10), so a field can be migrated gradually.
+type FederationOverride struct {
+	From  string `yaml:"from"`
+	Label string `yaml:"label,omitempty"`
 }
 
 func (c *PackageConfig) ImportPath() string {
@@ -0,0 +1,181 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/validator"
+	"gopkg.in/yaml.v3"
+)
+
+// Position is the position of a value in the config file, lines and columns start at 1.
+type Position struct {
+	Filename string
+	Line     int
+	Column   int
+}
+
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.Filename
+	}
+	if p.Filename == "" {
+		return fmt.Sprintf("%d:%d", p.Line, p.Column)
+	}
+	return fmt.Sprintf("%s:%d:%d", p.Filename, p.Line, p.Column)
+}
+
+// Error is an error of the config, at the position of the key it is about.
+type Error struct {
+	Position Position
+	// Key is the path of the key in the config, like models.User.model or executables[0].exec.
+	Key string
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Position.String() + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// errorAt returns err at the position of key in the config file, or of its closest parent when key is not in the file.
+// err is returned as is when it already has a position, or when the config was not read from a file.
+func (c *Config) errorAt(key string, err error) error {
+	var cerr *Error
+	if err == nil || errors.As(err, &cerr) {
+		return err
+	}
+	for k := key; k != ""; k = parentKey(k) {
+		if pos, ok := c.positions[k]; ok {
+			return &Error{Position: pos, Key: key, Err: err}
+		}
+	}
+	if c.filename == "" {
+		return err
+	}
+	return &Error{Position: Position{Filename: c.filename}, Key: key, Err: err}
+}
+
+func parentKey(key string) string {
+	if i := strings.LastIndexAny(key, ".["); i >= 0 {
+		return key[:i]
+	}
+	return ""
+}
+
+// readPositions records the position of every key of node, a value of type t at key, and fails on the keys t has no
+// field for, suggesting the closest field.
+func (c *Config) readPositions(node *yaml.Node, t reflect.Type, key string) error {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t != nil {
+		// types unmarshaling themselves, like StringList, are recorded but not checked
+		if _, ok := reflect.PointerTo(t).MethodByName("UnmarshalYAML"); ok {
+			t = nil
+		}
+	}
+
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, n := range node.Content {
+			if err := c.readPositions(n, t, key); err != nil {
+				return err
+			}
+		}
+	case yaml.MappingNode:
+		var fields map[string]reflect.Type
+		if t != nil && t.Kind() == reflect.Struct {
+			fields = map[string]reflect.Type{}
+			yamlFields(t, fields)
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			k, v := node.Content[i], node.Content[i+1]
+			if k.Value == "<<" {
+				continue
+			}
+			child := k.Value
+			if key != "" {
+				child = key + "." + k.Value
+			}
+			var elem reflect.Type
+			switch {
+			case fields != nil:
+				var ok bool
+				if elem, ok = fields[k.Value]; !ok {
+					return &Error{Position: c.position(k), Key: child, Err: unknownKey(k.Value, fields)}
+				}
+			case t != nil && t.Kind() == reflect.Map:
+				elem = t.Elem()
+			}
+			if v.Kind == yaml.ScalarNode {
+				c.positions[child] = c.position(v)
+			} else {
+				c.positions[child] = c.position(k)
+			}
+			if err := c.readPositions(v, elem, child); err != nil {
+				return err
+			}
+		}
+	case yaml.SequenceNode:
+		var elem reflect.Type
+		if t != nil && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			elem = t.Elem()
+		}
+		for i, v := range node.Content {
+			child := fmt.Sprintf("%s[%d]", key, i)
+			c.positions[child] = c.position(v)
+			if err := c.readPositions(v, elem, child); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Config) position(n *yaml.Node) Position {
+	return Position{Filename: c.filename, Line: n.Line, Column: n.Column}
+}
+
+// yamlFields adds the keys of the fields of struct t, and of the structs it inlines, to fields.
+func yamlFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(f.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		if strings.Contains(opts, "inline") {
+			yamlFields(f.Type, fields)
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		fields[name] = f.Type
+	}
+}
+
+func unknownKey(name string, fields map[string]reflect.Type) error {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if suggestions := validator.SuggestionList(name, keys); len(suggestions) > 0 {
+		return fmt.Errorf("unknown key %s, did you mean %s?", name, suggestions[0])
+	}
+	return fmt.Errorf("unknown key %s", name)
+}
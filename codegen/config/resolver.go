@@ -18,6 +18,8 @@ type ResolverConfig struct {
 	DirName             string         `yaml:"dir"`
 	OmitTemplateComment bool           `yaml:"omit_template_comment,omitempty"`
 	ResolverTemplate    string         `yaml:"resolver_template,omitempty"`
+	// TemplateOverrides are template files redefining blocks of the resolver template, see templates.Options.Overrides.
+	TemplateOverrides []string `yaml:"template_overrides,omitempty"`
 }
 
 type ResolverLayout string
@@ -0,0 +1,21 @@
+package coverage
+
+type Node interface {
+	IsNode()
+}
+
+type User struct{}
+
+func (User) IsNode() {}
+
+type Post struct{}
+
+func (*Post) IsNode() {}
+
+// Comment implements Node but is not a possible type of Node in the schema.
+type Comment struct{}
+
+func (Comment) IsNode() {}
+
+// Tag is a possible type of Node in the schema but does not implement it.
+type Tag struct{}
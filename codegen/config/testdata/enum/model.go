@@ -11,3 +11,12 @@ const (
 	BazOne = iota + 1
 	BazTwo
 )
+
+type Qux int
+
+const (
+	QuxLow  Qux = 10
+	QuxHigh Qux = 50
+	// QuxDefault is an alias of QuxLow
+	QuxDefault = QuxLow
+)
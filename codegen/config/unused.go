@@ -0,0 +1,135 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// UnusedModels returns the names of models entries that don't correspond to any type in the schema, they are left
+// behind when a type is removed or renamed and are otherwise silently ignored. The schema must already be loaded.
+func (c *Config) UnusedModels() []string {
+	var unused []string
+	for name := range c.Models {
+		if _, ok := c.Schema.Types[name]; !ok {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	return unused
+}
+
+// UnusedAutobindStructs returns the exported structs in the autobind packages that no schema type would bind to and
+// that no models entry references, as fully qualified go type names.
+func (c *Config) UnusedAutobindStructs() ([]string, error) {
+	if len(c.AutoBind) == 0 {
+		return nil, nil
+	}
+	if c.Packages == nil {
+		c.Packages = c.newPackages()
+	}
+
+	bound := map[string]bool{}
+	for _, entry := range c.Models {
+		for _, m := range entry.Model {
+			bound[m] = true
+		}
+	}
+	names := map[string]bool{}
+	for _, def := range c.Schema.Types {
+		names[def.Name] = true
+		names[templates.ToGo(def.Name)] = true
+	}
+
+	var unused []string
+	for i, p := range c.Packages.LoadAll(c.AutoBind...) {
+		if p == nil || p.Types == nil {
+			return nil, fmt.Errorf("unable to load %s - make sure you're using an import path to a package that exists", c.AutoBind[i])
+		}
+		scope := p.Types.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || !obj.Exported() || names[name] || bound[p.PkgPath+"."+name] {
+				continue
+			}
+			if _, ok := obj.Type().Underlying().(*types.Struct); ok {
+				unused = append(unused, p.PkgPath+"."+name)
+			}
+		}
+	}
+	sort.Strings(unused)
+	return unused, nil
+}
+
+// PruneModels removes the named entries from the models section of the config file src, leaving every other line,
+// including comments, untouched.
+func PruneModels(src []byte, names []string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(src, &doc); err != nil {
+		return nil, fmt.Errorf("unable to parse config: %w", err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return src, nil
+	}
+	root := doc.Content[0]
+
+	lines := bytes.SplitAfter(src, []byte("\n"))
+	remove := make([]bool, len(lines))
+
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value != "models" || root.Content[i+1].Kind != yaml.MappingNode {
+			continue
+		}
+		// the section ends where the next top level key starts
+		end := len(lines) + 1
+		if i+2 < len(root.Content) {
+			end = root.Content[i+2].Line
+		}
+
+		models := root.Content[i+1].Content
+		for j := 0; j+1 < len(models); j += 2 {
+			if !contains(names, models[j].Value) {
+				continue
+			}
+			last := end
+			if j+2 < len(models) {
+				last = models[j+2].Line
+			}
+			// leave trailing blank lines and comments in place, they usually belong to whatever follows
+			for last-1 > models[j].Line && isBlankOrComment(lines[last-2]) {
+				last--
+			}
+			for l := models[j].Line; l < last; l++ {
+				remove[l-1] = true
+			}
+		}
+	}
+
+	var out bytes.Buffer
+	for i, line := range lines {
+		if !remove[i] {
+			out.Write(line)
+		}
+	}
+	return out.Bytes(), nil
+}
+
+func isBlankOrComment(line []byte) bool {
+	s := strings.TrimSpace(string(line))
+	return s == "" || strings.HasPrefix(s, "#")
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+func TestUnusedModels(t *testing.T) {
+	cfg := Config{
+		Models: TypeMap{
+			"Message": {Model: StringList{"github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat.Message"}},
+			"Removed": {Model: StringList{"github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat.Message"}},
+			"Renamed": {Model: StringList{"github.com/99designs/gqlgen/graphql.String"}},
+		},
+		AutoBind: []string{"github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat"},
+		Packages: code.NewPackages(),
+	}
+	cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "TestUnusedModels.schema", Input: `
+		type Query { message: Message }
+		type Message { id: ID }
+	`})
+
+	require.Equal(t, []string{"Removed", "Renamed"}, cfg.UnusedModels())
+
+	unused, err := cfg.UnusedAutobindStructs()
+	require.NoError(t, err)
+	require.Equal(t, []string{"github.com/99designs/gqlgen/codegen/config/testdata/autobinding/chat.ChatAPI"}, unused)
+}
+
+func TestPruneModels(t *testing.T) {
+	src := `schema:
+  - schema.graphql
+
+models:
+  Removed:
+    model: example.com/model.Removed
+  # keep this comment
+  Kept:
+    model: example.com/model.Kept
+    fields:
+      id:
+        resolver: true
+  Renamed:
+    model:
+      - example.com/model.Renamed
+
+# trailing comment
+autobind:
+  - example.com/model
+`
+	pruned, err := PruneModels([]byte(src), []string{"Removed", "Renamed"})
+	require.NoError(t, err)
+	require.Equal(t, `schema:
+  - schema.graphql
+
+models:
+  # keep this comment
+  Kept:
+    model: example.com/model.Kept
+    fields:
+      id:
+        resolver: true
+
+# trailing comment
+autobind:
+  - example.com/model
+`, string(pruned))
+}
@@ -0,0 +1,256 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// visibilityDirective restricts the types, fields, arguments and enum values it annotates to the executables generated
+// for the scopes it lists, like @visibility(scopes: ["admin"]).
+const visibilityDirective = "visibility"
+
+// applyVisibility rewrites the sources declaring schema elements outside the visibility scope of the exec without
+// them. Elements referring to hidden types are hidden too, as are the types left without fields, union members or enum
+// values, which are logged along with the rules emptying them. The @visibility directive is removed entirely so the
+// executable schema doesn't tell which scopes exist.
+func (c *Config) applyVisibility() error {
+	scope := c.Exec.VisibilityScope
+	if scope == "" {
+		return nil
+	}
+
+	docs := make([]*ast.SchemaDocument, len(c.Sources))
+	for i, s := range c.Sources {
+		if s.BuiltIn {
+			continue
+		}
+		doc, err := parser.ParseSchema(s)
+		if err != nil {
+			return err
+		}
+		docs[i] = doc
+	}
+
+	changed, dropped := filterDocuments(docs, scope)
+	for _, d := range dropped {
+		log.Printf("visibility_scope %s: %s", scope, d)
+	}
+
+	sources := append([]*ast.Source{}, c.Sources...)
+	for i, doc := range docs {
+		if doc == nil || !changed[i] {
+			continue
+		}
+		var sb strings.Builder
+		formatter.NewFormatter(&sb, formatter.WithComments()).FormatSchemaDocument(doc)
+		sources[i] = &ast.Source{Name: c.Sources[i].Name, Input: sb.String()}
+		if c.rewrittenSources == nil {
+			c.rewrittenSources = map[*ast.Source]bool{}
+		}
+		c.rewrittenSources[sources[i]] = true
+	}
+	c.Sources = sources
+	return nil
+}
+
+// IsRewritten reports whether the source was rewritten from its file by visibility_scope, which means the file can't be
+// embedded in place of the source.
+func (c *Config) IsRewritten(s *ast.Source) bool {
+	return c.rewrittenSources[s]
+}
+
+// filterDocuments removes the elements of docs hidden from scope, reporting which docs changed. The types emptied by
+// the removal are dropped in turn, until no more type is emptied, and described in dropped along with the rules
+// removing their members.
+func filterDocuments(docs []*ast.SchemaDocument, scope string) (changed []bool, dropped []string) {
+	f := &visibilityFilter{scope: scope, hidden: map[string]string{}, removed: map[string][]string{}}
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, def := range doc.Definitions {
+			if !visibleIn(def.Directives, scope) {
+				f.hidden[def.Name] = "@visibility on " + def.Name
+			}
+		}
+	}
+
+	before := countMembers(docs)
+	changed = make([]bool, len(docs))
+	for {
+		for i, doc := range docs {
+			if doc != nil && f.filter(doc) {
+				changed[i] = true
+			}
+		}
+
+		after := countMembers(docs)
+		var emptied []string
+		for name, n := range before {
+			if n > 0 && after[name] == 0 && f.hidden[name] == "" {
+				emptied = append(emptied, name)
+			}
+		}
+		if len(emptied) == 0 {
+			return changed, dropped
+		}
+		sort.Strings(emptied)
+		for _, name := range emptied {
+			f.hidden[name] = strings.Join(f.removed[name], ", ")
+			dropped = append(dropped, fmt.Sprintf("dropped %s, emptied by %s", name, f.hidden[name]))
+		}
+	}
+}
+
+// visibilityFilter removes the elements hidden from scope from schema documents.
+type visibilityFilter struct {
+	scope string
+	// hidden maps the hidden types to the rules hiding them, the @visibility directive of the type or the rules
+	// emptying it.
+	hidden map[string]string
+	// removed maps types to the rules removing their fields, union members and enum values.
+	removed map[string][]string
+}
+
+// filter removes the elements of doc hidden from scope, reporting whether anything changed.
+func (f *visibilityFilter) filter(doc *ast.SchemaDocument) bool {
+	changed := false
+	// rule returns the rules hiding the element at path, or an empty string when it is visible.
+	rule := func(dirs ast.DirectiveList, typeName, path string) string {
+		if dirs.ForName(visibilityDirective) != nil {
+			changed = true
+		}
+		if r := f.hidden[typeName]; r != "" {
+			changed = true
+			return r
+		}
+		if !visibleIn(dirs, f.scope) {
+			return "@visibility on " + path
+		}
+		return ""
+	}
+	visible := func(dirs ast.DirectiveList, typeName, path string) bool {
+		return rule(dirs, typeName, path) == ""
+	}
+	// member is visible for the members of def, recording the rules removing them.
+	member := func(def *ast.Definition, dirs ast.DirectiveList, typeName, path string) bool {
+		r := rule(dirs, typeName, path)
+		if r == "" {
+			return true
+		}
+		if !slices.Contains(f.removed[def.Name], r) {
+			f.removed[def.Name] = append(f.removed[def.Name], r)
+		}
+		return false
+	}
+
+	doc.Directives = filterList(doc.Directives, func(d *ast.DirectiveDefinition) bool {
+		if d.Name == visibilityDirective {
+			changed = true
+			return false
+		}
+		return true
+	})
+	for _, schema := range append(append(ast.SchemaDefinitionList{}, doc.Schema...), doc.SchemaExtension...) {
+		schema.OperationTypes = filterList(schema.OperationTypes, func(op *ast.OperationTypeDefinition) bool {
+			return visible(nil, op.Type, "")
+		})
+	}
+
+	doc.Definitions = filterList(doc.Definitions, func(def *ast.Definition) bool {
+		return visible(def.Directives, def.Name, def.Name)
+	})
+	doc.Extensions = filterList(doc.Extensions, func(def *ast.Definition) bool {
+		return member(def, def.Directives, def.Name, "extend "+def.Name)
+	})
+	for _, def := range append(append(ast.DefinitionList{}, doc.Definitions...), doc.Extensions...) {
+		def.Directives = withoutVisibility(def.Directives)
+		def.Interfaces = filterList(def.Interfaces, func(name string) bool {
+			return visible(nil, name, "")
+		})
+		def.Types = filterList(def.Types, func(name string) bool {
+			return member(def, nil, name, "")
+		})
+		def.Fields = filterList(def.Fields, func(field *ast.FieldDefinition) bool {
+			return member(def, field.Directives, field.Type.Name(), def.Name+"."+field.Name)
+		})
+		for _, field := range def.Fields {
+			field.Directives = withoutVisibility(field.Directives)
+			field.Arguments = filterList(field.Arguments, func(arg *ast.ArgumentDefinition) bool {
+				return visible(arg.Directives, arg.Type.Name(), def.Name+"."+field.Name+"("+arg.Name+":)")
+			})
+			for _, arg := range field.Arguments {
+				arg.Directives = withoutVisibility(arg.Directives)
+			}
+		}
+		def.EnumValues = filterList(def.EnumValues, func(value *ast.EnumValueDefinition) bool {
+			return member(def, value.Directives, "", def.Name+"."+value.Name)
+		})
+		for _, value := range def.EnumValues {
+			value.Directives = withoutVisibility(value.Directives)
+		}
+	}
+	return changed
+}
+
+// countMembers counts the fields, union members and enum values of each type, across its definition and extensions.
+func countMembers(docs []*ast.SchemaDocument) map[string]int {
+	count := map[string]int{}
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, def := range append(append(ast.DefinitionList{}, doc.Definitions...), doc.Extensions...) {
+			count[def.Name] += len(def.Fields) + len(def.Types) + len(def.EnumValues)
+		}
+	}
+	return count
+}
+
+// visibleIn reports whether an element with the given directives is visible to scope. Elements without @visibility are
+// visible to every scope.
+func visibleIn(dirs ast.DirectiveList, scope string) bool {
+	d := dirs.ForName(visibilityDirective)
+	if d == nil {
+		return true
+	}
+	scopes := d.Arguments.ForName("scopes")
+	if scopes == nil || scopes.Value == nil {
+		return false
+	}
+	if scopes.Value.Kind != ast.ListValue {
+		return scopes.Value.Raw == scope
+	}
+	for _, v := range scopes.Value.Children {
+		if v.Value.Raw == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutVisibility(dirs ast.DirectiveList) ast.DirectiveList {
+	return filterList(dirs, func(d *ast.Directive) bool {
+		return d.Name != visibilityDirective
+	})
+}
+
+func filterList[T any](list []T, keep func(T) bool) []T {
+	if list == nil {
+		return nil
+	}
+	res := list[:0]
+	for _, v := range list {
+		if keep(v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
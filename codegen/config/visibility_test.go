@@ -0,0 +1,87 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestApplyVisibility(t *testing.T) {
+	types := &ast.Source{Name: "types.graphql", Input: `
+directive @visibility(scopes: [String!]!) on OBJECT | FIELD_DEFINITION | ENUM_VALUE
+
+type Photo { url: String! }
+type Invoice @visibility(scopes: ["billing"]) { total: Int! }
+union Result = Photo | Invoice
+`}
+	query := &ast.Source{Name: "query.graphql", Input: `
+type Query {
+  search: [Result!]!
+  invoices: [Invoice!]!
+}
+`}
+	untouched := &ast.Source{Name: "untouched.graphql", Input: `
+type Video { url: String! }
+`}
+
+	t.Run("scope hiding elements", func(t *testing.T) {
+		cfg := &Config{Sources: []*ast.Source{types, query, untouched}, Exec: ExecConfig{VisibilityScope: "public"}}
+		require.NoError(t, cfg.applyVisibility())
+
+		require.Equal(t, "type Photo {\n\turl: String!\n}\nunion Result = Photo\n", cfg.Sources[0].Input)
+		require.Equal(t, "type Query {\n\tsearch: [Result!]!\n}\n", cfg.Sources[1].Input)
+		require.Same(t, untouched, cfg.Sources[2])
+		require.True(t, cfg.IsRewritten(cfg.Sources[0]))
+		require.False(t, cfg.IsRewritten(untouched))
+
+		// the original sources, which may be shared with other configs, are left as is
+		require.Contains(t, types.Input, "Invoice")
+	})
+
+	t.Run("scope seeing elements", func(t *testing.T) {
+		cfg := &Config{Sources: []*ast.Source{types, query}, Exec: ExecConfig{VisibilityScope: "billing"}}
+		require.NoError(t, cfg.applyVisibility())
+		require.Contains(t, cfg.Sources[0].Input, "type Invoice {")
+		require.NotContains(t, cfg.Sources[0].Input, "@visibility")
+		require.Same(t, query, cfg.Sources[1])
+	})
+
+	t.Run("emptied types", func(t *testing.T) {
+		doc, err := parser.ParseSchema(&ast.Source{Name: "schema.graphql", Input: `
+type Invoice @visibility(scopes: ["billing"]) { total: Int! }
+union Billable = Invoice
+type Stats {
+  revenue: Int! @visibility(scopes: ["billing"])
+  billables: [Billable!]!
+}
+enum Plan { ENTERPRISE @visibility(scopes: ["billing"]) }
+type Query {
+  stats: Stats
+  plans: [Plan!]!
+  name: String!
+}
+`})
+		require.Nil(t, err)
+		changed, dropped := filterDocuments([]*ast.SchemaDocument{doc}, "public")
+		require.Equal(t, []bool{true}, changed)
+		require.Equal(t, []string{
+			"dropped Billable, emptied by @visibility on Invoice",
+			"dropped Plan, emptied by @visibility on Plan.ENTERPRISE",
+			"dropped Stats, emptied by @visibility on Stats.revenue, @visibility on Invoice",
+		}, dropped)
+
+		var sb strings.Builder
+		formatter.NewFormatter(&sb).FormatSchemaDocument(doc)
+		require.Equal(t, "type Query {\n\tname: String!\n}\n", sb.String())
+	})
+
+	t.Run("no scope", func(t *testing.T) {
+		cfg := &Config{Sources: []*ast.Source{types, query}}
+		require.NoError(t, cfg.applyVisibility())
+		require.Same(t, types, cfg.Sources[0])
+	})
+}
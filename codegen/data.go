@@ -1,6 +1,8 @@
 package codegen
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -35,6 +37,13 @@ type Data struct {
 	SubscriptionRoot *Object
 	AugmentedSources []AugmentedSource
 	Plugins          []interface{}
+	// CacheKey identifies everything Data was built from, the exec files are cached under it with build_cache, see
+	// templates.Options.CacheKey. Plugins changing Data in ways the key doesn't cover must change or clear it.
+	CacheKey string
+	// FileCacheKey identifies everything but the schema Data was built from. Each file of the follow-schema layout is
+	// cached under it and the definitions the file is built from, so editing a schema file only renders its own file
+	// and those of the types it touches again. Plugins must clear it along with CacheKey.
+	FileCacheKey string
 }
 
 func (d *Data) HasEmbeddableSources() bool {
@@ -47,6 +56,17 @@ func (d *Data) HasEmbeddableSources() bool {
 	return hasEmbeddableSources
 }
 
+// SchemaHash returns the hex encoded sha256 of the sources joined by newlines, the schema SDL served by the generated
+// SchemaSDL function, so the generated SchemaHash doesn't hash it again at runtime.
+func (d *Data) SchemaHash() string {
+	sdl := make([]string, len(d.AugmentedSources))
+	for i, s := range d.AugmentedSources {
+		sdl[i] = s.Source
+	}
+	sum := sha256.Sum256([]byte(strings.Join(sdl, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
 // AugmentedSource contains extra information about graphql schema files which is not known directly from the Config.Sources data
 type AugmentedSource struct {
 	// path relative to Config.Exec.Filename
@@ -61,6 +81,8 @@ type builder struct {
 	Schema     *ast.Schema
 	Binder     *config.Binder
 	Directives map[string]*Directive
+	// InterfaceResolvers maps interface names to the fields resolved once for all their implementors
+	InterfaceResolvers map[string]*Object
 }
 
 // Get only the directives which are defined in the config's sources.
@@ -77,6 +99,37 @@ func (d *Data) Directives() DirectiveList {
 	return res
 }
 
+// BoundEnums returns a reference to each enum whose values are bound to go values, sorted by name, to generate its All
+// and IsValid helpers from when EnumHelpers is set.
+func (d *Data) BoundEnums() []*config.TypeReference {
+	if !d.Config.EnumHelpers {
+		return nil
+	}
+	seen := map[string]bool{}
+	var res []*config.TypeReference
+	for _, ref := range d.ReferencedTypes {
+		if !ref.HasEnumValues() || seen[ref.Definition.Name] {
+			continue
+		}
+		seen[ref.Definition.Name] = true
+		res = append(res, ref)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Definition.Name < res[j].Definition.Name })
+	return res
+}
+
+// InterfaceResolvers returns the objects holding the fields resolved on interfaces, sorted by interface name.
+func (d *Data) InterfaceResolvers() Objects {
+	var res Objects
+	for _, i := range d.Interfaces {
+		if i.Resolvers != nil {
+			res = append(res, i.Resolvers)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
 func BuildData(cfg *config.Config, plugins ...interface{}) (*Data, error) {
 	// We reload all packages to allow packages to be compared correctly.
 	cfg.ReloadAllPackages()
@@ -94,6 +147,11 @@ func BuildData(cfg *config.Config, plugins ...interface{}) (*Data, error) {
 		return nil, err
 	}
 
+	b.InterfaceResolvers, err = b.buildInterfaceResolvers()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build interface resolvers: %w", err)
+	}
+
 	dataDirectives := make(map[string]*Directive)
 	for name, d := range b.Directives {
 		if !d.Builtin {
@@ -109,28 +167,61 @@ func BuildData(cfg *config.Config, plugins ...interface{}) (*Data, error) {
 		Plugins:       plugins,
 	}
 
+	// types are bound concurrently, each by a fork of the binder, then merged in the order of their names so that the
+	// references and the error returned don't depend on scheduling
+	var schemaTypes []*ast.Definition
 	for _, schemaType := range b.Schema.Types {
+		switch schemaType.Kind {
+		case ast.Object, ast.InputObject, ast.Union, ast.Interface:
+			schemaTypes = append(schemaTypes, schemaType)
+		}
+	}
+	sort.Slice(schemaTypes, func(i, j int) bool {
+		return schemaTypes[i].Name < schemaTypes[j].Name
+	})
+
+	type builtType struct {
+		binder *config.Binder
+		object *Object
+		iface  *Interface
+		err    error
+	}
+	built := make([]builtType, len(schemaTypes))
+	forEach(len(schemaTypes), func(i int) {
+		tb := b
+		tb.Binder = b.Binder.Fork()
+		built[i].binder = tb.Binder
+		switch schemaTypes[i].Kind {
+		case ast.Object, ast.InputObject:
+			built[i].object, built[i].err = tb.buildObject(schemaTypes[i])
+		case ast.Union, ast.Interface:
+			built[i].iface, built[i].err = tb.buildInterface(schemaTypes[i])
+		}
+	})
+
+	for i, schemaType := range schemaTypes {
+		b.Binder.Join(built[i].binder)
+		err := built[i].err
 		switch schemaType.Kind {
 		case ast.Object:
-			obj, err := b.buildObject(schemaType)
 			if err != nil {
 				return nil, fmt.Errorf("unable to build object definition: %w", err)
 			}
 
-			s.Objects = append(s.Objects, obj)
+			s.Objects = append(s.Objects, built[i].object)
 		case ast.InputObject:
-			input, err := b.buildObject(schemaType)
 			if err != nil {
 				return nil, fmt.Errorf("unable to build input definition: %w", err)
 			}
 
-			s.Inputs = append(s.Inputs, input)
+			s.Inputs = append(s.Inputs, built[i].object)
 
 		case ast.Union, ast.Interface:
-			s.Interfaces[schemaType.Name], err = b.buildInterface(schemaType)
 			if err != nil {
 				return nil, fmt.Errorf("unable to bind to interface: %w", err)
 			}
+			s.Interfaces[schemaType.Name] = built[i].iface
+			s.Interfaces[schemaType.Name].Resolvers = b.InterfaceResolvers[schemaType.Name]
 		}
 	}
 
@@ -186,7 +277,7 @@ func BuildData(cfg *config.Config, plugins ...interface{}) (*Data, error) {
 		}
 		relative = filepath.ToSlash(relative)
 		embeddable := true
-		if strings.HasPrefix(relative, "..") || s.BuiltIn {
+		if strings.HasPrefix(relative, "..") || s.BuiltIn || cfg.IsRewritten(s) {
 			embeddable = false
 		}
 		aSources = append(aSources, AugmentedSource{
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
 	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/codegen/config"
@@ -66,3 +68,29 @@ func TestData_Directives(t *testing.T) {
 
 	assert.Equal(t, expected, d.Directives())
 }
+
+func TestFileCacheKey(t *testing.T) {
+	users := &ast.Source{Name: "users.graphql", Input: `
+		type Query { user: User }
+		type User { id: ID! role: Role }
+	`}
+	// key returns the key of the file of users.graphql with the other sources of the schema
+	key := func(others string) string {
+		other := &ast.Source{Name: "other.graphql", Input: others}
+		schema := gqlparser.MustLoadSchema(users, other)
+		data := &Data{Schema: schema, FileCacheKey: "key"}
+		build := &Data{
+			Config:  &config.Config{Sources: []*ast.Source{users}},
+			Objects: Objects{{Definition: schema.Types["Query"]}, {Definition: schema.Types["User"]}},
+		}
+		return fileCacheKey(data, build)
+	}
+
+	base := key(`enum Role { ADMIN } type Post { id: ID! }`)
+	require.NotEmpty(t, base)
+	require.Equal(t, base, key(`type Post { id: ID! title: String } enum Role { ADMIN }`), "unrelated types don't change the key")
+	require.NotEqual(t, base, key(`enum Role { ADMIN USER } type Post { id: ID! }`), "types the file refers to do")
+	require.NotEqual(t, base, key(`enum Role { ADMIN } type Post { id: ID! } union Entity = User | Post`), "unions of its types do")
+	require.NotEqual(t, base, key(`enum Role { ADMIN } type Post { id: ID! } directive @log on FIELD`), "directives do")
+	require.Empty(t, fileCacheKey(&Data{}, &Data{}))
+}
@@ -22,6 +22,9 @@ type Directive struct {
 	Name    string
 	Args    []*FieldArgument
 	Builtin bool
+	// WrapFields is set by wrap_fields, the directive then wraps the fields of the objects it annotates and receives
+	// the object holding the field rather than the value of the field.
+	WrapFields bool
 }
 
 // IsLocation check location directive
@@ -54,6 +57,9 @@ func (b *builder) buildDirectives() (map[string]*Directive, error) {
 		if _, ok := directives[name]; ok {
 			return nil, fmt.Errorf("directive with name %s already exists", name)
 		}
+		if b.Config.Directives[name].WrapFields && !hasLocation(dir.Locations, ast.LocationObject) {
+			return nil, fmt.Errorf("directive %s sets wrap_fields but can't be used on OBJECT", name)
+		}
 
 		var args []*FieldArgument
 		for _, arg := range dir.Arguments {
@@ -83,12 +89,32 @@ func (b *builder) buildDirectives() (map[string]*Directive, error) {
 			Name:                name,
 			Args:                args,
 			Builtin:             b.Config.Directives[name].SkipRuntime,
+			WrapFields:          b.Config.Directives[name].WrapFields,
 		}
 	}
 
 	return directives, nil
 }
 
+func hasLocation(locations []ast.DirectiveLocation, location ast.DirectiveLocation) bool {
+	for _, l := range locations {
+		if l == location {
+			return true
+		}
+	}
+	return false
+}
+
+func filterDirectives(dirs []*Directive, keep func(*Directive) bool) []*Directive {
+	var res []*Directive
+	for _, d := range dirs {
+		if keep(d) {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
 func (b *builder) getDirectives(list ast.DirectiveList) ([]*Directive, error) {
 	dirs := make([]*Directive, len(list))
 	for i, d := range list {
@@ -123,6 +149,7 @@ func (b *builder) getDirectives(list ast.DirectiveList) ([]*Directive, error) {
 			Args:                args,
 			DirectiveDefinition: list[i].Definition,
 			Builtin:             b.Config.Directives[d.Name].SkipRuntime,
+			WrapFields:          b.Config.Directives[d.Name].WrapFields,
 		}
 	}
 
@@ -163,7 +190,11 @@ func (d *Directive) ResolveArgs(obj string, next int) string {
 }
 
 func (d *Directive) Declaration() string {
-	res := ucFirst(d.Name) + " func(ctx context.Context, obj interface{}, next graphql.Resolver"
+	obj := "obj"
+	if d.WrapFields {
+		obj = "parent"
+	}
+	res := ucFirst(d.Name) + " func(ctx context.Context, " + obj + " interface{}, next graphql.Resolver"
 
 	for _, arg := range d.Args {
 		res += fmt.Sprintf(", %s %s", templates.ToGoPrivate(arg.Name), templates.CurrentImports.LookupType(arg.TypeReference.GO))
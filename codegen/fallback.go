@@ -0,0 +1,144 @@
+package codegen
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+// FieldFallback is the @fallback directive of a field, its resolver is called through graphql.WithFallback.
+type FieldFallback struct {
+	Value interface{} // The value of the directive, nil for null
+	Type  *config.TypeReference
+}
+
+// Expr returns the go expressions of the value and error of the fallback function.
+func (f *FieldFallback) Expr() string {
+	if f.Value == nil {
+		return "nil, nil"
+	}
+	if f.Type.Definition.IsInputType() {
+		return "ec." + f.Type.UnmarshalFunc() + "(ctx, " + templates.Dump(f.Value) + ")"
+	}
+	// an empty list of objects
+	return templates.CurrentImports.LookupType(f.Type.GO) + "{}, nil"
+}
+
+// buildFieldFallback returns the @fallback directive of field, or nil if the field doesn't use it or the directive
+// isn't the builtin one.
+//
+// The value is written like any literal of the type of the field, checked against the type here, and converted by the
+// unmarshaler of the type. Fields of object types can only fall back to null or an empty list.
+func (b *builder) buildFieldFallback(obj *Object, f *Field) (*FieldFallback, error) {
+	d := f.FieldDefinition.Directives.ForName("fallback")
+	if d == nil || !b.Config.Directives["fallback"].Builtin() {
+		return nil, nil
+	}
+	name := obj.Name + "." + f.Name
+	if obj.Stream {
+		return nil, fmt.Errorf("@fallback on %s: subscription fields can not fall back", name)
+	}
+
+	fallback := &FieldFallback{Type: f.TypeReference}
+	arg := d.Arguments.ForName("value")
+	if arg == nil || arg.Value.Kind == ast.NullValue {
+		if f.TypeReference.GQL.NonNull {
+			return nil, fmt.Errorf("@fallback on %s: non-null fields need a value", name)
+		}
+		return fallback, nil
+	}
+
+	if !f.TypeReference.Definition.IsInputType() {
+		if _, ok := f.TypeReference.GO.(*types.Slice); !ok || arg.Value.Kind != ast.ListValue || len(arg.Value.Children) != 0 {
+			return nil, fmt.Errorf("@fallback on %s: fields of %s can only fall back to null or []", name, f.TypeReference.Definition.Name)
+		}
+	} else if err := checkFallbackValue(b.Schema, arg.Value, f.Type); err != nil {
+		return nil, fmt.Errorf("@fallback value of %s is not valid: %w", name, err)
+	}
+
+	var err error
+	if fallback.Value, err = arg.Value.Value(nil); err != nil {
+		return nil, fmt.Errorf("@fallback value of %s is not valid: %w", name, err)
+	}
+	return fallback, nil
+}
+
+// checkFallbackValue returns an error if v is not a literal of the input type t. The value argument of @fallback is
+// declared with a scalar accepting anything, so its literals are only checked here. Custom scalars accept any literal,
+// their unmarshalers check them.
+func checkFallbackValue(schema *ast.Schema, v *ast.Value, t *ast.Type) error {
+	switch {
+	case v.Kind == ast.Variable:
+		return fmt.Errorf("variables can not be used")
+	case v.Kind == ast.NullValue:
+		if t.NonNull {
+			return fmt.Errorf("null is not a %s", t.String())
+		}
+		return nil
+	case t.Elem != nil:
+		if v.Kind != ast.ListValue {
+			// a single value is coerced to a list of one
+			return checkFallbackValue(schema, v, t.Elem)
+		}
+		for _, child := range v.Children {
+			if err := checkFallbackValue(schema, child.Value, t.Elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	def := schema.Types[t.NamedType]
+	if def == nil {
+		return fmt.Errorf("unknown type %s", t.NamedType)
+	}
+	mismatch := fmt.Errorf("%s is not a %s", v.String(), t.String())
+	switch def.Kind {
+	case ast.Enum:
+		if v.Kind != ast.EnumValue || def.EnumValues.ForName(v.Raw) == nil {
+			return mismatch
+		}
+	case ast.InputObject:
+		if v.Kind != ast.ObjectValue {
+			return mismatch
+		}
+		for _, child := range v.Children {
+			field := def.Fields.ForName(child.Name)
+			if field == nil {
+				return fmt.Errorf("%s has no field %s", def.Name, child.Name)
+			}
+			if err := checkFallbackValue(schema, child.Value, field.Type); err != nil {
+				return fmt.Errorf("%s.%s: %w", def.Name, child.Name, err)
+			}
+		}
+		for _, field := range def.Fields {
+			if field.Type.NonNull && field.DefaultValue == nil && v.Children.ForName(field.Name) == nil {
+				return fmt.Errorf("%s.%s is required", def.Name, field.Name)
+			}
+		}
+	case ast.Scalar:
+		var ok bool
+		switch def.Name {
+		case "Int":
+			ok = v.Kind == ast.IntValue
+		case "Float":
+			ok = v.Kind == ast.IntValue || v.Kind == ast.FloatValue
+		case "String":
+			ok = v.Kind == ast.StringValue || v.Kind == ast.BlockValue
+		case "Boolean":
+			ok = v.Kind == ast.BooleanValue
+		case "ID":
+			ok = v.Kind == ast.StringValue || v.Kind == ast.IntValue
+		default:
+			ok = true
+		}
+		if !ok {
+			return mismatch
+		}
+	}
+	return nil
+}
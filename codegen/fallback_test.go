@@ -0,0 +1,67 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestCheckFallbackValue(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		scalar Time
+		enum Level { LOW HIGH }
+		input Filter { level: Level!, tags: [String!], limit: Int = 10 }
+		type Query { a: Int }
+	`})
+
+	tests := []struct {
+		typ, value string
+		err        string
+	}{
+		{"Int!", "0", ""},
+		{"Int!", "null", "null is not a Int!"},
+		{"Int", "null", ""},
+		{"Int!", `"0"`, `"0" is not a Int!`},
+		{"Float", "1", ""},
+		{"Float", "1.5", ""},
+		{"String!", `"hello"`, ""},
+		{"String!", "[]", "[] is not a String!"},
+		{"Boolean", "true", ""},
+		{"ID", "1", ""},
+		{"Time", `"2024-01-01"`, ""},
+		{"Level", "LOW", ""},
+		{"Level", "MEDIUM", "MEDIUM is not a Level"},
+		{"Level", `"LOW"`, `"LOW" is not a Level`},
+		{"[String!]!", "[]", ""},
+		{"[String!]!", `["none"]`, ""},
+		{"[String!]!", `"none"`, ""},
+		{"[String!]!", `[null]`, "null is not a String!"},
+		{"[Int!]", `[1, "2"]`, `"2" is not a Int!`},
+		{"Filter", "{level: LOW}", ""},
+		{"Filter", "{level: LOW, tags: [\"a\"], limit: 1}", ""},
+		{"Filter", "{tags: []}", "Filter.level is required"},
+		{"Filter", "{level: LOW, other: 1}", "Filter has no field other"},
+		{"Filter", "{level: 1}", "Filter.level: 1 is not a Level!"},
+		{"Int", "$var", "variables can not be used"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.typ+" "+tc.value, func(t *testing.T) {
+			typ, err := parser.ParseQuery(&ast.Source{Input: "query($v: " + tc.typ + ") { a }"})
+			require.Nil(t, err)
+			value, err := parser.ParseQuery(&ast.Source{Input: "{ a(v: " + tc.value + ") }"})
+			require.Nil(t, err)
+
+			checked := checkFallbackValue(schema,
+				value.Operations[0].SelectionSet[0].(*ast.Field).Arguments[0].Value,
+				typ.Operations[0].VariableDefinitions[0].Type)
+			if tc.err == "" {
+				require.NoError(t, checked)
+			} else {
+				require.EqualError(t, checked, tc.err)
+			}
+		})
+	}
+}
@@ -25,15 +25,25 @@ type Field struct {
 	GoFieldType      GoFieldType      // The field type in go, if any
 	GoReceiverName   string           // The name of method & var receiver in go, if any
 	GoFieldName      string           // The name of the method or var in go, if any
+	GoEmbedPath      string           // The embedded fields holding the method or var, like "Base.", if set with fieldName
 	IsResolver       bool             // Does this field need a resolver
 	Args             []*FieldArgument // A list of arguments to be passed to this field
 	MethodHasContext bool             // If this is bound to a go method, does the method also take a context
 	NoErr            bool             // If this is bound to a go method, does that method have an error as the second argument
 	VOkFunc          bool             // If this is bound to a go method, is it of shape (interface{}, bool)
 	Object           *Object          // A link back to the parent object
-	Default          interface{}      // The default value
+	Default          interface{}      // The default value, from the schema or the @default directive of input fields
 	Stream           bool             // does this field return a channel?
 	Directives       []*Directive
+	ResolvedOn       *Object // The interface resolving this field once for all implementors, see Interface.Resolvers
+	BindError        error   // Why the field couldn't be bound to its model and needs a resolver, if so
+	MaskReason       string  // The reason of @masked, the value is masked for viewers not allowed to read it
+	EncryptKey       string  // The key of @encrypted, the value is encrypted for viewers not allowed to read it
+	Cache            *FieldCache
+	Retry            *FieldRetry
+	Fallback         *FieldFallback
+	InitialPayload   bool // @initialPayload, the resolver returns a graphql.InitialPayload instead of a channel
+	Cleanup          bool // subscription_cleanup, the resolver also returns a function called once the subscription ends
 }
 
 func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, error) {
@@ -59,6 +69,36 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		}
 	}
 
+	if d := field.Directives.ForName("default"); d != nil && obj.Kind == ast.InputObject && b.Config.Directives["default"].Builtin() {
+		// unlike schema defaults, @default values are applied when the field is omitted without being introspectable
+		if field.DefaultValue != nil {
+			return nil, fmt.Errorf("%s.%s has both a default value and @default", obj.Name, field.Name)
+		}
+		if field.Type.NonNull {
+			return nil, fmt.Errorf("@default on %s.%s is never applied, clients must send non-null fields without a default value", obj.Name, field.Name)
+		}
+		arg := d.Arguments.ForName("value")
+		if arg == nil {
+			return nil, fmt.Errorf("@default on %s.%s needs a value", obj.Name, field.Name)
+		}
+		f.Default, err = arg.Value.Value(nil)
+		if err != nil {
+			return nil, fmt.Errorf("@default value of %s.%s is not valid: %w", obj.Name, field.Name, err)
+		}
+	}
+
+	if obj.Kind != ast.InputObject {
+		if f.MaskReason, err = b.maskingArg(obj, field, "masked", "reason"); err != nil {
+			return nil, err
+		}
+		if f.EncryptKey, err = b.maskingArg(obj, field, "encrypted", "key"); err != nil {
+			return nil, err
+		}
+		if f.MaskReason != "" && f.EncryptKey != "" {
+			return nil, fmt.Errorf("%s.%s can't be both @masked and @encrypted", obj.Name, field.Name)
+		}
+	}
+
 	for _, arg := range field.Arguments {
 		newArg, err := b.buildArg(obj, arg)
 		if err != nil {
@@ -67,8 +107,29 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		f.Args = append(f.Args, newArg)
 	}
 
+	if obj.Kind != ast.InputObject {
+		if f.Cache, err = b.buildFieldCache(obj, &f); err != nil {
+			return nil, err
+		}
+		if f.Retry, err = b.buildFieldRetry(obj, &f); err != nil {
+			return nil, err
+		}
+		if field.Directives.ForName("initialPayload") != nil && b.Config.Directives["initialPayload"].Builtin() {
+			if !obj.Stream {
+				return nil, fmt.Errorf("@initialPayload on %s.%s: only subscription fields have an initial payload", obj.Name, field.Name)
+			}
+			f.InitialPayload = true
+		}
+		f.Cleanup = obj.Stream && b.Config.SubscriptionCleanup
+	}
+
 	if err = b.bindField(obj, &f); err != nil {
 		f.IsResolver = true
+		f.BindError = err
+		if strings.Contains(f.GoFieldName, ".") {
+			// a path to an embedded field set with fieldName can't name a resolver
+			f.GoFieldName = templates.ToGo(field.Name)
+		}
 		if errors.Is(err, config.ErrTypeNotFound) {
 			return nil, err
 		}
@@ -79,9 +140,32 @@ func (b *builder) buildField(obj *Object, field *ast.FieldDefinition) (*Field, e
 		f.TypeReference = b.Binder.PointerTo(f.TypeReference)
 	}
 
+	if obj.Kind != ast.InputObject && f.TypeReference != nil {
+		if f.Fallback, err = b.buildFieldFallback(obj, &f); err != nil {
+			return nil, err
+		}
+	}
+
 	return &f, nil
 }
 
+// maskingArg returns the argument arg of the masking directive name on field, or an empty string if the field doesn't
+// use it or the directive isn't the builtin one.
+func (b *builder) maskingArg(obj *Object, field *ast.FieldDefinition, name, arg string) (string, error) {
+	d := field.Directives.ForName(name)
+	if d == nil || !b.Config.Directives[name].Builtin() {
+		return "", nil
+	}
+	if field.Type.NamedType != "String" && field.Type.NamedType != "ID" {
+		return "", fmt.Errorf("@%s on %s.%s needs a String or ID field, it is %s", name, obj.Name, field.Name, field.Type)
+	}
+	value := d.Arguments.ForName(arg)
+	if value == nil || value.Value.Kind != ast.StringValue || value.Value.Raw == "" {
+		return "", fmt.Errorf("@%s on %s.%s needs a %s", name, obj.Name, field.Name, arg)
+	}
+	return value.Value.Raw, nil
+}
+
 func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	defer func() {
 		if f.TypeReference == nil {
@@ -96,17 +180,33 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 			if err != nil {
 				errret = err
 			}
+			dirs = filterDirectives(dirs, func(dir *Directive) bool {
+				return !b.Config.Directives[dir.Name].WrapFields
+			})
 			for _, dir := range obj.Directives {
 				if dir.IsLocation(ast.LocationInputObject) {
 					dirs = append(dirs, dir)
 				}
 			}
 			f.Directives = append(dirs, f.Directives...)
+			// directives wrapping the fields of the object run before the directives of the field, introspection
+			// fields are left alone
+			if !obj.IsInputType() && !f.IsReserved() {
+				f.Directives = append(f.Directives, filterDirectives(obj.Directives, func(dir *Directive) bool {
+					return b.Config.Directives[dir.Name].WrapFields && dir.IsLocation(ast.LocationObject)
+				})...)
+			}
 		}
 	}()
 
 	f.Stream = obj.Stream
 
+	if iface := b.resolvedOn(obj, f.Name); iface != nil {
+		f.IsResolver = true
+		f.ResolvedOn = iface
+		return nil
+	}
+
 	switch {
 	case f.Name == "__schema":
 		f.GoFieldType = GoFieldMethod
@@ -134,6 +234,10 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	case obj.Root:
 		f.IsResolver = true
 		return nil
+	case obj.Kind == ast.Interface:
+		// only the fields an interface resolves for its implementors are built
+		f.IsResolver = true
+		return nil
 	case b.Config.Models[obj.Name].Fields[f.Name].Resolver:
 		f.IsResolver = true
 		return nil
@@ -204,6 +308,7 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 		// success, args and return type match. Bind to method
 		f.GoFieldType = GoFieldMethod
 		f.GoReceiverName = "obj"
+		f.GoEmbedPath = embeddedPath(f.GoFieldName)
 		f.GoFieldName = target.Name()
 		f.Args = newArgs
 		f.TypeReference = tr
@@ -219,6 +324,7 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 		// success, bind to var
 		f.GoFieldType = GoFieldVariable
 		f.GoReceiverName = "obj"
+		f.GoEmbedPath = embeddedPath(f.GoFieldName)
 		f.GoFieldName = target.Name()
 		f.TypeReference = tr
 
@@ -228,12 +334,34 @@ func (b *builder) bindField(obj *Object, f *Field) (errret error) {
 	}
 }
 
+// embeddedPath returns the embedded fields a name set with fieldName binds in, such as Base. for Base.Name.
+func embeddedPath(name string) string {
+	return name[:strings.LastIndex(name, ".")+1]
+}
+
 // findBindTarget attempts to match the name to a field or method on a Type
 // with the following priorites:
 // 1. Any Fields with a struct tag (see config.StructTag). Errors if more than one match is found
 // 2. Any method or field with a matching name. Errors if more than one match is found
-// 3. Same logic again for embedded fields
+// 3. Same logic again for embedded fields, the shallowest match wins like in go. Errors if more than one match is
+// found at the same depth
+//
+// A name such as Base.Name, set with fieldName, binds to Name in the embedded field Base.
 func (b *builder) findBindTarget(t types.Type, name string) (types.Object, error) {
+	if path := strings.Split(name, "."); len(path) > 1 {
+		return b.findBindPathTarget(t, path)
+	}
+
+	found, err := b.findBindOwnTarget(t, name)
+	if found != nil || err != nil {
+		return found, err
+	}
+
+	// Search embeds
+	return b.findBindEmbedsTarget(t, name)
+}
+
+func (b *builder) findBindOwnTarget(t types.Type, name string) (types.Object, error) {
 	// NOTE: a struct tag will override both methods and fields
 	// Bind to struct tag
 	found, err := b.findBindStructTagTarget(t, name)
@@ -265,8 +393,102 @@ func (b *builder) findBindTarget(t types.Type, name string) (types.Object, error
 		return nil, fmt.Errorf("found more than one way to bind for %s", name)
 	}
 
-	// Search embeds
-	return b.findBindEmbedsTarget(t, name)
+	return nil, nil
+}
+
+// findBindPathTarget binds to the last element of path, in the embedded fields named by the others.
+func (b *builder) findBindPathTarget(t types.Type, path []string) (types.Object, error) {
+	for _, name := range path[:len(path)-1] {
+		var next types.Type
+		for _, e := range embeddedTypes(t) {
+			if e.name == name {
+				next = e.typ
+			}
+		}
+		if next == nil {
+			return nil, fmt.Errorf("%s has no embedded field %s", t.String(), name)
+		}
+		t = next
+	}
+	return b.findBindOwnTarget(t, path[len(path)-1])
+}
+
+type embeddedType struct {
+	name string
+	typ  types.Type
+	path string
+}
+
+func embeddedTypes(in types.Type) []embeddedType {
+	var res []embeddedType
+	switch t := in.Underlying().(type) {
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			field := t.Field(i)
+			if !field.Embedded() {
+				continue
+			}
+			fieldType := field.Type()
+			if ptr, ok := fieldType.(*types.Pointer); ok {
+				fieldType = ptr.Elem()
+			}
+			res = append(res, embeddedType{name: field.Name(), typ: fieldType, path: field.Name()})
+		}
+	case *types.Interface:
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			embedded := t.EmbeddedType(i)
+			name := embedded.String()
+			if named, ok := embedded.(*types.Named); ok {
+				name = named.Obj().Name()
+			}
+			res = append(res, embeddedType{name: name, typ: embedded, path: name})
+		}
+	}
+	return res
+}
+
+// findBindEmbedsTarget searches the embedded fields of in one depth at a time, like go promotes their fields and
+// methods.
+func (b *builder) findBindEmbedsTarget(in types.Type, name string) (types.Object, error) {
+	level := embeddedTypes(in)
+	seen := map[types.Type]bool{}
+	for len(level) > 0 {
+		var found types.Object
+		var paths []string
+		var next []embeddedType
+		for _, e := range level {
+			// a type embedded at a shallower depth hides the deeper ones
+			if seen[e.typ] {
+				continue
+			}
+
+			f, err := b.findBindOwnTarget(e.typ, name)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", e.path, err)
+			}
+			if f != nil {
+				found = f
+				paths = append(paths, e.path+"."+f.Name())
+				continue
+			}
+			for _, child := range embeddedTypes(e.typ) {
+				child.path = e.path + "." + child.path
+				next = append(next, child)
+			}
+		}
+		for _, e := range level {
+			seen[e.typ] = true
+		}
+		if len(paths) > 1 {
+			return nil, fmt.Errorf("found more than one way to bind for %s: %s are at the same depth, set fieldName to one of them", name, strings.Join(paths, " and "))
+		}
+		if found != nil {
+			return found, nil
+		}
+		level = next
+	}
+
+	return nil, nil
 }
 
 func (b *builder) findBindStructTagTarget(in types.Type, name string) (types.Object, error) {
@@ -359,71 +581,6 @@ func (b *builder) findBindFieldTarget(in types.Type, name string) (types.Object,
 	return nil, nil
 }
 
-func (b *builder) findBindEmbedsTarget(in types.Type, name string) (types.Object, error) {
-	switch t := in.(type) {
-	case *types.Named:
-		return b.findBindEmbedsTarget(t.Underlying(), name)
-	case *types.Struct:
-		return b.findBindStructEmbedsTarget(t, name)
-	case *types.Interface:
-		return b.findBindInterfaceEmbedsTarget(t, name)
-	}
-
-	return nil, nil
-}
-
-func (b *builder) findBindStructEmbedsTarget(strukt *types.Struct, name string) (types.Object, error) {
-	var found types.Object
-	for i := 0; i < strukt.NumFields(); i++ {
-		field := strukt.Field(i)
-		if !field.Embedded() {
-			continue
-		}
-
-		fieldType := field.Type()
-		if ptr, ok := fieldType.(*types.Pointer); ok {
-			fieldType = ptr.Elem()
-		}
-
-		f, err := b.findBindTarget(fieldType, name)
-		if err != nil {
-			return nil, err
-		}
-
-		if f != nil && found != nil {
-			return nil, fmt.Errorf("found more than one way to bind for %s", name)
-		}
-
-		if f != nil {
-			found = f
-		}
-	}
-
-	return found, nil
-}
-
-func (b *builder) findBindInterfaceEmbedsTarget(iface *types.Interface, name string) (types.Object, error) {
-	var found types.Object
-	for i := 0; i < iface.NumEmbeddeds(); i++ {
-		embeddedType := iface.EmbeddedType(i)
-
-		f, err := b.findBindTarget(embeddedType, name)
-		if err != nil {
-			return nil, err
-		}
-
-		if f != nil && found != nil {
-			return nil, fmt.Errorf("found more than one way to bind for %s", name)
-		}
-
-		if f != nil {
-			found = f
-		}
-	}
-
-	return found, nil
-}
-
 func (f *Field) HasDirectives() bool {
 	return len(f.ImplDirectives()) > 0
 }
@@ -450,6 +607,78 @@ func (f *Field) ImplDirectives() []*Directive {
 	return d
 }
 
+// ExecutionDirectives returns the names of the directives applied to the field at runtime, outermost first.
+func (f *Field) ExecutionDirectives() []string {
+	var names []string
+	for _, d := range f.ImplDirectives() {
+		names = append(names, d.Name)
+	}
+	if f.Fallback != nil {
+		names = append(names, "fallback")
+	}
+	if f.Cache != nil {
+		names = append(names, "cached")
+	}
+	if f.Retry != nil {
+		names = append(names, "retry")
+	}
+	return names
+}
+
+// ExecutionExpr returns the graphql.FieldExecution of the field as a go expression, or an empty string for fields
+// reading the model without directives, which the generated code describes without listing them.
+func (f *Field) ExecutionExpr() string {
+	directives := f.ExecutionDirectives()
+	if !f.IsResolver && !f.IsMethod() && len(directives) == 0 {
+		return ""
+	}
+
+	expr := "graphql.FieldExecution{Resolution: " + f.resolutionExpr()
+	if f.IsConcurrent() {
+		expr += ", Concurrent: true"
+	}
+	if len(directives) > 0 {
+		expr += ", Directives: " + quotedList(directives)
+	}
+	return expr + "}"
+}
+
+// MetaExpr returns the graphql.FieldMeta of the field as a go expression, for the FieldMeta table generated with
+// export_field_meta.
+func (f *Field) MetaExpr() string {
+	expr := "graphql.FieldMeta{GoName: " + strconv.Quote(f.GoFieldName) + ", Resolution: " + f.resolutionExpr()
+	if len(f.Args) > 0 {
+		args := make([]string, len(f.Args))
+		for i, arg := range f.Args {
+			args[i] = fmt.Sprintf("{Name: %q, GoName: %q, GoType: %q}", arg.Name, arg.VarName, arg.TypeReference.GO.String())
+		}
+		expr += ", Args: []graphql.ArgMeta{" + strings.Join(args, ", ") + "}"
+	}
+	if directives := f.ExecutionDirectives(); len(directives) > 0 {
+		expr += ", Directives: " + quotedList(directives)
+	}
+	return expr + "}"
+}
+
+func (f *Field) resolutionExpr() string {
+	switch {
+	case f.IsResolver:
+		return "graphql.ResolvedByResolver"
+	case f.IsMethod():
+		return "graphql.ResolvedByMethod"
+	default:
+		return "graphql.ResolvedByField"
+	}
+}
+
+func quotedList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
 func (f *Field) IsReserved() bool {
 	return strings.HasPrefix(f.Name, "__")
 }
@@ -482,6 +711,9 @@ func (f *Field) ShortInvocation() string {
 	if f.Object.Kind == ast.InputObject {
 		return fmt.Sprintf("%s().%s(ctx, &it, data)", caser.String(f.Object.Definition.Name), f.GoFieldName)
 	}
+	if f.ResolvedOn != nil {
+		return fmt.Sprintf("%s().%s(%s)", caser.String(f.ResolvedOn.Definition.Name), f.GoFieldName, f.CallArgs())
+	}
 	return fmt.Sprintf("%s().%s(%s)", caser.String(f.Object.Definition.Name), f.GoFieldName, f.CallArgs())
 }
 
@@ -506,6 +738,9 @@ func (f *Field) ResolverType() string {
 		return ""
 	}
 
+	if f.ResolvedOn != nil {
+		return fmt.Sprintf("%s().%s(%s)", f.ResolvedOn.Definition.Name, f.GoFieldName, f.CallArgs())
+	}
 	return fmt.Sprintf("%s().%s(%s)", f.Object.Definition.Name, f.GoFieldName, f.CallArgs())
 }
 
@@ -542,10 +777,21 @@ func (f *Field) ShortResolverSignature(ft *goast.FuncType) string {
 
 	result := templates.CurrentImports.LookupType(f.TypeReference.GO)
 	if f.Object.Stream {
-		result = "<-chan " + result
+		result = f.StreamType()
 	}
 	// Named return.
 	var namedV, namedE string
+	if f.Cleanup {
+		// names are only kept from previous declarations returning a cleanup function, they are all named or none is
+		var namedC string
+		if ft != nil && ft.Results != nil && len(ft.Results.List) == 3 && len(ft.Results.List[0].Names) > 0 {
+			namedV = ft.Results.List[0].Names[0].Name
+			namedC = ft.Results.List[1].Names[0].Name
+			namedE = ft.Results.List[2].Names[0].Name
+		}
+		res += fmt.Sprintf(") (%s %s, %s func(), %s error)", namedV, result, namedC, namedE)
+		return res
+	}
 	if ft != nil {
 		if ft.Results != nil && len(ft.Results.List) > 0 && len(ft.Results.List[0].Names) > 0 {
 			namedV = ft.Results.List[0].Names[0].Name
@@ -558,6 +804,16 @@ func (f *Field) ShortResolverSignature(ft *goast.FuncType) string {
 	return res
 }
 
+// StreamType returns the go type returned by the resolver of a subscription field, a channel of the type of the field
+// or a graphql.InitialPayload of it with @initialPayload.
+func (f *Field) StreamType() string {
+	t := templates.CurrentImports.LookupType(f.TypeReference.GO)
+	if f.InitialPayload {
+		return templates.CurrentImports.Lookup("github.com/99designs/gqlgen/graphql") + ".InitialPayload[" + t + "]"
+	}
+	return "<-chan " + t
+}
+
 func (f *Field) GoResultName() (string, bool) {
 	name := fmt.Sprintf("%v", f.TypeReference.GO)
 	splits := strings.Split(name, "/")
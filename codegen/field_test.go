@@ -78,6 +78,58 @@ type Embed struct {
 	}
 }
 
+func TestFindPromotedField(t *testing.T) {
+	input := `
+package test
+
+type Inner struct {
+	Name string
+}
+type Mid struct {
+	Inner
+}
+type Shallow struct {
+	Name string
+}
+type Depth struct {
+	Mid
+	*Shallow
+}
+type Tie struct {
+	Inner
+	Shallow
+}
+`
+	scope, err := parseScope(input, "test")
+	require.NoError(t, err)
+
+	shallowName := scope.Lookup("Shallow").Type().Underlying().(*types.Struct).Field(0)
+	depth := scope.Lookup("Depth").Type().(*types.Named)
+	tie := scope.Lookup("Tie").Type().(*types.Named)
+	b := builder{Config: &config.Config{}}
+
+	t.Run("the shallowest field wins", func(t *testing.T) {
+		target, err := b.findBindTarget(depth, "name")
+		require.NoError(t, err)
+		require.Equal(t, shallowName, target)
+	})
+
+	t.Run("fields at the same depth are ambiguous", func(t *testing.T) {
+		_, err := b.findBindTarget(tie, "name")
+		require.EqualError(t, err, "found more than one way to bind for name: Inner.Name and Shallow.Name are at the same depth, set fieldName to one of them")
+	})
+
+	t.Run("fieldName picks the embedded field", func(t *testing.T) {
+		target, err := b.findBindTarget(tie, "Shallow.Name")
+		require.NoError(t, err)
+		require.Equal(t, shallowName, target)
+		require.Equal(t, "Shallow.", embeddedPath("Shallow.Name"))
+
+		_, err = b.findBindTarget(tie, "Missing.Name")
+		require.EqualError(t, err, "test.Tie has no embedded field Missing")
+	})
+}
+
 func parseScope(input interface{}, packageName string) (*types.Scope, error) {
 	// test setup to parse the types
 	fset := token.NewFileSet()
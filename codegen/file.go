@@ -0,0 +1,69 @@
+package codegen
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"text/template"
+
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+// File is a file added to the generated code by a plugin, see plugin.FileGenerator. It is rendered like the files
+// gqlgen generates: with the template helpers, such as lookupImport and go, imports collected from the template and
+// the generated header and provenance of the config.
+type File struct {
+	// Filename is the path of the file, relative paths are relative to the working directory like in the config.
+	Filename string
+	// PackageName is the package of the file, the package already in its directory or the name of the directory when
+	// empty.
+	PackageName string
+
+	// Template is the template of the file, or else every .gotpl file of TemplateFS, rendered in order of their names.
+	Template   string
+	TemplateFS fs.FS
+	Funcs      template.FuncMap
+
+	// Data is passed to the template, the built Data of the generated code when nil.
+	Data interface{}
+
+	// BuildConstraint is written as a //go:build line at the top of the file.
+	BuildConstraint string
+}
+
+// RenderFile renders f with the templates package, as the generated code built from data.
+func RenderFile(data *Data, f File) error {
+	if f.Filename == "" {
+		return fmt.Errorf("filename must be specified")
+	}
+	if f.Template == "" && f.TemplateFS == nil {
+		return fmt.Errorf("%s: template or template fs must be specified", f.Filename)
+	}
+	filename, err := filepath.Abs(f.Filename)
+	if err != nil {
+		return err
+	}
+	pkg := f.PackageName
+	if pkg == "" {
+		pkg = code.NameForDir(filepath.Dir(filename))
+	}
+	var d interface{} = data
+	if f.Data != nil {
+		d = f.Data
+	}
+
+	return templates.Render(templates.Options{
+		PackageName:     pkg,
+		Filename:        filename,
+		Template:        f.Template,
+		TemplateFS:      f.TemplateFS,
+		Funcs:           f.Funcs,
+		Data:            d,
+		GeneratedHeader: true,
+		Provenance:      data.Config.Provenance(),
+		BuildConstraint: f.BuildConstraint,
+		Packages:        data.Config.Packages,
+		Format:          data.Config.Format.Options(),
+	})
+}
@@ -1,15 +1,20 @@
 package codegen
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"embed"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 
 	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
 
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/codegen/templates"
@@ -23,14 +28,20 @@ func GenerateCode(data *Data) error {
 		return fmt.Errorf("missing exec config")
 	}
 
+	var err error
 	switch data.Config.Exec.Layout {
 	case config.ExecLayoutSingleFile:
-		return generateSingleFile(data)
+		err = generateSingleFile(data)
 	case config.ExecLayoutFollowSchema:
-		return generatePerSchema(data)
+		err = generatePerSchema(data)
+	default:
+		return fmt.Errorf("unrecognized exec layout %s", data.Config.Exec.Layout)
+	}
+	if err != nil {
+		return err
 	}
 
-	return fmt.Errorf("unrecognized exec layout %s", data.Config.Exec.Layout)
+	return generateStubFile(data)
 }
 
 func generateSingleFile(data *Data) error {
@@ -40,8 +51,13 @@ func generateSingleFile(data *Data) error {
 		Data:            data,
 		RegionTags:      true,
 		GeneratedHeader: true,
+		Provenance:      data.Config.Provenance(),
+		BuildConstraint: data.Config.Exec.BuildTags,
 		Packages:        data.Config.Packages,
+		Format:          data.Config.Format.Options(),
 		TemplateFS:      codegenTemplates,
+		Overrides:       data.Config.Exec.TemplateOverrides,
+		CacheKey:        data.CacheKey,
 	})
 }
 
@@ -73,29 +89,121 @@ func generatePerSchema(data *Data) error {
 		return err
 	}
 
-	for filename, build := range builds {
-		if filename == "" {
-			continue
+	filenames := make([]string, 0, len(builds))
+	for filename := range builds {
+		if filename != "" {
+			filenames = append(filenames, filename)
 		}
+	}
+	sort.Strings(filenames)
 
+	opts := make([]templates.Options, 0, len(filenames))
+	for _, filename := range filenames {
 		dir := data.Config.Exec.DirName
 		path := filepath.Join(dir, filename)
 
-		err = templates.Render(templates.Options{
+		opts = append(opts, templates.Options{
 			PackageName:     data.Config.Exec.Package,
 			Filename:        path,
-			Data:            build,
+			Data:            builds[filename],
 			RegionTags:      true,
 			GeneratedHeader: true,
+			Provenance:      data.Config.Provenance(),
+			BuildConstraint: data.Config.Exec.BuildTags,
 			Packages:        data.Config.Packages,
+			Format:          data.Config.Format.Options(),
 			TemplateFS:      codegenTemplates,
+			Overrides:       data.Config.Exec.TemplateOverrides,
+			CacheKey:        fileCacheKey(data, builds[filename]),
 		})
-		if err != nil {
-			return err
+	}
+
+	return templates.RenderAll(opts)
+}
+
+// fileCacheKey returns the key the follow-schema file rendered from build is cached under: data.FileCacheKey with the
+// source of the file, the definitions of its types and of the types they refer to, implement or are implemented by,
+// the marshalers it holds and the directives. It is empty when data.FileCacheKey is.
+func fileCacheKey(data, build *Data) string {
+	if data.FileCacheKey == "" {
+		return ""
+	}
+
+	defs := map[string]*ast.Definition{}
+	add := func(name string) {
+		if def := data.Schema.Types[name]; def != nil {
+			defs[name] = def
 		}
 	}
+	addType := func(def *ast.Definition) {
+		add(def.Name)
+		for _, f := range def.Fields {
+			add(f.Type.Name())
+			for _, arg := range f.Arguments {
+				add(arg.Type.Name())
+			}
+		}
+		for _, t := range data.Schema.GetImplements(def) {
+			add(t.Name)
+		}
+		for _, t := range data.Schema.GetPossibleTypes(def) {
+			add(t.Name)
+		}
+	}
+	for _, o := range build.Objects {
+		addType(o.Definition)
+	}
+	for _, in := range build.Inputs {
+		addType(in.Definition)
+	}
+	for _, inf := range build.Interfaces {
+		addType(inf.Definition)
+	}
+	refs := make([]string, 0, len(build.ReferencedTypes))
+	for k, rt := range build.ReferencedTypes {
+		add(rt.Definition.Name)
+		refs = append(refs, k+" "+rt.GO.String())
+	}
+	sort.Strings(refs)
 
-	return nil
+	doc := &ast.SchemaDocument{}
+	for _, name := range sortedKeys(defs) {
+		doc.Definitions = append(doc.Definitions, defs[name])
+	}
+	for _, name := range sortedKeys(data.Schema.Directives) {
+		doc.Directives = append(doc.Directives, data.Schema.Directives[name])
+	}
+
+	h := sha256.New()
+	write := func(s string) {
+		_, _ = h.Write([]byte(s))
+		_, _ = h.Write([]byte{0})
+	}
+	write(data.FileCacheKey)
+	for _, src := range build.Config.Sources {
+		write(src.Name)
+		write(src.Input)
+	}
+	for _, d := range doc.Directives {
+		if d.Position != nil && d.Position.Src != nil {
+			// Directives() of the file are the directives declared in its source
+			write(d.Name + " " + d.Position.Src.Name)
+		}
+	}
+	for _, ref := range refs {
+		write(ref)
+	}
+	formatter.NewFormatter(h, formatter.WithComments()).FormatSchemaDocument(doc)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func filename(p *ast.Position, config *config.Config) string {
@@ -151,8 +259,43 @@ func generateRootFile(data *Data) error {
 		Data:            data,
 		RegionTags:      false,
 		GeneratedHeader: true,
+		Provenance:      data.Config.Provenance(),
+		BuildConstraint: data.Config.Exec.BuildTags,
 		Packages:        data.Config.Packages,
+		Format:          data.Config.Format.Options(),
 		TemplateFS:      codegenTemplates,
+		Overrides:       data.Config.Exec.TemplateOverrides,
+		CacheKey:        data.CacheKey,
+	})
+}
+
+// Stub file declares the exported API of the executable schema for builds its build constraint excludes.
+func generateStubFile(data *Data) error {
+	path := data.Config.Exec.StubFilename()
+	if data.Config.Exec.BuildTags == "" {
+		// remove the stub left behind by an earlier configuration, as it would redeclare the generated code
+		if b, err := os.ReadFile(path); err == nil && bytes.Contains(b, []byte("errExecutableSchemaExcluded")) {
+			return os.Remove(path)
+		}
+		return nil
+	}
+
+	template, err := codegenTemplates.ReadFile("stub_.gotpl")
+	if err != nil {
+		return err
+	}
+
+	return templates.Render(templates.Options{
+		PackageName:     data.Config.Exec.Package,
+		Template:        string(template),
+		Filename:        path,
+		Data:            data,
+		GeneratedHeader: true,
+		Provenance:      data.Config.Provenance(),
+		BuildConstraint: data.Config.Exec.StubBuildTags(),
+		Packages:        data.Config.Packages,
+		Format:          data.Config.Format.Options(),
+		CacheKey:        data.CacheKey,
 	})
 }
 
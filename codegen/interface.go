@@ -3,9 +3,12 @@ package codegen
 import (
 	"fmt"
 	"go/types"
+	"slices"
 	"sort"
 
 	"github.com/vektah/gqlparser/v2/ast"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 
 	"github.com/99designs/gqlgen/codegen/config"
 )
@@ -15,6 +18,9 @@ type Interface struct {
 	Type         types.Type
 	Implementors []InterfaceImplementor
 	InTypemap    bool
+	// Resolvers holds the fields resolved once for every implementor, configured with resolver: true on the fields of
+	// the interface model, as the fields of an object with the interface as its type.
+	Resolvers *Object
 }
 
 type InterfaceImplementor struct {
@@ -41,11 +47,10 @@ func (b *builder) buildInterface(typ *ast.Definition) (*Interface, error) {
 		return nil, fmt.Errorf("%s is not an interface", i.Type)
 	}
 
-	// Sort so that more specific types are evaluated first.
-	implementors := b.Schema.GetPossibleTypes(typ)
-	sort.Slice(implementors, func(i, j int) bool {
-		return len(implementors[i].Interfaces) > len(implementors[j].Interfaces)
-	})
+	implementors, err := b.sortImplementors(typ)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, implementor := range implementors {
 		obj, err := b.Binder.DefaultUserObject(implementor.Name)
@@ -89,6 +94,153 @@ func (b *builder) buildInterface(typ *ast.Definition) (*Interface, error) {
 	return i, nil
 }
 
+// sortImplementors returns the implementors of typ in the order the type switch marshaling its values matches them:
+// those listed in the implementor_priority config of typ first, in that order, then those implementing more interfaces,
+// so that more specific types are evaluated first, and then in the order the schema declares them.
+func (b *builder) sortImplementors(typ *ast.Definition) ([]*ast.Definition, error) {
+	possible := b.Schema.GetPossibleTypes(typ)
+	implementors := make([]*ast.Definition, len(possible))
+	copy(implementors, possible)
+
+	priority := b.Config.Models[typ.Name].ImplementorPriority
+	rank := make(map[string]int, len(priority))
+	for i, name := range priority {
+		if !slices.ContainsFunc(possible, func(def *ast.Definition) bool { return def.Name == name }) {
+			return nil, fmt.Errorf("models.%s.implementor_priority: %s is not a possible type of %s", typ.Name, name, typ.Name)
+		}
+		rank[name] = len(priority) - i
+	}
+
+	sort.SliceStable(implementors, func(i, j int) bool {
+		if ri, rj := rank[implementors[i].Name], rank[implementors[j].Name]; ri != rj {
+			return ri > rj
+		}
+		return len(implementors[i].Interfaces) > len(implementors[j].Interfaces)
+	})
+	return implementors, nil
+}
+
+// buildInterfaceResolvers returns an object for each interface with fields marked as resolvers in its models config.
+// Implementors bind those fields to the single resolver of the interface, which receives the implementor as the
+// interface value, rather than to a resolver of their own.
+func (b *builder) buildInterfaceResolvers() (map[string]*Object, error) {
+	res := map[string]*Object{}
+	caser := cases.Title(language.English, cases.NoLower)
+	for _, typ := range b.Schema.Types {
+		if typ.Kind != ast.Interface {
+			continue
+		}
+		var fields ast.FieldList
+		for _, field := range typ.Fields {
+			if b.Config.Models[typ.Name].Fields[field.Name].Resolver {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		goType, err := b.Binder.DefaultUserObject(typ.Name)
+		if err != nil {
+			return nil, err
+		}
+		obj := &Object{
+			Definition: typ,
+			Type:       goType,
+			ResolverInterface: types.NewNamed(
+				types.NewTypeName(0, b.Config.Exec.Pkg(), caser.String(typ.Name)+"Resolver", nil),
+				nil,
+				nil,
+			),
+		}
+
+		for _, impl := range b.Schema.GetPossibleTypes(typ) {
+			implType, err := b.Binder.DefaultUserObject(impl.Name)
+			if err != nil {
+				return nil, fmt.Errorf("%s has no backing go type", impl.Name)
+			}
+			if !config.IsNilable(implType) {
+				implType = types.NewPointer(implType)
+			}
+			if !types.AssignableTo(implType, goType) {
+				return nil, fmt.Errorf("%s can't be passed to the resolvers of %s, it doesn't implement %s", implType.String(), typ.Name, goType.String())
+			}
+			for _, field := range fields {
+				if !sameSignature(field, impl.Fields.ForName(field.Name)) {
+					return nil, fmt.Errorf("%s.%s is resolved on %s and must have the same type and arguments as the interface field", impl.Name, field.Name, typ.Name)
+				}
+			}
+		}
+
+		for _, field := range fields {
+			f, err := b.buildField(obj, field)
+			if err != nil {
+				return nil, err
+			}
+			obj.Fields = append(obj.Fields, f)
+		}
+		res[typ.Name] = obj
+	}
+	return res, nil
+}
+
+func sameSignature(a, b *ast.FieldDefinition) bool {
+	if b == nil || a.Type.String() != b.Type.String() || len(a.Arguments) != len(b.Arguments) {
+		return false
+	}
+	for i, arg := range a.Arguments {
+		if arg.Name != b.Arguments[i].Name || arg.Type.String() != b.Arguments[i].Type.String() {
+			return false
+		}
+	}
+	return true
+}
+
+// resolvedOn returns the interface object of obj that resolves the field name once for all implementors, if any.
+func (b *builder) resolvedOn(obj *Object, name string) *Object {
+	for _, def := range obj.Implements {
+		iface := b.InterfaceResolvers[def.Name]
+		if iface == nil {
+			continue
+		}
+		for _, f := range iface.Fields {
+			if f.Name == name {
+				return iface
+			}
+		}
+	}
+	return nil
+}
+
 func (i *InterfaceImplementor) CanBeNil() bool {
 	return config.IsNilable(i.Type)
 }
+
+// DispatchImplementors returns the implementors in the dispatch table of the interface with interface_dispatch_tables:
+// the concrete ones, leaving out those implementing the Go interface an implementor before them is bound to, as the
+// type switch matches their values with that implementor first.
+func (i *Interface) DispatchImplementors() []InterfaceImplementor {
+	var res []InterfaceImplementor
+	for j, impl := range i.Implementors {
+		if !impl.IsConcrete() {
+			continue
+		}
+		matchedBefore := false
+		for _, before := range i.Implementors[:j] {
+			if iface, ok := before.Type.Underlying().(*types.Interface); ok && types.Implements(impl.Type, iface) {
+				matchedBefore = true
+				break
+			}
+		}
+		if !matchedBefore {
+			res = append(res, impl)
+		}
+	}
+	return res
+}
+
+// IsConcrete reports whether Type is the dynamic type of the interface values it matches, rather than an interface
+// they implement.
+func (i *InterfaceImplementor) IsConcrete() bool {
+	return !types.IsInterface(i.Type)
+}
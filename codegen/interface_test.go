@@ -0,0 +1,71 @@
+package codegen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func TestSameSignature(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { node: Node }
+		interface Node { url(absolute: Boolean): String }
+		type A implements Node { url(absolute: Boolean): String }
+		type B implements Node { url(absolute: Boolean, host: String): String! }
+		type C implements Node { url(absolute: Boolean): String! }
+	`})
+	url := func(typ string) *ast.FieldDefinition { return schema.Types[typ].Fields.ForName("url") }
+
+	require.True(t, sameSignature(url("Node"), url("A")))
+	require.False(t, sameSignature(url("Node"), url("B")))
+	require.False(t, sameSignature(url("Node"), url("C")))
+	require.False(t, sameSignature(url("Node"), nil))
+}
+
+func TestSortImplementors(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { node: Node }
+		interface Node { id: ID! }
+		interface Named { name: String! }
+		type User implements Node { id: ID! }
+		type Admin implements Node & Named { id: ID! name: String! }
+		type Bot implements Node { id: ID! }
+		type Wrapper implements Node { id: ID! }
+	`})
+	names := func(defs []*ast.Definition) []string {
+		var names []string
+		for _, def := range defs {
+			names = append(names, def.Name)
+		}
+		return names
+	}
+
+	t.Run("more specific types first, then in schema order", func(t *testing.T) {
+		b := builder{Config: &config.Config{}, Schema: schema}
+		implementors, err := b.sortImplementors(schema.Types["Node"])
+		require.NoError(t, err)
+		require.Equal(t, []string{"Admin", "User", "Bot", "Wrapper"}, names(implementors))
+		require.Equal(t, []string{"User", "Admin", "Bot", "Wrapper"}, names(schema.GetPossibleTypes(schema.Types["Node"])))
+	})
+
+	t.Run("configured priority first", func(t *testing.T) {
+		b := builder{Config: &config.Config{Models: config.TypeMap{
+			"Node": {ImplementorPriority: []string{"Wrapper", "Bot"}},
+		}}, Schema: schema}
+		implementors, err := b.sortImplementors(schema.Types["Node"])
+		require.NoError(t, err)
+		require.Equal(t, []string{"Wrapper", "Bot", "Admin", "User"}, names(implementors))
+	})
+
+	t.Run("priority must list implementors", func(t *testing.T) {
+		b := builder{Config: &config.Config{Models: config.TypeMap{
+			"Node": {ImplementorPriority: []string{"Query"}},
+		}}, Schema: schema}
+		_, err := b.sortImplementors(schema.Types["Node"])
+		require.EqualError(t, err, "models.Node.implementor_priority: Query is not a possible type of Node")
+	})
+}
@@ -0,0 +1,74 @@
+package codegen_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+// TestOptions generates the servers of testdata/options, each built with config options that change the whole
+// generated package and so can't go in the testservers, and runs their tests against the generated code.
+func TestOptions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("runs go test on the generated servers")
+	}
+
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	tests := []struct {
+		name string
+		// tags are build tags the tests of the server run with again
+		tags []string
+	}{
+		{name: "buildtags", tags: []string{"graphql_stub"}},
+		{name: "customroots"},
+		{name: "dispatchtables"},
+		{name: "implicitconversions"},
+		{name: "nilsafety"},
+		{name: "standalone"},
+		{name: "subscriptioncleanup"},
+		{name: "visibility"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(wd, "testdata", "options", tt.name)
+			t.Cleanup(func() {
+				cleanupOptions(dir)
+				_ = os.Chdir(wd)
+			})
+			require.NoError(t, os.Chdir(dir))
+
+			cfg, err := config.LoadConfigFromDefaultLocations()
+			require.NoError(t, err, "failed to load config")
+			require.NoError(t, api.Generate(cfg), "failed to generate code")
+
+			goTest(t, dir)
+			for _, tag := range tt.tags {
+				goTest(t, dir, "-tags", tag)
+			}
+		})
+	}
+}
+
+// cleanupOptions removes the files generated in dir.
+func cleanupOptions(dir string) {
+	generated, _ := filepath.Glob(filepath.Join(dir, "generated*.go"))
+	for _, f := range append(generated, filepath.Join(dir, "models-gen.go")) {
+		_ = os.Remove(f)
+	}
+}
+
+func goTest(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	// the generated code is vetted with the testservers
+	cmd := exec.Command("go", append(append([]string{"test", "-count=1", "-vet=off"}, args...), ".")...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
@@ -0,0 +1,29 @@
+package codegen
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEach(t *testing.T) {
+	var calls atomic.Int64
+	seen := make([]bool, 100)
+	forEach(len(seen), func(i int) {
+		calls.Add(1)
+		seen[i] = true
+	})
+	require.Equal(t, int64(100), calls.Load())
+	require.NotContains(t, seen, false)
+
+	forEach(0, func(i int) { t.Fatal("called without indexes") })
+
+	require.PanicsWithValue(t, 3, func() {
+		forEach(10, func(i int) {
+			if i == 3 || i == 7 {
+				panic(i)
+			}
+		})
+	})
+}
@@ -0,0 +1,58 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// FieldRetry is the @retry directive of a field, its resolver is called through graphql.WithRetry.
+type FieldRetry struct {
+	Attempts int
+	Backoff  time.Duration
+}
+
+// PolicyExpr returns the graphql.RetryPolicy of the directive as a go expression.
+func (r *FieldRetry) PolicyExpr() string {
+	if r.Backoff == 0 {
+		return "graphql.RetryPolicy{Attempts: " + strconv.Itoa(r.Attempts) + "}"
+	}
+	return "graphql.RetryPolicy{Attempts: " + strconv.Itoa(r.Attempts) + ", Backoff: " + durationExpr(r.Backoff) + "}"
+}
+
+// buildFieldRetry returns the @retry directive of field, or nil if the field doesn't use it or the directive
+// isn't the builtin one.
+func (b *builder) buildFieldRetry(obj *Object, f *Field) (*FieldRetry, error) {
+	d := f.FieldDefinition.Directives.ForName("retry")
+	if d == nil || !b.Config.Directives["retry"].Builtin() {
+		return nil, nil
+	}
+	name := obj.Name + "." + f.Name
+	if obj.Stream {
+		return nil, fmt.Errorf("@retry on %s: subscription fields can not be retried", name)
+	}
+
+	retry := &FieldRetry{}
+	attempts := d.Arguments.ForName("attempts")
+	if attempts == nil || attempts.Value.Kind != ast.IntValue {
+		return nil, fmt.Errorf("@retry on %s needs a number of attempts", name)
+	}
+	var err error
+	if retry.Attempts, err = strconv.Atoi(attempts.Value.Raw); err != nil || retry.Attempts < 1 {
+		return nil, fmt.Errorf("@retry on %s: attempts %s is not a positive number", name, attempts.Value.Raw)
+	}
+
+	backoff := d.Arguments.ForName("backoff")
+	if backoff == nil || backoff.Value.Kind == ast.NullValue {
+		return retry, nil
+	}
+	if backoff.Value.Kind != ast.StringValue {
+		return nil, fmt.Errorf("@retry on %s: backoff must be a duration string", name)
+	}
+	if retry.Backoff, err = time.ParseDuration(backoff.Value.Raw); err != nil || retry.Backoff < 0 {
+		return nil, fmt.Errorf("@retry on %s: backoff %q is not a duration", name, backoff.Value.Raw)
+	}
+	return retry, nil
+}
@@ -0,0 +1,115 @@
+package templates
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/internal/code"
+)
+
+// cacheKey returns the key of the formatted source of filename rendered as src: the hash of everything formatting
+// depends on.
+func (f Format) cacheKey(filename string, src []byte) string {
+	h := sha256.New()
+	for _, s := range []string{graphql.Version, filename, f.LocalPrefix, strings.Join(f.Command, "\x00")} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	h.Write(src)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// renderKey returns the key the formatted source of the file rendered for cfg is cached under before its templates
+// are executed: the hash of cfg.CacheKey, the templates and every option the file depends on. It is empty when the
+// file can't be cached this way.
+func renderKey(cfg Options) string {
+	if cfg.CacheKey == "" || cfg.Format.CacheDir == "" || (cfg.Template == "" && cfg.TemplateFS == nil) {
+		return ""
+	}
+	h := sha256.New()
+	write := func(s string) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	for _, s := range []string{
+		"render", graphql.Version, cfg.CacheKey, cfg.Filename, cfg.PackageName, cfg.Provenance, cfg.BuildConstraint,
+		cfg.PackageDoc, cfg.FileNotice, strconv.FormatBool(cfg.RegionTags), strconv.FormatBool(cfg.GeneratedHeader),
+		cfg.Format.LocalPrefix, strings.Join(cfg.Format.Command, "\x00"), cfg.Template,
+	} {
+		write(s)
+	}
+	if cfg.Template == "" {
+		names, err := fs.Glob(cfg.TemplateFS, "*.gotpl")
+		if err != nil {
+			return ""
+		}
+		for _, name := range names {
+			b, err := fs.ReadFile(cfg.TemplateFS, name)
+			if err != nil {
+				return ""
+			}
+			write(name)
+			write(string(b))
+		}
+	}
+	for _, o := range cfg.Overrides {
+		b, err := os.ReadFile(o)
+		if err != nil {
+			return ""
+		}
+		write(o)
+		write(string(b))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// readCache returns the formatted source cached under key, and marks it as used.
+func (f Format) readCache(key string) ([]byte, bool) {
+	if f.CacheDir == "" || key == "" {
+		return nil, false
+	}
+	path := filepath.Join(f.CacheDir, key)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return b, true
+}
+
+// writeCache caches the formatted source under key. The cache is best effort, failing to write it is ignored.
+func (f Format) writeCache(key string, formatted []byte) {
+	if f.CacheDir == "" || key == "" {
+		return
+	}
+	if err := os.MkdirAll(f.CacheDir, 0o755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(f.CacheDir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(formatted)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(f.CacheDir, key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// PruneCache removes the entries of the cache in dir that were not used for maxAge.
+func PruneCache(dir string, maxAge time.Duration) error {
+	return code.PruneCache(dir, maxAge)
+}
@@ -0,0 +1,91 @@
+package templates
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+	"text/template"
+)
+
+// overridePin matches the {{/* gqlgen:base name fingerprint */}} comments of override files, pinning the version of
+// the block an override was written against.
+var overridePin = regexp.MustCompile(`{{-?\s*/\*\s*gqlgen:base\s+(\S+)\s+(\S+)\s*\*/\s*-?}}`)
+
+// unpinnedWarned holds the override blocks already warned about not being pinned, as every file rendered with the
+// overrides applies them again.
+var unpinnedWarned sync.Map
+
+// Fingerprint identifies the content of a template block, it changes whenever the block is modified.
+func Fingerprint(t *template.Template) string {
+	if t == nil || t.Tree == nil {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(t.Tree.Root.String()))
+	return hex.EncodeToString(sum[:6])
+}
+
+// applyOverrides parses the override files on top of t, replacing the blocks they define. Every block must exist in
+// t, and blocks pinned with a gqlgen:base comment must still have the pinned fingerprint, so overrides of blocks that
+// were renamed or changed upstream fail instead of silently drifting.
+func applyOverrides(t *template.Template, funcs template.FuncMap, filenames []string) error {
+	for _, filename := range filenames {
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return fmt.Errorf("reading template override: %w", err)
+		}
+
+		fingerprints := map[string]string{}
+		for _, block := range t.Templates() {
+			fingerprints[block.Name()] = Fingerprint(block)
+		}
+
+		parsed, err := template.New(filename).Funcs(funcs).Parse(string(src))
+		if err != nil {
+			return fmt.Errorf("template override %s: %w", filename, err)
+		}
+		pins := map[string]string{}
+		for _, m := range overridePin.FindAllStringSubmatch(string(src), -1) {
+			pins[m[1]] = m[2]
+		}
+
+		var names []string
+		for _, block := range parsed.Templates() {
+			if block.Name() != filename {
+				names = append(names, block.Name())
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			current, ok := fingerprints[name]
+			if !ok {
+				return fmt.Errorf("template override %s: %s is not a block of the template, it may have been renamed or removed", filename, name)
+			}
+			pin, ok := pins[name]
+			if !ok {
+				if _, warned := unpinnedWarned.LoadOrStore(filename+"#"+name, true); !warned {
+					log.Printf("template override %s: pin %s with {{/* gqlgen:base %s %s */}} to be told when it changes", filename, name, name, current)
+				}
+				continue
+			}
+			if pin != current {
+				return fmt.Errorf("template override %s: %s changed since the override was written, update the override from the new block and pin it to %s", filename, name, current)
+			}
+		}
+		for name := range pins {
+			if _, ok := fingerprints[name]; !ok {
+				return fmt.Errorf("template override %s: %s is not a block of the template, it may have been renamed or removed", filename, name)
+			}
+		}
+
+		// not named after the file, so it is never rendered as a root template
+		if _, err := t.New(filename + "#override").Parse(string(src)); err != nil {
+			return fmt.Errorf("template override %s: %w", filename, err)
+		}
+	}
+	return nil
+}
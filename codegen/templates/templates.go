@@ -6,6 +6,7 @@ import (
 	"go/types"
 	"io/fs"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"reflect"
 	"regexp"
@@ -47,6 +48,10 @@ type Options struct {
 	Filename        string
 	RegionTags      bool
 	GeneratedHeader bool
+	// Provenance is a comment written below the generated header, such as the version and config generating the file
+	Provenance string
+	// BuildConstraint is a build constraint expression written as a //go:build line at the top of the file
+	BuildConstraint string
 	// PackageDoc is documentation written above the package line
 	PackageDoc string
 	// FileNotice is notice written below the package line
@@ -54,9 +59,31 @@ type Options struct {
 	// Data will be passed to the template execution.
 	Data  interface{}
 	Funcs template.FuncMap
+	// CacheKey identifies everything Data and Funcs are built from. When it is set along with Format.CacheDir, a file
+	// rendered before with the same key, templates and options is written from the cache without executing the
+	// templates again. Leave it empty unless the key covers every input of Data.
+	CacheKey string
 
 	// Packages cache, you can find me on config.Config
 	Packages *code.Packages
+
+	// Overrides are template files parsed after the template, redefining some of its blocks with {{ define }}.
+	Overrides []string
+
+	// Format configures how the rendered file is formatted.
+	Format Format
+}
+
+// Format configures how rendered files are formatted, after unused imports are removed and gofmt is applied.
+type Format struct {
+	// LocalPrefix lists comma separated import path prefixes grouped after third party imports, like goimports -local.
+	LocalPrefix string
+	// Command formats the file further, such as gofumpt: it receives the source on stdin and writes the result to
+	// stdout, from the directory of the file.
+	Command []string
+	// CacheDir keeps the formatted source of files by the hash of their rendered source, so files rendered the same as
+	// in an earlier run are written without being formatted again. Empty disables the cache.
+	CacheDir string
 }
 
 var (
@@ -70,10 +97,64 @@ var (
 // plugins. If Options.Template is empty, the Render function will look for `.gotpl`
 // files inside the directory where you wrote the plugin.
 func Render(cfg Options) error {
+	key := renderKey(cfg)
+	if formatted, ok := cfg.Format.readCache(key); ok {
+		return writeCached(cfg, formatted)
+	}
+	src, err := execute(cfg)
+	if err != nil {
+		return err
+	}
+	return writeRendered(cfg, src, key)
+}
+
+// RenderAll renders a file for each of opts like Render. Templates are executed one at a time, as they share
+// CurrentImports, while the files already rendered are formatted and written concurrently. It returns the error of
+// the first file that failed in the order of opts, the files after a template that failed to execute are not rendered.
+func RenderAll(opts []Options) error {
+	errs := make([]error, len(opts))
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+	for i, cfg := range opts {
+		key := renderKey(cfg)
+		formatted, cached := cfg.Format.readCache(key)
+		var src []byte
+		if !cached {
+			var err error
+			if src, err = execute(cfg); err != nil {
+				errs[i] = err
+				break
+			}
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, cfg Options) {
+			defer wg.Done()
+			if cached {
+				errs[i] = writeCached(cfg, formatted)
+			} else {
+				errs[i] = writeRendered(cfg, src, key)
+			}
+			<-sem
+		}(i, cfg)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execute executes the templates of cfg, returning the source of the file before it is formatted.
+func execute(cfg Options) ([]byte, error) {
 	if CurrentImports != nil {
 		panic(fmt.Errorf("recursive or concurrent call to RenderToFile detected"))
 	}
 	CurrentImports = &Imports{packages: cfg.Packages, destDir: filepath.Dir(cfg.Filename)}
+	defer func() { CurrentImports = nil }()
 
 	funcs := Funcs()
 	for n, f := range cfg.Funcs {
@@ -83,7 +164,10 @@ func Render(cfg Options) error {
 	t := template.New("").Funcs(funcs)
 	t, err := parseTemplates(cfg, t)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if err := applyOverrides(t, funcs, cfg.Overrides); err != nil {
+		return nil, err
 	}
 
 	roots := make([]string, 0, len(t.Templates()))
@@ -117,7 +201,7 @@ func Render(cfg Options) error {
 		}
 		err := t.Lookup(root).Execute(&buf, cfg.Data)
 		if err != nil {
-			return fmt.Errorf("%s: %w", root, err)
+			return nil, fmt.Errorf("%s: %w", root, err)
 		}
 		if cfg.RegionTags {
 			buf.WriteString("\n// endregion " + center(70, "*", " "+root+" ") + "\n")
@@ -125,8 +209,15 @@ func Render(cfg Options) error {
 	}
 
 	var result bytes.Buffer
+	if cfg.BuildConstraint != "" {
+		result.WriteString("//go:build " + cfg.BuildConstraint + "\n\n")
+	}
 	if cfg.GeneratedHeader {
-		result.WriteString("// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n\n")
+		result.WriteString("// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.\n")
+		if cfg.Provenance != "" {
+			result.WriteString("// " + cfg.Provenance + "\n")
+		}
+		result.WriteString("\n")
 	}
 	if cfg.PackageDoc != "" {
 		result.WriteString(cfg.PackageDoc + "\n")
@@ -143,14 +234,31 @@ func Render(cfg Options) error {
 	result.WriteString(")\n")
 	_, err = buf.WriteTo(&result)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	CurrentImports = nil
+	return result.Bytes(), nil
+}
 
-	err = write(cfg.Filename, result.Bytes(), cfg.Packages)
+// writeRendered formats and writes the source rendered for cfg, caching it under the render key of cfg, and evicts
+// the package it changed.
+func writeRendered(cfg Options, src []byte, key string) error {
+	formatted, err := formatSource(cfg.Filename, src, cfg.Packages, cfg.Format)
 	if err != nil {
 		return err
 	}
+	if formatted != nil {
+		cfg.Format.writeCache(key, formatted)
+	} else {
+		formatted = src
+	}
+	return writeCached(cfg, formatted)
+}
+
+// writeCached writes the formatted source of the file rendered for cfg, and evicts the package it changed.
+func writeCached(cfg Options, formatted []byte) error {
+	if err := write(cfg.Filename, formatted); err != nil {
+		return err
+	}
 
 	cfg.Packages.Evict(code.ImportPathForDir(filepath.Dir(cfg.Filename)))
 	return nil
@@ -658,18 +766,38 @@ func render(filename string, tpldata interface{}) (*bytes.Buffer, error) {
 	return buf, t.Execute(buf, tpldata)
 }
 
-func write(filename string, b []byte, packages *code.Packages) error {
-	err := os.MkdirAll(filepath.Dir(filename), 0o755)
+// formatSource prunes the imports of the source b rendered for filename and formats it, reading and writing the formatted
+// source of the cache. It returns nil if b doesn't parse, so the file can still be written to see why.
+func formatSource(filename string, b []byte, packages *code.Packages, format Format) ([]byte, error) {
+	key := format.cacheKey(filename, b)
+	if formatted, cached := format.readCache(key); cached {
+		return formatted, nil
+	}
+	formatted, err := imports.Prune(filename, b, packages, format.LocalPrefix)
 	if err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
+		fmt.Fprintf(os.Stderr, "gofmt failed on %s: %s\n", filepath.Base(filename), err.Error())
+		return nil, nil
+	}
+	if len(format.Command) > 0 {
+		formatted, err = runFormatter(filename, formatted, format.Command)
+		if err != nil {
+			return nil, err
+		}
 	}
+	format.writeCache(key, formatted)
+	return formatted, nil
+}
 
-	formatted, err := imports.Prune(filename, b, packages)
+// write writes the formatted source of filename, unless the file already has that content.
+func write(filename string, formatted []byte) error {
+	err := os.MkdirAll(filepath.Dir(filename), 0o755)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "gofmt failed on %s: %s\n", filepath.Base(filename), err.Error())
-		formatted = b
+		return fmt.Errorf("failed to create directory: %w", err)
 	}
 
+	if existing, err := os.ReadFile(filename); err == nil && bytes.Equal(existing, formatted) {
+		return nil
+	}
 	err = os.WriteFile(filename, formatted, 0o644)
 	if err != nil {
 		return fmt.Errorf("failed to write %s: %w", filename, err)
@@ -678,6 +806,19 @@ func write(filename string, b []byte, packages *code.Packages) error {
 	return nil
 }
 
+func runFormatter(filename string, src []byte, command []string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = filepath.Dir(filename)
+	cmd.Stdin = bytes.NewReader(src)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("formatting %s with %s: %w: %s", filepath.Base(filename), command[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
 var pkgReplacer = strings.NewReplacer(
 	"/", "ᚋ",
 	".", "ᚗ",
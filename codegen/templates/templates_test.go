@@ -1,11 +1,16 @@
 package templates
 
 import (
+	"bytes"
 	"embed"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"text/template"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -357,3 +362,199 @@ func TestRenderFS(t *testing.T) {
 	// don't look at last character since it's \n on Linux and \r\n on Windows
 	assert.Equal(t, expectedString, actualContentsStr[:len(expectedString)])
 }
+
+func TestRenderHeader(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "gqlgen.go")
+	err := Render(Options{
+		Template:        "var x = 1",
+		PackageName:     "test",
+		Filename:        filename,
+		GeneratedHeader: true,
+		Provenance:      "gqlgen version v0.17.0, config sha256:abc",
+		BuildConstraint: "!graphql_stub",
+		Packages:        code.NewPackages(),
+	})
+	require.NoError(t, err)
+
+	b, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(b), `//go:build !graphql_stub
+
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+// gqlgen version v0.17.0, config sha256:abc
+
+package test
+`), string(b))
+}
+
+func TestRenderOverrides(t *testing.T) {
+	const base = `var a = {{ block "a" . }}1{{ end }}
+var b = {{ block "b" . }}2{{ end }}`
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "gqlgen.go")
+	render := func(override string) error {
+		overrideFile := filepath.Join(dir, "override.gotpl")
+		require.NoError(t, os.WriteFile(overrideFile, []byte(override), 0o644))
+		return Render(Options{
+			Template:    base,
+			PackageName: "test",
+			Filename:    filename,
+			Packages:    code.NewPackages(),
+			Overrides:   []string{overrideFile},
+		})
+	}
+	pin := Fingerprint(template.Must(template.New("").Parse(base)).Lookup("b"))
+
+	t.Run("redefines blocks", func(t *testing.T) {
+		require.NoError(t, render(`{{/* gqlgen:base b `+pin+` */}}
+{{ define "b" }}3{{ end }}`))
+		b, err := os.ReadFile(filename)
+		require.NoError(t, err)
+		require.Contains(t, string(b), "var a = 1\nvar b = 3\n")
+	})
+
+	t.Run("fails when the pinned block changed", func(t *testing.T) {
+		err := render(`{{/* gqlgen:base b 000000000000 */}}{{ define "b" }}3{{ end }}`)
+		require.ErrorContains(t, err, "b changed since the override was written, update the override from the new block and pin it to "+pin)
+	})
+
+	t.Run("fails on unknown blocks", func(t *testing.T) {
+		err := render(`{{ define "c" }}3{{ end }}`)
+		require.ErrorContains(t, err, "c is not a block of the template, it may have been renamed or removed")
+	})
+
+	t.Run("warns once about unpinned blocks", func(t *testing.T) {
+		var logs bytes.Buffer
+		log.SetOutput(&logs)
+		defer log.SetOutput(os.Stderr)
+
+		require.NoError(t, render(`{{ define "a" }}4{{ end }}`))
+		require.NoError(t, render(`{{ define "a" }}4{{ end }}`))
+		require.Equal(t, 1, strings.Count(logs.String(), "pin a with"))
+	})
+}
+
+func TestRenderFormatCommand(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "gqlgen.go")
+	render := func(command ...string) error {
+		return Render(Options{
+			Template:    "var x = 1",
+			PackageName: "test",
+			Filename:    filename,
+			Packages:    code.NewPackages(),
+			Format:      Format{Command: command},
+		})
+	}
+
+	require.NoError(t, render("sed", "s/x = 1/x = 2/"))
+	b, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "var x = 2")
+
+	require.ErrorContains(t, render("sh", "-c", "echo broken >&2; exit 1"), "formatting gqlgen.go with sh: exit status 1: broken")
+}
+
+func TestRenderCache(t *testing.T) {
+	dir := t.TempDir()
+	cacheDir := filepath.Join(dir, "cache")
+	runs := filepath.Join(dir, "runs")
+	filename := filepath.Join(dir, "gqlgen.go")
+	render := func(tpl string) {
+		require.NoError(t, Render(Options{
+			Template:    tpl,
+			PackageName: "test",
+			Filename:    filename,
+			Packages:    code.NewPackages(),
+			Format:      Format{Command: []string{"sh", "-c", "echo run >> " + runs + "; cat"}, CacheDir: cacheDir},
+		}))
+	}
+	formatted := func() int {
+		b, err := os.ReadFile(runs)
+		require.NoError(t, err)
+		return strings.Count(string(b), "run")
+	}
+
+	render("var x = 1")
+	require.Equal(t, 1, formatted())
+
+	require.NoError(t, os.Remove(filename))
+	render("var x = 1")
+	require.Equal(t, 1, formatted(), "the cached source is written without formatting it again")
+	b, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "var x = 1")
+
+	render("var x = 2")
+	require.Equal(t, 2, formatted())
+
+	entries, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	old := time.Now().Add(-time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(cacheDir, entries[0].Name()), old, old))
+	require.NoError(t, PruneCache(cacheDir, time.Minute))
+	left, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{entries[1].Name()}, []string{left[0].Name()})
+	require.NoError(t, PruneCache(filepath.Join(dir, "missing"), time.Minute))
+}
+
+func TestRenderCacheKey(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "gqlgen.go")
+	executed := 0
+	render := func(key string) {
+		require.NoError(t, Render(Options{
+			Template:    "var x = {{ value }}",
+			PackageName: "test",
+			Filename:    filename,
+			Funcs: template.FuncMap{"value": func() int {
+				executed++
+				return 1
+			}},
+			Packages: code.NewPackages(),
+			Format:   Format{CacheDir: filepath.Join(dir, "cache")},
+			CacheKey: key,
+		}))
+	}
+
+	render("a")
+	require.Equal(t, 1, executed)
+	require.NoError(t, os.Remove(filename))
+	render("a")
+	require.Equal(t, 1, executed, "the templates are not executed again for the same key")
+	b, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Contains(t, string(b), "var x = 1")
+
+	render("b")
+	require.Equal(t, 2, executed)
+	render("")
+	render("")
+	require.Equal(t, 4, executed, "files without a key are always executed")
+}
+
+func TestRenderAll(t *testing.T) {
+	dir := t.TempDir()
+	opts := make([]Options, 3)
+	for i := range opts {
+		opts[i] = Options{
+			Template:    fmt.Sprintf("var x = %d", i),
+			PackageName: "test",
+			Filename:    filepath.Join(dir, fmt.Sprintf("file%d.go", i)),
+			Packages:    code.NewPackages(),
+		}
+	}
+	require.NoError(t, RenderAll(opts))
+	for i := range opts {
+		b, err := os.ReadFile(opts[i].Filename)
+		require.NoError(t, err)
+		require.Contains(t, string(b), fmt.Sprintf("var x = %d", i))
+	}
+
+	opts[1].Template = "{{ .Missing }}"
+	opts[1].Data = struct{}{}
+	require.NoError(t, os.Remove(opts[2].Filename))
+	require.ErrorContains(t, RenderAll(opts), "can't evaluate field Missing")
+	require.NoFileExists(t, opts[2].Filename, "files after a failed template are not rendered")
+}
@@ -0,0 +1,28 @@
+//go:build !graphql_stub
+
+package buildtags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestBuildTags(t *testing.T) {
+	es := NewExecutableSchema(Config{Resolvers: &Resolver{}, Directives: DirectiveRoot{Upper: upper}})
+	c := client.New(handler.NewDefaultServer(es))
+
+	var resp struct {
+		Greeting struct {
+			Text string
+			Tone string
+		}
+	}
+	c.MustPost(`{ greeting(input: {name: "bob"}) { text tone } }`, &resp)
+	require.Equal(t, "HELLO BOB", resp.Greeting.Text)
+	require.Equal(t, "PLAIN", resp.Greeting.Tone)
+	require.Equal(t, []Tone{TonePlain, ToneLoud}, AllTone)
+}
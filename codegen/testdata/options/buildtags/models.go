@@ -0,0 +1,8 @@
+package buildtags
+
+type Tone int
+
+const (
+	ToneLoud  Tone = 1
+	TonePlain Tone = 3
+)
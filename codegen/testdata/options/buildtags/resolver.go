@@ -0,0 +1,26 @@
+package buildtags
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type Resolver struct{}
+
+func (r *Resolver) Query() QueryResolver {
+	return r
+}
+
+func (r *Resolver) Greeting(ctx context.Context, input GreetingInput) (*Greeting, error) {
+	return &Greeting{Text: "hello " + input.Name, Tone: TonePlain}, nil
+}
+
+func upper(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	v, err := next(ctx)
+	if s, ok := v.(string); ok {
+		return strings.ToUpper(s), err
+	}
+	return v, err
+}
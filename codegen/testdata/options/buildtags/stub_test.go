@@ -0,0 +1,27 @@
+//go:build graphql_stub
+
+package buildtags
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStub(t *testing.T) {
+	cfg := Config{Resolvers: &Resolver{}, Directives: DirectiveRoot{Upper: upper}}
+	require.PanicsWithError(t, errExecutableSchemaExcluded.Error(), func() {
+		NewExecutableSchema(cfg)
+	})
+
+	_, err := UnmarshalGreetingInput(context.Background(), nil, map[string]interface{}{"name": "bob"})
+	require.ErrorIs(t, err, errExecutableSchemaExcluded)
+	_, err = MarshalGreeting(context.Background(), nil, &Greeting{}, "")
+	require.ErrorIs(t, err, errExecutableSchemaExcluded)
+	require.Empty(t, FieldMeta)
+
+	require.Equal(t, []Tone{TonePlain, ToneLoud}, AllTone)
+	require.True(t, IsValidTone(ToneLoud))
+	require.False(t, IsValidTone(Tone(2)))
+}
@@ -0,0 +1,65 @@
+package customroots
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+func TestCustomRoots(t *testing.T) {
+	cfg := Config{Resolvers: &Resolver{}}
+	cfg.Complexity.RootQuery.Items = func(childComplexity, first int) int {
+		return first * childComplexity
+	}
+	srv := handler.NewDefaultServer(NewExecutableSchema(cfg))
+	srv.Use(extension.FixedComplexityLimit(10))
+	c := client.New(srv)
+
+	t.Run("mutation and query", func(t *testing.T) {
+		var resp struct {
+			AddItem struct{ Name string }
+			Query   struct {
+				Items []struct{ Name string }
+			}
+		}
+		c.MustPost(`mutation { addItem(name: "desk") { name } query { items(first: 5) { name } } }`, &resp)
+		require.Equal(t, "desk", resp.AddItem.Name)
+		require.Len(t, resp.Query.Items, 1)
+		require.Equal(t, "desk", resp.Query.Items[0].Name)
+	})
+
+	t.Run("complexity", func(t *testing.T) {
+		var resp struct{}
+		err := c.Post(`{ items(first: 20) { name } }`, &resp)
+		require.EqualError(t, err, `[{"message":"operation has complexity 20, which exceeds the limit of 10","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}]`)
+	})
+
+	t.Run("subscription", func(t *testing.T) {
+		sub := c.Websocket(`subscription { itemAdded { name } }`)
+		defer sub.Close()
+
+		var resp struct {
+			ItemAdded struct{ Name string }
+		}
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, "lamp", resp.ItemAdded.Name)
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		var resp struct {
+			Schema struct {
+				QueryType        struct{ Name string }
+				MutationType     struct{ Name string }
+				SubscriptionType struct{ Name string }
+			} `json:"__schema"`
+		}
+		c.MustPost(`{ __schema { queryType { name } mutationType { name } subscriptionType { name } } }`, &resp)
+		require.Equal(t, "RootQuery", resp.Schema.QueryType.Name)
+		require.Equal(t, "RootMutation", resp.Schema.MutationType.Name)
+		require.Equal(t, "RootSubscription", resp.Schema.SubscriptionType.Name)
+	})
+}
@@ -0,0 +1,44 @@
+package customroots
+
+import "context"
+
+type Resolver struct {
+	items []*Item
+}
+
+func (r *Resolver) RootQuery() RootQueryResolver {
+	return r
+}
+
+func (r *Resolver) RootMutation() RootMutationResolver {
+	return &rootMutationResolver{r}
+}
+
+func (r *Resolver) RootSubscription() RootSubscriptionResolver {
+	return r
+}
+
+func (r *Resolver) Items(ctx context.Context, first int) ([]*Item, error) {
+	if first < len(r.items) {
+		return r.items[:first], nil
+	}
+	return r.items, nil
+}
+
+func (r *Resolver) ItemAdded(ctx context.Context) (<-chan *Item, error) {
+	ch := make(chan *Item, 1)
+	ch <- &Item{Name: "lamp"}
+	return ch, nil
+}
+
+type rootMutationResolver struct{ *Resolver }
+
+func (r *rootMutationResolver) AddItem(ctx context.Context, name string) (*Item, error) {
+	item := &Item{Name: name}
+	r.items = append(r.items, item)
+	return item, nil
+}
+
+func (r *rootMutationResolver) Query(ctx context.Context) (*RootQuery, error) {
+	return &RootQuery{}, nil
+}
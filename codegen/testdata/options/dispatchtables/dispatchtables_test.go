@@ -0,0 +1,59 @@
+package dispatchtables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestDispatchTables(t *testing.T) {
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: &Resolver{}})))
+
+	t.Run("union", func(t *testing.T) {
+		var resp struct {
+			Results []map[string]interface{}
+		}
+		c.MustPost(`{ results { __typename ... on Photo { url } ... on Video { url duration } } }`, &resp)
+		require.Equal(t, []map[string]interface{}{
+			{"__typename": "Photo", "url": "a.png"},
+			{"__typename": "Video", "url": "b.mp4", "duration": float64(3)},
+		}, resp.Results)
+	})
+
+	t.Run("interface with an implementor bound to an interface", func(t *testing.T) {
+		var resp struct {
+			Animals []map[string]interface{}
+		}
+		c.MustPost(`{ animals { __typename name } }`, &resp)
+		require.Equal(t, []map[string]interface{}{
+			{"__typename": "Dog", "name": "rex"},
+			{"__typename": "Cat", "name": "tabby"},
+			{"__typename": "Cat", "name": "fox"},
+			nil,
+		}, resp.Animals)
+	})
+}
+
+// BenchmarkDispatchTables marshals union members found in the dispatch table, and interface values that are partly
+// matched by the type switch behind it.
+func BenchmarkDispatchTables(b *testing.B) {
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: &Resolver{}})))
+	for _, bm := range []struct {
+		name  string
+		query string
+	}{
+		{"table", `{ results { __typename } }`},
+		{"switch", `{ animals { __typename } }`},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var resp map[string]interface{}
+				c.MustPost(bm.query, &resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package dispatchtables
+
+// Cat is bound to an interface, so its implementations are only matched by the type switch behind the dispatch table.
+type Cat interface {
+	Animal
+	Name() string
+}
+
+type tabby struct{}
+
+func (tabby) IsAnimal()       {}
+func (tabby) GetName() string { return "tabby" }
+func (tabby) Name() string    { return "tabby" }
+
+// Fox implements Cat too, so the type switch matches its values with Cat, listed before it, and the dispatch table
+// leaves it out to do the same.
+type Fox struct{}
+
+func (Fox) IsAnimal()       {}
+func (Fox) GetName() string { return "fox" }
+func (Fox) Name() string    { return "fox" }
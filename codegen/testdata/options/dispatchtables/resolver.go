@@ -0,0 +1,17 @@
+package dispatchtables
+
+import "context"
+
+type Resolver struct{}
+
+func (r *Resolver) Query() QueryResolver {
+	return r
+}
+
+func (r *Resolver) Results(ctx context.Context) ([]Result, error) {
+	return []Result{&Photo{URL: "a.png"}, Video{URL: "b.mp4", Duration: 3}}, nil
+}
+
+func (r *Resolver) Animals(ctx context.Context) ([]Animal, error) {
+	return []Animal{&Dog{Name: "rex"}, tabby{}, Fox{}, (*Dog)(nil)}, nil
+}
@@ -0,0 +1,50 @@
+package implicitconversions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestImplicitConversions(t *testing.T) {
+	age := Age(42)
+	resolvers := &Resolver{users: []*User{
+		{ID: "1", Age: &age, Score: 0.5, Admin: true, Tags: []Tag{"a", "b"}},
+		{ID: "2", Score: 1, Tags: []Tag{"b"}},
+	}}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	t.Run("marshals named types", func(t *testing.T) {
+		var resp struct {
+			User struct {
+				ID    string
+				Age   *int
+				Score float64
+				Admin bool
+				Tags  []string
+			}
+		}
+		c.MustPost(`{ user(id: "1") { id age score admin tags } }`, &resp)
+		require.Equal(t, "1", resp.User.ID)
+		require.Equal(t, 42, *resp.User.Age)
+		require.Equal(t, 0.5, resp.User.Score)
+		require.True(t, resp.User.Admin)
+		require.Equal(t, []string{"a", "b"}, resp.User.Tags)
+
+		c.MustPost(`{ user(id: "2") { id age score admin tags } }`, &resp)
+		require.Nil(t, resp.User.Age)
+	})
+
+	t.Run("unmarshals named types", func(t *testing.T) {
+		var resp struct{ Users []struct{ ID string } }
+		c.MustPost(`{ users(ids: ["1", "2"], filter: {minAge: 18}) { id } }`, &resp)
+		require.Len(t, resp.Users, 1)
+		require.Equal(t, "1", resp.Users[0].ID)
+
+		c.MustPost(`{ users(ids: ["1", "2"], filter: {tags: ["b"]}) { id } }`, &resp)
+		require.Len(t, resp.Users, 2)
+	})
+}
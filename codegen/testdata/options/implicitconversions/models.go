@@ -0,0 +1,22 @@
+package implicitconversions
+
+type (
+	UserID string
+	Age    int
+	Score  float64
+	Flag   bool
+	Tag    string
+)
+
+type User struct {
+	ID    UserID
+	Age   *Age
+	Score Score
+	Admin Flag
+	Tags  []Tag
+}
+
+type UserFilter struct {
+	MinAge *Age
+	Tags   []Tag
+}
@@ -0,0 +1,49 @@
+package implicitconversions
+
+import (
+	"context"
+	"slices"
+)
+
+type Resolver struct {
+	users []*User
+}
+
+func (r *Resolver) Query() QueryResolver {
+	return r
+}
+
+func (r *Resolver) User(ctx context.Context, id string) (*User, error) {
+	for _, u := range r.users {
+		if u.ID == UserID(id) {
+			return u, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Resolver) Users(ctx context.Context, ids []string, filter *UserFilter) ([]*User, error) {
+	var res []*User
+	for _, id := range ids {
+		u, _ := r.User(ctx, id)
+		if u != nil && filter.matches(u) {
+			res = append(res, u)
+		}
+	}
+	return res, nil
+}
+
+func (f *UserFilter) matches(u *User) bool {
+	if f == nil {
+		return true
+	}
+	if f.MinAge != nil && (u.Age == nil || *u.Age < *f.MinAge) {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !slices.Contains(u.Tags, tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package nilsafety
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+type triangle struct{}
+
+func (triangle) IsShape()         {}
+func (triangle) GetArea() float64 { return 1 }
+
+func TestNilSafety(t *testing.T) {
+	resolvers := &Resolver{}
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+	c := client.New(srv)
+
+	t.Run("nil object", func(t *testing.T) {
+		resolvers.UserFunc = func() (*User, error) { return nil, nil }
+
+		var resp struct{ User *User }
+		err := c.Post("query {\n  user { id }\n}", &resp)
+		require.EqualError(t, err, `[{"message":"must not be null","path":["user"],"locations":[{"line":2,"column":3}]}]`)
+	})
+
+	t.Run("nil list element", func(t *testing.T) {
+		resolvers.UsersFunc = func() ([]*User, error) { return []*User{{ID: "1"}, nil}, nil }
+
+		var resp struct{ Users []*User }
+		err := c.Post("query { users { id } }", &resp)
+		require.EqualError(t, err, `[{"message":"must not be null","path":["users",1],"locations":[{"line":1,"column":9}]}]`)
+	})
+
+	t.Run("typed nil implementor", func(t *testing.T) {
+		resolvers.ShapeFunc = func() (Shape, error) { return (*Circle)(nil), nil }
+
+		var resp struct{ Shape map[string]interface{} }
+		err := c.Post("query { shape { area } }", &resp)
+		require.EqualError(t, err, `[{"message":"must not be null","path":["shape"],"locations":[{"line":1,"column":9}]}]`)
+	})
+
+	t.Run("unknown implementor", func(t *testing.T) {
+		resolvers.ShapesFunc = func() ([]Shape, error) { return []Shape{&Square{Area: 4}, triangle{}}, nil }
+
+		var resp struct{ Shapes []map[string]interface{} }
+		err := c.Post("query { shapes { area } }", &resp)
+		require.EqualError(t, err, `[{"message":"unexpected type nilsafety.triangle, expected Shape","path":["shapes",1],"locations":[{"line":1,"column":9}]}]`)
+		require.Nil(t, resp.Shapes)
+	})
+
+	t.Run("unexpected type from middleware", func(t *testing.T) {
+		srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+		srv.AroundFields(func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+			if graphql.GetFieldContext(ctx).Field.Name == "user" {
+				return "not a user", nil
+			}
+			return next(ctx)
+		})
+
+		var resp struct{ User *User }
+		err := client.New(srv).Post("query { user { id } }", &resp)
+		require.EqualError(t, err, `[{"message":"unexpected type string, expected *User","path":["user"],"locations":[{"line":1,"column":9}]}]`)
+	})
+}
@@ -0,0 +1,30 @@
+package nilsafety
+
+import "context"
+
+type Resolver struct {
+	UserFunc   func() (*User, error)
+	UsersFunc  func() ([]*User, error)
+	ShapeFunc  func() (Shape, error)
+	ShapesFunc func() ([]Shape, error)
+}
+
+func (r *Resolver) Query() QueryResolver {
+	return r
+}
+
+func (r *Resolver) User(ctx context.Context) (*User, error) {
+	return r.UserFunc()
+}
+
+func (r *Resolver) Users(ctx context.Context) ([]*User, error) {
+	return r.UsersFunc()
+}
+
+func (r *Resolver) Shape(ctx context.Context) (Shape, error) {
+	return r.ShapeFunc()
+}
+
+func (r *Resolver) Shapes(ctx context.Context) ([]Shape, error) {
+	return r.ShapesFunc()
+}
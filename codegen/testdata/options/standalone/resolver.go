@@ -0,0 +1,42 @@
+package standalone
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type Resolver struct{}
+
+func (r *Resolver) Query() QueryResolver {
+	return r
+}
+
+func (r *Resolver) Order() OrderResolver {
+	return r
+}
+
+func (r *Resolver) CreateOrder(ctx context.Context, input OrderInput) (*Order, error) {
+	return &Order{ID: "1", Customer: input.Customer}, nil
+}
+
+func (r *Resolver) Label(ctx context.Context, obj *Order, upper *bool) (*string, error) {
+	if obj.Customer == "" {
+		return nil, errors.New("order has no customer")
+	}
+	label := obj.ID + "/" + obj.Customer
+	if upper != nil && *upper {
+		label = strings.ToUpper(label)
+	}
+	return &label, nil
+}
+
+func trim(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	v, err := next(ctx)
+	if s, ok := v.(string); ok {
+		return strings.TrimSpace(s), err
+	}
+	return v, err
+}
@@ -0,0 +1,105 @@
+package standalone
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func decodeJSON(t *testing.T, s string) interface{} {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	require.NoError(t, dec.Decode(&v))
+	return v
+}
+
+func TestUnmarshalInput(t *testing.T) {
+	es := NewExecutableSchema(Config{Resolvers: &Resolver{}, Directives: DirectiveRoot{Trim: trim}})
+	ctx := context.Background()
+
+	t.Run("same semantics as arguments", func(t *testing.T) {
+		order, err := UnmarshalOrderInput(ctx, es, decodeJSON(t, `{"customer":"  bob ","lines":{"sku":"a","quantity":2}}`))
+		require.NoError(t, err)
+		require.Equal(t, OrderInput{
+			Customer: "bob",
+			Priority: ptr(PriorityLow),
+			Lines:    []*LineInput{{Sku: "a", Quantity: 2}},
+		}, order)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		_, err := UnmarshalOrderInput(ctx, es, decodeJSON(t, `{"customer":"bob","lines":[{"sku":"a"}]}`))
+		require.EqualError(t, err, "input: variable.input.lines[0].quantity must be defined")
+	})
+
+	t.Run("registry", func(t *testing.T) {
+		line, err := InputUnmarshalers["LineInput"](ctx, es, decodeJSON(t, `{"sku":"a","quantity":1}`))
+		require.NoError(t, err)
+		require.Equal(t, LineInput{Sku: "a", Quantity: 1}, line)
+	})
+}
+
+func TestMarshalObject(t *testing.T) {
+	es := NewExecutableSchema(Config{Resolvers: &Resolver{}, Directives: DirectiveRoot{Trim: trim}})
+	ctx := context.Background()
+	order := &Order{
+		ID:       "1",
+		Customer: "bob",
+		Priority: PriorityHigh,
+		Lines:    []*Line{{Sku: "a", Quantity: 2}},
+	}
+
+	t.Run("selection", func(t *testing.T) {
+		b, err := MarshalOrder(ctx, es, order, `{ id label(upper: true) lines { ...L } } fragment L on Line { sku }`)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":"1","label":"1/BOB","lines":[{"sku":"a"}]}`, string(b))
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		b, err := MarshalOrder(ctx, es, order, "")
+		require.NoError(t, err)
+		require.JSONEq(t, `{"id":"1","customer":"bob","priority":"HIGH","label":"1/bob"}`, string(b))
+	})
+
+	t.Run("field errors", func(t *testing.T) {
+		b, err := MarshalOrder(ctx, es, &Order{ID: "2"}, "{ id label }")
+		require.EqualError(t, err, "input: label order has no customer\n")
+		require.JSONEq(t, `{"id":"2","label":null}`, string(b))
+	})
+
+	t.Run("invalid selection", func(t *testing.T) {
+		_, err := MarshalLine(ctx, es, &Line{}, "{ price }")
+		require.ErrorContains(t, err, `Cannot query field "price" on type "Line".`)
+	})
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestFieldMeta(t *testing.T) {
+	require.Equal(t, graphql.FieldMeta{
+		GoName:     "Label",
+		Resolution: graphql.ResolvedByResolver,
+		Args:       []graphql.ArgMeta{{Name: "upper", GoName: "upper", GoType: "*bool"}},
+	}, FieldMeta["Order"]["label"])
+	require.Equal(t, graphql.FieldMeta{
+		GoName:     "CreateOrder",
+		Resolution: graphql.ResolvedByResolver,
+		Args: []graphql.ArgMeta{{
+			Name:   "input",
+			GoName: "input",
+			GoType: "github.com/99designs/gqlgen/codegen/testdata/options/standalone.OrderInput",
+		}},
+	}, FieldMeta["Query"]["createOrder"])
+	require.Equal(t, graphql.FieldMeta{GoName: "Sku", Resolution: graphql.ResolvedByField}, FieldMeta["Line"]["sku"])
+
+	require.Len(t, FieldMeta, 3)
+	require.NotContains(t, FieldMeta["Query"], "__schema")
+}
@@ -0,0 +1,71 @@
+package subscriptioncleanup
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type Resolver struct {
+	mu       sync.Mutex
+	cleanups int
+	cleaned  chan struct{}
+}
+
+func (r *Resolver) Query() QueryResolver {
+	return &queryResolver{r}
+}
+
+func (r *Resolver) Subscription() SubscriptionResolver {
+	return &subscriptionResolver{r}
+}
+
+func (r *Resolver) cleanup() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cleanups++
+	if r.cleaned != nil {
+		r.cleaned <- struct{}{}
+	}
+}
+
+type queryResolver struct{ *Resolver }
+
+func (r *queryResolver) Cleanups(ctx context.Context) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cleanups, nil
+}
+
+type subscriptionResolver struct{ *Resolver }
+
+// Messages sends count messages then closes the channel, or sends messages until the subscription ends without count.
+func (r *subscriptionResolver) Messages(ctx context.Context, count *int) (<-chan string, func(), error) {
+	ch := make(chan string)
+	stop := make(chan struct{})
+	go func() {
+		defer close(ch)
+		for i := 1; count == nil || i <= *count; i++ {
+			select {
+			case ch <- "message " + strconv.Itoa(i):
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return ch, func() {
+		close(stop)
+		r.cleanup()
+	}, nil
+}
+
+func (r *subscriptionResolver) Counter(ctx context.Context) (graphql.InitialPayload[int], func(), error) {
+	return graphql.InitialPayload[int]{Initial: 1}, r.cleanup, nil
+}
+
+func (r *subscriptionResolver) Failing(ctx context.Context) (<-chan string, func(), error) {
+	return nil, r.cleanup, errors.New("unavailable")
+}
@@ -0,0 +1,77 @@
+package subscriptioncleanup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestSubscriptionCleanup(t *testing.T) {
+	newClient := func() (*client.Client, *Resolver) {
+		r := &Resolver{cleaned: make(chan struct{}, 1)}
+		return client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: r}))), r
+	}
+	waitCleanup := func(t *testing.T, r *Resolver) {
+		select {
+		case <-r.cleaned:
+		case <-time.After(time.Second):
+			t.Fatal("cleanup was not called")
+		}
+	}
+
+	t.Run("cleans up when the client unsubscribes", func(t *testing.T) {
+		c, r := newClient()
+		sub := c.Websocket(`subscription { messages }`)
+
+		var resp struct{ Messages string }
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, "message 1", resp.Messages)
+		require.NoError(t, sub.Close())
+
+		waitCleanup(t, r)
+		require.Equal(t, 1, r.cleanups)
+	})
+
+	t.Run("cleans up when the events run out", func(t *testing.T) {
+		c, r := newClient()
+		sub := c.Websocket(`subscription { messages(count: 2) }`)
+		defer sub.Close()
+
+		var resp struct{ Messages string }
+		require.NoError(t, sub.Next(&resp))
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, "message 2", resp.Messages)
+
+		waitCleanup(t, r)
+		require.Equal(t, 1, r.cleanups)
+	})
+
+	t.Run("cleans up after the initial payload", func(t *testing.T) {
+		c, r := newClient()
+		sub := c.Websocket(`subscription { counter }`)
+		defer sub.Close()
+
+		var resp struct{ Counter int }
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 1, resp.Counter)
+
+		waitCleanup(t, r)
+		require.Equal(t, 1, r.cleanups)
+	})
+
+	t.Run("cleans up when the resolver fails", func(t *testing.T) {
+		c, r := newClient()
+		sub := c.Websocket(`subscription { failing }`)
+		defer sub.Close()
+
+		var resp struct{ Failing string }
+		require.Error(t, sub.Next(&resp))
+
+		waitCleanup(t, r)
+		require.Equal(t, 1, r.cleanups)
+	})
+}
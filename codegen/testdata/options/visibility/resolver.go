@@ -0,0 +1,13 @@
+package visibility
+
+import "context"
+
+type Resolver struct{}
+
+func (r *Resolver) Query() QueryResolver {
+	return r
+}
+
+func (r *Resolver) Users(ctx context.Context) ([]*User, error) {
+	return []*User{{Name: "ada", Role: RoleOwner}}, nil
+}
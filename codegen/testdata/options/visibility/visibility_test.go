@@ -0,0 +1,70 @@
+package visibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestVisibility(t *testing.T) {
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: &Resolver{}})))
+
+	t.Run("visible fields", func(t *testing.T) {
+		var resp struct {
+			Users []struct {
+				Name string
+				Role string
+			}
+		}
+		c.MustPost(`{ users { name role } }`, &resp)
+		require.Len(t, resp.Users, 1)
+		require.Equal(t, "ada", resp.Users[0].Name)
+		require.Equal(t, "OWNER", resp.Users[0].Role)
+	})
+
+	t.Run("hidden elements", func(t *testing.T) {
+		var resp struct{}
+		err := c.Post(`{ users { email } }`, &resp)
+		require.ErrorContains(t, err, `Cannot query field \"email\" on type \"User\".`)
+
+		err = c.Post(`{ users(includeDeleted: true) { name } }`, &resp)
+		require.ErrorContains(t, err, `Unknown argument \"includeDeleted\" on field \"Query.users\".`)
+
+		err = c.Post(`{ user(name: "ada") { name } }`, &resp)
+		require.ErrorContains(t, err, `Cannot query field \"user\" on type \"Query\".`)
+
+		err = c.Post(`{ stats { revenue } }`, &resp)
+		require.ErrorContains(t, err, `Cannot query field \"stats\" on type \"Query\".`)
+
+		err = c.Post(`mutation { deleteUser(name: "ada") }`, &resp)
+		require.ErrorContains(t, err, "Schema does not support operation type \\\"mutation\\\"")
+	})
+
+	t.Run("introspection", func(t *testing.T) {
+		var resp struct {
+			Audit *struct{ Name string }
+			Stats *struct{ Name string }
+			Role  struct {
+				EnumValues []struct{ Name string }
+			}
+			Directives struct {
+				Directives []struct{ Name string }
+			}
+		}
+		c.MustPost(`{
+			audit: __type(name: "AuditEntry") { name }
+			stats: __type(name: "Stats") { name }
+			role: __type(name: "Role") { enumValues { name } }
+			directives: __schema { directives { name } }
+		}`, &resp)
+		require.Nil(t, resp.Audit)
+		require.Nil(t, resp.Stats)
+		require.Equal(t, []struct{ Name string }{{Name: "MEMBER"}, {Name: "OWNER"}}, resp.Role.EnumValues)
+		for _, d := range resp.Directives.Directives {
+			require.NotEqual(t, "visibility", d.Name)
+		}
+	})
+}
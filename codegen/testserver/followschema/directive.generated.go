@@ -121,6 +121,21 @@ func (ec *executionContext) dir_range_args(ctx context.Context, rawArgs map[stri
 	return args, nil
 }
 
+func (ec *executionContext) dir_tag_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["name"]; ok {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["name"] = arg0
+	return args, nil
+}
+
 // endregion ***************************** args.gotpl *****************************
 
 // region    ************************** directives.gotpl **************************
@@ -143,6 +158,20 @@ func (ec *executionContext) _fieldMiddleware(ctx context.Context, obj interface{
 				}
 				return ec.directives.Logged(ctx, obj, n, args["id"].(string))
 			}
+		case "tag":
+			rawArgs := d.ArgumentMap(ec.Variables)
+			args, err := ec.dir_tag_args(ctx, rawArgs)
+			if err != nil {
+				ec.Error(ctx, err)
+				return nil
+			}
+			n := next
+			next = func(ctx context.Context) (interface{}, error) {
+				if ec.directives.Tag == nil {
+					return nil, errors.New("directive tag is not implemented")
+				}
+				return ec.directives.Tag(ctx, obj, n, args["name"].(string))
+			}
 		}
 	}
 	res, err := ec.ResolverMiddleware(ctx, next)
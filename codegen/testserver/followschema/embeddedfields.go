@@ -0,0 +1,23 @@
+package followschema
+
+type EmbeddedFieldsBase struct {
+	ID   string
+	Name string
+}
+
+type EmbeddedFieldsAudit struct {
+	Name    string
+	Version int
+}
+
+type EmbeddedFieldsRevision struct {
+	EmbeddedFieldsAudit
+}
+
+// EmbeddedFieldsDocument has Name at the same depth in EmbeddedFieldsBase and EmbeddedFieldsAudit, and Version deeper
+// in EmbeddedFieldsRevision than in EmbeddedFieldsAudit.
+type EmbeddedFieldsDocument struct {
+	EmbeddedFieldsBase
+	*EmbeddedFieldsAudit
+	EmbeddedFieldsRevision
+}
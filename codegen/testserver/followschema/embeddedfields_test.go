@@ -0,0 +1,37 @@
+package followschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestEmbeddedFields(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.EmbeddedFieldsDocument = func(ctx context.Context) (*EmbeddedFieldsDocument, error) {
+		return &EmbeddedFieldsDocument{
+			EmbeddedFieldsBase:     EmbeddedFieldsBase{ID: "1", Name: "report"},
+			EmbeddedFieldsAudit:    &EmbeddedFieldsAudit{Name: "ada", Version: 3},
+			EmbeddedFieldsRevision: EmbeddedFieldsRevision{EmbeddedFieldsAudit{Name: "bob", Version: 2}},
+		}, nil
+	}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	var resp struct {
+		EmbeddedFieldsDocument struct {
+			ID      string
+			Name    string
+			Editor  string
+			Version int
+		}
+	}
+	c.MustPost(`{ embeddedFieldsDocument { id name editor version } }`, &resp)
+	require.Equal(t, "1", resp.EmbeddedFieldsDocument.ID)
+	require.Equal(t, "report", resp.EmbeddedFieldsDocument.Name)
+	require.Equal(t, "ada", resp.EmbeddedFieldsDocument.Editor)
+	require.Equal(t, 3, resp.EmbeddedFieldsDocument.Version)
+}
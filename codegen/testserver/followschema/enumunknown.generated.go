@@ -0,0 +1,204 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package followschema
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNUnknownColor2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownColor(ctx context.Context, v interface{}) (UnknownColor, error) {
+	var res UnknownColor
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUnknownColor2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownColor(ctx context.Context, sel ast.SelectionSet, v UnknownColor) graphql.Marshaler {
+	if !v.IsValid() {
+		ec.Errorf(ctx, "%v is not a valid UnknownColor", v)
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalNUnknownSize2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSize(ctx context.Context, v interface{}) (UnknownSize, error) {
+	var res UnknownSize
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUnknownSize2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSize(ctx context.Context, sel ast.SelectionSet, v UnknownSize) graphql.Marshaler {
+	if !v.IsValid() {
+		ec.Errorf(ctx, "%v is not a valid UnknownSize", v)
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) unmarshalNUnknownStatus2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownStatus(ctx context.Context, v interface{}) (UnknownStatus, error) {
+	tmp, err := graphql.UnmarshalString(v)
+	res := unmarshalNUnknownStatus2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownStatus[tmp]
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNUnknownStatus2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownStatus(ctx context.Context, sel ast.SelectionSet, v UnknownStatus) graphql.Marshaler {
+	enumName, ok := marshalNUnknownStatus2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownStatus[v]
+	if !ok {
+		return graphql.MarshalString("UNKNOWN")
+	}
+	res := graphql.MarshalString(enumName)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+var (
+	unmarshalNUnknownStatus2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownStatus = map[string]UnknownStatus{
+		"ACTIVE":  UnknownStatusActive,
+		"UNKNOWN": UnknownStatusUnknown,
+	}
+	marshalNUnknownStatus2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownStatus = map[UnknownStatus]string{
+		UnknownStatusActive:  "ACTIVE",
+		UnknownStatusUnknown: "UNKNOWN",
+	}
+)
+
+func (ec *executionContext) unmarshalOUnknownSize2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSizeᚄ(ctx context.Context, v interface{}) ([]UnknownSize, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]UnknownSize, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNUnknownSize2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSize(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOUnknownSize2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSizeᚄ(ctx context.Context, sel ast.SelectionSet, v []UnknownSize) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNUnknownSize2githubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSize(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOUnknownSize2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSize(ctx context.Context, v interface{}) (*UnknownSize, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(UnknownSize)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOUnknownSize2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋcodegenᚋtestserverᚋfollowschemaᚐUnknownSize(ctx context.Context, sel ast.SelectionSet, v *UnknownSize) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	if !v.IsValid() {
+		graphql.AddUnknownEnumWarning(ctx, "UnknownSize", *v)
+		return graphql.Null
+	}
+	return v
+}
+
+// AllUnknownStatus lists the go values bound to the UnknownStatus enum, in schema order.
+var AllUnknownStatus = []UnknownStatus{
+	UnknownStatusActive,
+	UnknownStatusUnknown,
+}
+
+// IsValidUnknownStatus reports whether v is bound to a value of the UnknownStatus enum.
+func IsValidUnknownStatus(v UnknownStatus) bool {
+	switch v {
+	case UnknownStatusActive, UnknownStatusUnknown:
+		return true
+	}
+	return false
+}
+
+// endregion ***************************** type.gotpl *****************************
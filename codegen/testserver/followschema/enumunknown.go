@@ -0,0 +1,8 @@
+package followschema
+
+type UnknownStatus int
+
+const (
+	UnknownStatusUnknown UnknownStatus = iota
+	UnknownStatusActive
+)
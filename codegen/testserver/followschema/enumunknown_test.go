@@ -0,0 +1,88 @@
+package followschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestUnknownEnumValues(t *testing.T) {
+	color, status := UnknownColorRed, UnknownStatusActive
+	var size *UnknownSize
+	var sizes []UnknownSize
+	resolvers := &Stub{}
+	resolvers.QueryResolver.UnknownColor = func(ctx context.Context) (UnknownColor, error) {
+		return color, nil
+	}
+	resolvers.QueryResolver.UnknownSize = func(ctx context.Context) (*UnknownSize, error) {
+		return size, nil
+	}
+	resolvers.QueryResolver.UnknownSizes = func(ctx context.Context) ([]UnknownSize, error) {
+		return sizes, nil
+	}
+	resolvers.QueryResolver.UnknownStatus = func(ctx context.Context) (UnknownStatus, error) {
+		return status, nil
+	}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	t.Run("known values", func(t *testing.T) {
+		large := UnknownSizeLarge
+		size = &large
+
+		var resp struct{ UnknownColor, UnknownSize, UnknownStatus string }
+		c.MustPost(`query { unknownColor unknownSize unknownStatus }`, &resp)
+		require.Equal(t, "RED", resp.UnknownColor)
+		require.Equal(t, "LARGE", resp.UnknownSize)
+		require.Equal(t, "ACTIVE", resp.UnknownStatus)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		color = "BLUE"
+		defer func() { color = UnknownColorRed }()
+
+		var resp struct{ UnknownColor *string }
+		err := c.Post(`query { unknownColor }`, &resp)
+		require.EqualError(t, err, `[{"message":"BLUE is not a valid UnknownColor","path":["unknownColor"]}]`)
+	})
+
+	t.Run("null with a warning", func(t *testing.T) {
+		huge := UnknownSize("HUGE")
+		size = &huge
+
+		resp, err := c.RawPost(`query { unknownSize }`)
+		require.NoError(t, err)
+		require.Nil(t, resp.Errors)
+		require.Equal(t, map[string]interface{}{"unknownSize": nil}, resp.Data)
+		require.Equal(t, []interface{}{map[string]interface{}{
+			"message": "HUGE is not a valid UnknownSize",
+			"path":    []interface{}{"unknownSize"},
+			"code":    "UNKNOWN_ENUM_VALUE",
+		}}, resp.Extensions["warnings"])
+	})
+
+	t.Run("null is an error where the schema requires a value", func(t *testing.T) {
+		sizes = []UnknownSize{UnknownSizeSmall, "HUGE"}
+
+		var resp struct{ UnknownSizes []string }
+		err := c.Post(`query { unknownSizes }`, &resp)
+		require.EqualError(t, err, `[{"message":"HUGE is not a valid UnknownSize","path":["unknownSizes",1]}]`)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		status = UnknownStatus(42)
+
+		var resp struct{ UnknownStatus string }
+		c.MustPost(`query { unknownStatus }`, &resp)
+		require.Equal(t, "UNKNOWN", resp.UnknownStatus)
+	})
+}
+
+func TestBoundEnumHelpers(t *testing.T) {
+	require.Equal(t, []UnknownStatus{UnknownStatusActive, UnknownStatusUnknown}, AllUnknownStatus)
+	require.True(t, IsValidUnknownStatus(UnknownStatusUnknown))
+	require.False(t, IsValidUnknownStatus(UnknownStatus(42)))
+}
@@ -0,0 +1,126 @@
+package followschema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+// initialPayloadCounter is the state the subscriptions of TestInitialPayload report.
+type initialPayloadCounter struct {
+	mu          sync.Mutex
+	counter     int
+	subscribers []chan int
+}
+
+// subscribe returns the counter and a channel of its next values, read under the same lock so that no increment is
+// missed or sent twice.
+func (c *initialPayloadCounter) subscribe(ctx context.Context) (int, <-chan int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan int, 16)
+	c.subscribers = append(c.subscribers, ch)
+	go func() {
+		<-ctx.Done()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for i, s := range c.subscribers {
+			if s == ch {
+				c.subscribers = append(c.subscribers[:i], c.subscribers[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}()
+	return c.counter, ch
+}
+
+func (c *initialPayloadCounter) increment() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.counter++
+	for _, s := range c.subscribers {
+		s <- c.counter
+	}
+}
+
+func (c *initialPayloadCounter) subscriberCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.subscribers)
+}
+
+func TestInitialPayload(t *testing.T) {
+	newClient := func() (*client.Client, *initialPayloadCounter, *int) {
+		counter := &initialPayloadCounter{}
+		resolvers := &Stub{}
+		resolvers.SubscriptionResolver.InitialPayloadCounter = func(ctx context.Context) (graphql.InitialPayload[int], error) {
+			initial, updates := counter.subscribe(ctx)
+			return graphql.InitialPayload[int]{Initial: initial, Updates: updates}, nil
+		}
+		resolvers.SubscriptionResolver.InitialPayloadLoggedCounter = resolvers.SubscriptionResolver.InitialPayloadCounter
+		resolvers.SubscriptionResolver.InitialPayloadIncrements = func(ctx context.Context) (<-chan int, error) {
+			_, updates := counter.subscribe(ctx)
+			return updates, nil
+		}
+
+		logged := 0
+		cfg := Config{Resolvers: resolvers}
+		cfg.Directives.Directive1 = func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+			logged++
+			return next(ctx)
+		}
+		return client.New(handler.NewDefaultServer(NewExecutableSchema(cfg))), counter, &logged
+	}
+
+	t.Run("sends the current state before the updates", func(t *testing.T) {
+		c, counter, _ := newClient()
+		counter.increment()
+
+		sub := c.Websocket(`subscription { initialPayloadCounter }`)
+		defer sub.Close()
+
+		var resp struct{ InitialPayloadCounter int }
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 1, resp.InitialPayloadCounter)
+
+		counter.increment()
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 2, resp.InitialPayloadCounter)
+	})
+
+	t.Run("runs the directives of the field once", func(t *testing.T) {
+		c, counter, logged := newClient()
+		sub := c.Websocket(`subscription { initialPayloadLoggedCounter }`)
+		defer sub.Close()
+
+		var resp struct{ InitialPayloadLoggedCounter int }
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 0, resp.InitialPayloadLoggedCounter)
+
+		counter.increment()
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 1, resp.InitialPayloadLoggedCounter)
+		require.Equal(t, 1, *logged)
+	})
+
+	t.Run("subscriptions without the directive only send updates", func(t *testing.T) {
+		c, counter, _ := newClient()
+		sub := c.Websocket(`subscription { initialPayloadIncrements }`)
+		defer sub.Close()
+		require.Eventually(t, func() bool { return counter.subscriberCount() == 1 }, time.Second, time.Millisecond)
+
+		counter.increment()
+
+		var resp struct{ InitialPayloadIncrements int }
+		require.NoError(t, sub.Next(&resp))
+		require.Equal(t, 1, resp.InitialPayloadIncrements)
+	})
+}
@@ -0,0 +1,51 @@
+package followschema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestInterfaceResolvers(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.Linkables = func(ctx context.Context) ([]Linkable, error) {
+		return []Linkable{&LinkableUser{ID: "1", Name: "bob"}, &LinkablePost{ID: "2", Title: "hello"}}, nil
+	}
+	// resolves the url of every implementor of Linkable
+	resolvers.LinkableResolver.URL = func(ctx context.Context, obj Linkable, absolute *bool) (string, error) {
+		var path string
+		switch obj := obj.(type) {
+		case *LinkableUser:
+			path = "/users/" + obj.ID
+		case *LinkablePost:
+			path = "/posts/" + obj.ID
+		default:
+			return "", fmt.Errorf("unexpected linkable %T", obj)
+		}
+		if absolute != nil && *absolute {
+			return "https://example.com" + path, nil
+		}
+		return strings.TrimPrefix(path, "/"), nil
+	}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	var resp struct {
+		Linkables []struct {
+			URL      string
+			Absolute string
+		}
+	}
+	c.MustPost(`query { linkables { url absolute: url(absolute: true) } }`, &resp)
+
+	require.Len(t, resp.Linkables, 2)
+	require.Equal(t, "users/1", resp.Linkables[0].URL)
+	require.Equal(t, "https://example.com/users/1", resp.Linkables[0].Absolute)
+	require.Equal(t, "posts/2", resp.Linkables[1].URL)
+	require.Equal(t, "https://example.com/posts/2", resp.Linkables[1].Absolute)
+}
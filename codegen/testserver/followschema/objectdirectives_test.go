@@ -0,0 +1,85 @@
+package followschema
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestObjectDirectives(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.OwnedOrder = func(ctx context.Context, id string) (*OwnedOrder, error) {
+		note := "fragile"
+		return &OwnedOrder{ID: id, Total: 42, Note: &note, Customer: &OwnedCustomer{Name: "ada"}}, nil
+	}
+
+	var calls []string
+	cfg := Config{Resolvers: resolvers}
+	cfg.Directives.Owned = func(ctx context.Context, parent interface{}, next graphql.Resolver, by string) (interface{}, error) {
+		fc := graphql.GetFieldContext(ctx)
+		_, isOrder := parent.(*OwnedOrder)
+		calls = append(calls, fmt.Sprintf("owned(%s) %s.%s %t", by, fc.Field.ObjectDefinition.Name, fc.Field.Name, isOrder))
+		if by == "billing" && fc.Field.Name == "total" {
+			return nil, fmt.Errorf("only billing can read the total")
+		}
+		return next(ctx)
+	}
+	cfg.Directives.ObjectLog = func(ctx context.Context, obj interface{}, next graphql.Resolver, tag string) (interface{}, error) {
+		calls = append(calls, fmt.Sprintf("objectLog(%s) %s", tag, graphql.GetFieldContext(ctx).Field.Name))
+		return next(ctx)
+	}
+	cfg.Directives.Upper = func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+		calls = append(calls, "upper")
+		res, err := next(ctx)
+		if s, ok := res.(*string); ok && s != nil {
+			upper := strings.ToUpper(*s)
+			return &upper, err
+		}
+		return res, err
+	}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(cfg)))
+
+	t.Run("wraps every field of the object", func(t *testing.T) {
+		calls = nil
+		var resp struct {
+			OwnedOrder struct {
+				ID       string
+				Note     string
+				Customer struct{ Name string }
+			}
+		}
+		c.MustPost(`{ ownedOrder(id: "1") { id note customer { name } } }`, &resp)
+		require.Equal(t, "FRAGILE", resp.OwnedOrder.Note)
+		require.Equal(t, "ada", resp.OwnedOrder.Customer.Name)
+		require.Equal(t, []string{
+			"objectLog(order) ownedOrder",
+			"owned(billing) OwnedOrder.id true",
+			"owned(billing) OwnedOrder.note true",
+			"upper",
+			"owned(billing) OwnedOrder.customer true",
+		}, calls)
+	})
+
+	t.Run("can deny a field", func(t *testing.T) {
+		var resp struct{}
+		err := c.Post(`{ ownedOrder(id: "1") { total } }`, &resp)
+		require.EqualError(t, err, `[{"message":"only billing can read the total","path":["ownedOrder","total"]}]`)
+	})
+
+	t.Run("introspection is not wrapped", func(t *testing.T) {
+		calls = nil
+		var resp struct {
+			Type struct{ Name string } `json:"__type"`
+		}
+		c.MustPost(`{ __type(name: "OwnedOrder") { name } }`, &resp)
+		require.Equal(t, "OwnedOrder", resp.Type.Name)
+		require.Empty(t, calls)
+	})
+}
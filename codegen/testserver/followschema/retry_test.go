@@ -0,0 +1,70 @@
+package followschema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestRetry(t *testing.T) {
+	// newClient returns a client and the number of calls of the query and name resolvers
+	newClient := func() (*client.Client, *int, *int) {
+		var calls, nameCalls int
+		resolvers := &Stub{}
+		// fails the first failures calls and then returns the number of calls
+		resolvers.QueryResolver.RetryFlaky = func(ctx context.Context, failures int) (int, error) {
+			calls++
+			if calls <= failures {
+				return 0, errors.New("unavailable")
+			}
+			return calls, nil
+		}
+		resolvers.QueryResolver.RetryMissing = func(ctx context.Context) (*int, error) {
+			calls++
+			return nil, graphql.Permanent(errors.New("not found"))
+		}
+		resolvers.QueryResolver.RetryUser = func(ctx context.Context) (*RetryUser, error) {
+			return &RetryUser{ID: "1"}, nil
+		}
+		resolvers.RetryUserResolver.Name = func(ctx context.Context, obj *RetryUser) (string, error) {
+			nameCalls++
+			return "", errors.New("unavailable")
+		}
+		return client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))), &calls, &nameCalls
+	}
+
+	t.Run("retries until the resolver succeeds", func(t *testing.T) {
+		c, calls, _ := newClient()
+		var resp struct{ RetryFlaky int }
+		c.MustPost(`{ retryFlaky(failures: 2) }`, &resp)
+		require.Equal(t, 3, resp.RetryFlaky)
+		require.Equal(t, 3, *calls)
+	})
+
+	t.Run("returns the last error after the attempts", func(t *testing.T) {
+		c, calls, nameCalls := newClient()
+		var resp struct{ RetryFlaky int }
+		err := c.Post(`{ retryFlaky(failures: 5) }`, &resp)
+		require.EqualError(t, err, `[{"message":"unavailable","path":["retryFlaky"]}]`)
+		require.Equal(t, 3, *calls)
+
+		var user struct{ RetryUser struct{ Name string } }
+		err = c.Post(`{ retryUser { name } }`, &user)
+		require.EqualError(t, err, `[{"message":"unavailable","path":["retryUser","name"]}]`)
+		require.Equal(t, 2, *nameCalls)
+	})
+
+	t.Run("does not retry permanent errors", func(t *testing.T) {
+		c, calls, _ := newClient()
+		var resp struct{ RetryMissing *int }
+		err := c.Post(`{ retryMissing }`, &resp)
+		require.EqualError(t, err, `[{"message":"not found","path":["retryMissing"]}]`)
+		require.Equal(t, 1, *calls)
+	})
+}
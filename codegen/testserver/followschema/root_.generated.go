@@ -8,6 +8,7 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -35,6 +36,7 @@ type Config struct {
 
 type ResolverRoot interface {
 	BackedByInterface() BackedByInterfaceResolver
+	CachedUser() CachedUserResolver
 	DeferModel() DeferModelResolver
 	Errors() ErrorsResolver
 	ForcedResolver() ForcedResolverResolver
@@ -46,11 +48,13 @@ type ResolverRoot interface {
 	Primitive() PrimitiveResolver
 	PrimitiveString() PrimitiveStringResolver
 	Query() QueryResolver
+	RetryUser() RetryUserResolver
 	Subscription() SubscriptionResolver
 	User() UserResolver
 	WrappedMap() WrappedMapResolver
 	WrappedSlice() WrappedSliceResolver
 	FieldsOrderInput() FieldsOrderInputResolver
+	Linkable() LinkableResolver
 }
 
 type DirectiveRoot struct {
@@ -63,11 +67,15 @@ type DirectiveRoot struct {
 	Logged        func(ctx context.Context, obj interface{}, next graphql.Resolver, id string) (res interface{}, err error)
 	MakeNil       func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
 	MakeTypedNil  func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	ObjectLog     func(ctx context.Context, obj interface{}, next graphql.Resolver, tag string) (res interface{}, err error)
 	Order1        func(ctx context.Context, obj interface{}, next graphql.Resolver, location string) (res interface{}, err error)
 	Order2        func(ctx context.Context, obj interface{}, next graphql.Resolver, location string) (res interface{}, err error)
+	Owned         func(ctx context.Context, parent interface{}, next graphql.Resolver, by string) (res interface{}, err error)
 	Range         func(ctx context.Context, obj interface{}, next graphql.Resolver, min *int, max *int) (res interface{}, err error)
+	Tag           func(ctx context.Context, obj interface{}, next graphql.Resolver, name string) (res interface{}, err error)
 	ToNull        func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
 	Unimplemented func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
+	Upper         func(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error)
 }
 
 type ComplexityRoot struct {
@@ -101,6 +109,13 @@ type ComplexityRoot struct {
 		ThisShouldBindWithError func(childComplexity int) int
 	}
 
+	CachedUser struct {
+		ID    func(childComplexity int) int
+		Name  func(childComplexity int) int
+		Posts func(childComplexity int, first int) int
+		Score func(childComplexity int) int
+	}
+
 	Cat struct {
 		CatBreed func(childComplexity int) int
 		Size     func(childComplexity int) int
@@ -174,6 +189,12 @@ type ComplexityRoot struct {
 		Value func(childComplexity int) int
 	}
 
+	EmbeddedFieldsDocument struct {
+		ID      func(childComplexity int) int
+		Name    func(childComplexity int) int
+		Version func(childComplexity int) int
+	}
+
 	EmbeddedPointer struct {
 		ID    func(childComplexity int) int
 		Title func(childComplexity int) int
@@ -194,6 +215,10 @@ type ComplexityRoot struct {
 		E func(childComplexity int) int
 	}
 
+	FallbackProduct struct {
+		ID func(childComplexity int) int
+	}
+
 	FieldsOrderPayload struct {
 		FirstFieldValue func(childComplexity int) int
 	}
@@ -212,6 +237,15 @@ type ComplexityRoot struct {
 		ID func(childComplexity int) int
 	}
 
+	InputDefaultsSearchResult struct {
+		Cursor    func(childComplexity int) int
+		CursorSet func(childComplexity int) int
+		Limit     func(childComplexity int) int
+		Locale    func(childComplexity int) int
+		Order     func(childComplexity int) int
+		Query     func(childComplexity int) int
+	}
+
 	InvalidIdentifier struct {
 		ID func(childComplexity int) int
 	}
@@ -220,6 +254,18 @@ type ComplexityRoot struct {
 		ID func(childComplexity int) int
 	}
 
+	LinkablePost struct {
+		ID    func(childComplexity int) int
+		Title func(childComplexity int) int
+		URL   func(childComplexity int, absolute *bool) int
+	}
+
+	LinkableUser struct {
+		ID   func(childComplexity int) int
+		Name func(childComplexity int) int
+		URL  func(childComplexity int, absolute *bool) int
+	}
+
 	LoopA struct {
 		B func(childComplexity int) int
 	}
@@ -243,6 +289,14 @@ type ComplexityRoot struct {
 		Nested func(childComplexity int) int
 	}
 
+	MaskedUser struct {
+		Email      func(childComplexity int) int
+		InternalID func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Phone      func(childComplexity int) int
+		Ssn        func(childComplexity int) int
+	}
+
 	ModelMethods struct {
 		NoContext     func(childComplexity int) int
 		ResolverField func(childComplexity int) int
@@ -250,8 +304,10 @@ type ComplexityRoot struct {
 	}
 
 	Mutation struct {
+		CreateTypedMapProduct func(childComplexity int, input TypedMapProductInput) int
 		DefaultInput          func(childComplexity int, input DefaultInput) int
 		OverrideValueViaInput func(childComplexity int, input FieldsOrderInput) int
+		TypedMapRestock       func(childComplexity int, stock map[string]int) int
 		UpdatePtrToPtr        func(childComplexity int, input UpdatePtrToPtrOuter) int
 		UpdateSomething       func(childComplexity int, input SpecialInput) int
 	}
@@ -276,6 +332,17 @@ type ComplexityRoot struct {
 		OldFoo func(childComplexity int) int
 	}
 
+	OwnedCustomer struct {
+		Name func(childComplexity int) int
+	}
+
+	OwnedOrder struct {
+		Customer func(childComplexity int) int
+		ID       func(childComplexity int) int
+		Note     func(childComplexity int) int
+		Total    func(childComplexity int) int
+	}
+
 	Panics struct {
 		ArgUnmarshal       func(childComplexity int, u []MarshalPanic) int
 		FieldFuncMarshal   func(childComplexity int, u []MarshalPanic) int
@@ -321,6 +388,8 @@ type ComplexityRoot struct {
 	Query struct {
 		Animal                           func(childComplexity int) int
 		Autobind                         func(childComplexity int) int
+		CachedCount                      func(childComplexity int) int
+		CachedUser                       func(childComplexity int, id string) int
 		Collision                        func(childComplexity int) int
 		DefaultParameters                func(childComplexity int, falsyBoolean *bool, truthyBoolean *bool) int
 		DefaultScalar                    func(childComplexity int, arg string) int
@@ -342,22 +411,32 @@ type ComplexityRoot struct {
 		EmbeddedCase1                    func(childComplexity int) int
 		EmbeddedCase2                    func(childComplexity int) int
 		EmbeddedCase3                    func(childComplexity int) int
+		EmbeddedFieldsDocument           func(childComplexity int) int
 		EnumInInput                      func(childComplexity int, input *InputWithEnumValue) int
 		ErrorBubble                      func(childComplexity int) int
 		ErrorBubbleList                  func(childComplexity int) int
 		ErrorList                        func(childComplexity int) int
 		Errors                           func(childComplexity int) int
 		Fallback                         func(childComplexity int, arg FallbackToStringEncoding) int
+		FallbackBanner                   func(childComplexity int) int
+		FallbackGreeting                 func(childComplexity int) int
+		FallbackLevel                    func(childComplexity int) int
+		FallbackRecommendations          func(childComplexity int) int
+		FallbackScore                    func(childComplexity int) int
+		FallbackTags                     func(childComplexity int) int
 		Infinity                         func(childComplexity int) int
+		InputDefaultsSearch              func(childComplexity int, input InputDefaultsSearchInput) int
 		InputNullableSlice               func(childComplexity int, arg []string) int
 		InputOmittable                   func(childComplexity int, arg OmittableInput) int
 		InputSlice                       func(childComplexity int, arg []string) int
 		Invalid                          func(childComplexity int) int
 		InvalidIdentifier                func(childComplexity int) int
 		Issue896a                        func(childComplexity int) int
+		Linkables                        func(childComplexity int) int
 		MapInput                         func(childComplexity int, input map[string]interface{}) int
 		MapNestedStringInterface         func(childComplexity int, in *NestedMapInput) int
 		MapStringInterface               func(childComplexity int, in map[string]interface{}) int
+		MaskedUser                       func(childComplexity int) int
 		ModelMethods                     func(childComplexity int) int
 		NestedInputs                     func(childComplexity int, input [][]*OuterInput) int
 		NestedOutputs                    func(childComplexity int) int
@@ -368,18 +447,29 @@ type ComplexityRoot struct {
 		NullableArg                      func(childComplexity int, arg *int) int
 		OptionalUnion                    func(childComplexity int) int
 		Overlapping                      func(childComplexity int) int
+		OwnedOrder                       func(childComplexity int, id string) int
 		Panics                           func(childComplexity int) int
 		PrimitiveObject                  func(childComplexity int) int
 		PrimitiveStringObject            func(childComplexity int) int
 		PtrToAnyContainer                func(childComplexity int) int
 		PtrToSliceContainer              func(childComplexity int) int
 		Recursive                        func(childComplexity int, input *RecursiveInputSlice) int
+		RepeatableItem                   func(childComplexity int, id string, filter *RepeatableFilter) int
+		RetryFlaky                       func(childComplexity int, failures int) int
+		RetryMissing                     func(childComplexity int) int
+		RetryUser                        func(childComplexity int) int
 		ScalarSlice                      func(childComplexity int) int
 		ShapeUnion                       func(childComplexity int) int
 		Shapes                           func(childComplexity int) int
 		Slices                           func(childComplexity int) int
 		StringFromContextFunction        func(childComplexity int) int
 		StringFromContextInterface       func(childComplexity int) int
+		TypedMapProduct                  func(childComplexity int) int
+		TypedMapStatuses                 func(childComplexity int, ids []string) int
+		UnknownColor                     func(childComplexity int) int
+		UnknownSize                      func(childComplexity int) int
+		UnknownSizes                     func(childComplexity int) int
+		UnknownStatus                    func(childComplexity int) int
 		User                             func(childComplexity int, id int) int
 		VOkCaseNil                       func(childComplexity int) int
 		VOkCaseValue                     func(childComplexity int) int
@@ -399,6 +489,15 @@ type ComplexityRoot struct {
 		Width       func(childComplexity int) int
 	}
 
+	RepeatableItem struct {
+		Name func(childComplexity int) int
+	}
+
+	RetryUser struct {
+		ID   func(childComplexity int) int
+		Name func(childComplexity int) int
+	}
+
 	Size struct {
 		Height func(childComplexity int) int
 		Weight func(childComplexity int) int
@@ -412,14 +511,23 @@ type ComplexityRoot struct {
 	}
 
 	Subscription struct {
-		DirectiveArg           func(childComplexity int, arg string) int
-		DirectiveDouble        func(childComplexity int) int
-		DirectiveNullableArg   func(childComplexity int, arg *int, arg2 *int, arg3 *string) int
-		DirectiveUnimplemented func(childComplexity int) int
-		ErrorRequired          func(childComplexity int) int
-		InitPayload            func(childComplexity int) int
-		Issue896b              func(childComplexity int) int
-		Updated                func(childComplexity int) int
+		DirectiveArg                func(childComplexity int, arg string) int
+		DirectiveDouble             func(childComplexity int) int
+		DirectiveNullableArg        func(childComplexity int, arg *int, arg2 *int, arg3 *string) int
+		DirectiveUnimplemented      func(childComplexity int) int
+		ErrorRequired               func(childComplexity int) int
+		InitPayload                 func(childComplexity int) int
+		InitialPayloadCounter       func(childComplexity int) int
+		InitialPayloadIncrements    func(childComplexity int) int
+		InitialPayloadLoggedCounter func(childComplexity int) int
+		Issue896b                   func(childComplexity int) int
+		Updated                     func(childComplexity int) int
+	}
+
+	TypedMapProduct struct {
+		Labels func(childComplexity int) int
+		Name   func(childComplexity int) int
+		Stock  func(childComplexity int) int
 	}
 
 	User struct {
@@ -582,6 +690,39 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.BackedByInterface.ThisShouldBindWithError(childComplexity), true
 
+	case "CachedUser.id":
+		if e.complexity.CachedUser.ID == nil {
+			break
+		}
+
+		return e.complexity.CachedUser.ID(childComplexity), true
+
+	case "CachedUser.name":
+		if e.complexity.CachedUser.Name == nil {
+			break
+		}
+
+		return e.complexity.CachedUser.Name(childComplexity), true
+
+	case "CachedUser.posts":
+		if e.complexity.CachedUser.Posts == nil {
+			break
+		}
+
+		args, err := ec.field_CachedUser_posts_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.CachedUser.Posts(childComplexity, args["first"].(int)), true
+
+	case "CachedUser.score":
+		if e.complexity.CachedUser.Score == nil {
+			break
+		}
+
+		return e.complexity.CachedUser.Score(childComplexity), true
+
 	case "Cat.catBreed":
 		if e.complexity.Cat.CatBreed == nil {
 			break
@@ -778,6 +919,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.EmbeddedDefaultScalar.Value(childComplexity), true
 
+	case "EmbeddedFieldsDocument.id":
+		if e.complexity.EmbeddedFieldsDocument.ID == nil {
+			break
+		}
+
+		return e.complexity.EmbeddedFieldsDocument.ID(childComplexity), true
+
+	case "EmbeddedFieldsDocument.name", "EmbeddedFieldsDocument.editor":
+		if e.complexity.EmbeddedFieldsDocument.Name == nil {
+			break
+		}
+
+		return e.complexity.EmbeddedFieldsDocument.Name(childComplexity), true
+
+	case "EmbeddedFieldsDocument.version":
+		if e.complexity.EmbeddedFieldsDocument.Version == nil {
+			break
+		}
+
+		return e.complexity.EmbeddedFieldsDocument.Version(childComplexity), true
+
 	case "EmbeddedPointer.ID":
 		if e.complexity.EmbeddedPointer.ID == nil {
 			break
@@ -855,6 +1017,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Errors.E(childComplexity), true
 
+	case "FallbackProduct.id":
+		if e.complexity.FallbackProduct.ID == nil {
+			break
+		}
+
+		return e.complexity.FallbackProduct.ID(childComplexity), true
+
 	case "FieldsOrderPayload.firstFieldValue":
 		if e.complexity.FieldsOrderPayload.FirstFieldValue == nil {
 			break
@@ -897,6 +1066,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.InnerObject.ID(childComplexity), true
 
+	case "InputDefaultsSearchResult.cursor":
+		if e.complexity.InputDefaultsSearchResult.Cursor == nil {
+			break
+		}
+
+		return e.complexity.InputDefaultsSearchResult.Cursor(childComplexity), true
+
+	case "InputDefaultsSearchResult.cursorSet":
+		if e.complexity.InputDefaultsSearchResult.CursorSet == nil {
+			break
+		}
+
+		return e.complexity.InputDefaultsSearchResult.CursorSet(childComplexity), true
+
+	case "InputDefaultsSearchResult.limit":
+		if e.complexity.InputDefaultsSearchResult.Limit == nil {
+			break
+		}
+
+		return e.complexity.InputDefaultsSearchResult.Limit(childComplexity), true
+
+	case "InputDefaultsSearchResult.locale":
+		if e.complexity.InputDefaultsSearchResult.Locale == nil {
+			break
+		}
+
+		return e.complexity.InputDefaultsSearchResult.Locale(childComplexity), true
+
+	case "InputDefaultsSearchResult.order":
+		if e.complexity.InputDefaultsSearchResult.Order == nil {
+			break
+		}
+
+		return e.complexity.InputDefaultsSearchResult.Order(childComplexity), true
+
+	case "InputDefaultsSearchResult.query":
+		if e.complexity.InputDefaultsSearchResult.Query == nil {
+			break
+		}
+
+		return e.complexity.InputDefaultsSearchResult.Query(childComplexity), true
+
 	case "InvalidIdentifier.id":
 		if e.complexity.InvalidIdentifier.ID == nil {
 			break
@@ -911,6 +1122,58 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.It.ID(childComplexity), true
 
+	case "LinkablePost.id":
+		if e.complexity.LinkablePost.ID == nil {
+			break
+		}
+
+		return e.complexity.LinkablePost.ID(childComplexity), true
+
+	case "LinkablePost.title":
+		if e.complexity.LinkablePost.Title == nil {
+			break
+		}
+
+		return e.complexity.LinkablePost.Title(childComplexity), true
+
+	case "LinkablePost.url":
+		if e.complexity.LinkablePost.URL == nil {
+			break
+		}
+
+		args, err := ec.field_LinkablePost_url_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LinkablePost.URL(childComplexity, args["absolute"].(*bool)), true
+
+	case "LinkableUser.id":
+		if e.complexity.LinkableUser.ID == nil {
+			break
+		}
+
+		return e.complexity.LinkableUser.ID(childComplexity), true
+
+	case "LinkableUser.name":
+		if e.complexity.LinkableUser.Name == nil {
+			break
+		}
+
+		return e.complexity.LinkableUser.Name(childComplexity), true
+
+	case "LinkableUser.url":
+		if e.complexity.LinkableUser.URL == nil {
+			break
+		}
+
+		args, err := ec.field_LinkableUser_url_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.LinkableUser.URL(childComplexity, args["absolute"].(*bool)), true
+
 	case "LoopA.b":
 		if e.complexity.LoopA.B == nil {
 			break
@@ -967,6 +1230,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.MapStringInterfaceType.Nested(childComplexity), true
 
+	case "MaskedUser.email":
+		if e.complexity.MaskedUser.Email == nil {
+			break
+		}
+
+		return e.complexity.MaskedUser.Email(childComplexity), true
+
+	case "MaskedUser.internalId":
+		if e.complexity.MaskedUser.InternalID == nil {
+			break
+		}
+
+		return e.complexity.MaskedUser.InternalID(childComplexity), true
+
+	case "MaskedUser.name":
+		if e.complexity.MaskedUser.Name == nil {
+			break
+		}
+
+		return e.complexity.MaskedUser.Name(childComplexity), true
+
+	case "MaskedUser.phone":
+		if e.complexity.MaskedUser.Phone == nil {
+			break
+		}
+
+		return e.complexity.MaskedUser.Phone(childComplexity), true
+
+	case "MaskedUser.ssn":
+		if e.complexity.MaskedUser.Ssn == nil {
+			break
+		}
+
+		return e.complexity.MaskedUser.Ssn(childComplexity), true
+
 	case "ModelMethods.noContext":
 		if e.complexity.ModelMethods.NoContext == nil {
 			break
@@ -988,6 +1286,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.ModelMethods.WithContext(childComplexity), true
 
+	case "Mutation.createTypedMapProduct":
+		if e.complexity.Mutation.CreateTypedMapProduct == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createTypedMapProduct_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateTypedMapProduct(childComplexity, args["input"].(TypedMapProductInput)), true
+
 	case "Mutation.defaultInput":
 		if e.complexity.Mutation.DefaultInput == nil {
 			break
@@ -1012,6 +1322,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.OverrideValueViaInput(childComplexity, args["input"].(FieldsOrderInput)), true
 
+	case "Mutation.typedMapRestock":
+		if e.complexity.Mutation.TypedMapRestock == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_typedMapRestock_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.TypedMapRestock(childComplexity, args["stock"].(map[string]int)), true
+
 	case "Mutation.updatePtrToPtr":
 		if e.complexity.Mutation.UpdatePtrToPtr == nil {
 			break
@@ -1092,6 +1414,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.OverlappingFields.OldFoo(childComplexity), true
 
+	case "OwnedCustomer.name":
+		if e.complexity.OwnedCustomer.Name == nil {
+			break
+		}
+
+		return e.complexity.OwnedCustomer.Name(childComplexity), true
+
+	case "OwnedOrder.customer":
+		if e.complexity.OwnedOrder.Customer == nil {
+			break
+		}
+
+		return e.complexity.OwnedOrder.Customer(childComplexity), true
+
+	case "OwnedOrder.id":
+		if e.complexity.OwnedOrder.ID == nil {
+			break
+		}
+
+		return e.complexity.OwnedOrder.ID(childComplexity), true
+
+	case "OwnedOrder.note":
+		if e.complexity.OwnedOrder.Note == nil {
+			break
+		}
+
+		return e.complexity.OwnedOrder.Note(childComplexity), true
+
+	case "OwnedOrder.total":
+		if e.complexity.OwnedOrder.Total == nil {
+			break
+		}
+
+		return e.complexity.OwnedOrder.Total(childComplexity), true
+
 	case "Panics.argUnmarshal":
 		if e.complexity.Panics.ArgUnmarshal == nil {
 			break
@@ -1247,6 +1604,25 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Autobind(childComplexity), true
 
+	case "Query.cachedCount":
+		if e.complexity.Query.CachedCount == nil {
+			break
+		}
+
+		return e.complexity.Query.CachedCount(childComplexity), true
+
+	case "Query.cachedUser":
+		if e.complexity.Query.CachedUser == nil {
+			break
+		}
+
+		args, err := ec.field_Query_cachedUser_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CachedUser(childComplexity, args["id"].(string)), true
+
 	case "Query.collision":
 		if e.complexity.Query.Collision == nil {
 			break
@@ -1434,6 +1810,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.EmbeddedCase3(childComplexity), true
 
+	case "Query.embeddedFieldsDocument":
+		if e.complexity.Query.EmbeddedFieldsDocument == nil {
+			break
+		}
+
+		return e.complexity.Query.EmbeddedFieldsDocument(childComplexity), true
+
 	case "Query.enumInInput":
 		if e.complexity.Query.EnumInInput == nil {
 			break
@@ -1486,6 +1869,48 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Fallback(childComplexity, args["arg"].(FallbackToStringEncoding)), true
 
+	case "Query.fallbackBanner":
+		if e.complexity.Query.FallbackBanner == nil {
+			break
+		}
+
+		return e.complexity.Query.FallbackBanner(childComplexity), true
+
+	case "Query.fallbackGreeting":
+		if e.complexity.Query.FallbackGreeting == nil {
+			break
+		}
+
+		return e.complexity.Query.FallbackGreeting(childComplexity), true
+
+	case "Query.fallbackLevel":
+		if e.complexity.Query.FallbackLevel == nil {
+			break
+		}
+
+		return e.complexity.Query.FallbackLevel(childComplexity), true
+
+	case "Query.fallbackRecommendations":
+		if e.complexity.Query.FallbackRecommendations == nil {
+			break
+		}
+
+		return e.complexity.Query.FallbackRecommendations(childComplexity), true
+
+	case "Query.fallbackScore":
+		if e.complexity.Query.FallbackScore == nil {
+			break
+		}
+
+		return e.complexity.Query.FallbackScore(childComplexity), true
+
+	case "Query.fallbackTags":
+		if e.complexity.Query.FallbackTags == nil {
+			break
+		}
+
+		return e.complexity.Query.FallbackTags(childComplexity), true
+
 	case "Query.infinity":
 		if e.complexity.Query.Infinity == nil {
 			break
@@ -1493,6 +1918,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Infinity(childComplexity), true
 
+	case "Query.inputDefaultsSearch":
+		if e.complexity.Query.InputDefaultsSearch == nil {
+			break
+		}
+
+		args, err := ec.field_Query_inputDefaultsSearch_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.InputDefaultsSearch(childComplexity, args["input"].(InputDefaultsSearchInput)), true
+
 	case "Query.inputNullableSlice":
 		if e.complexity.Query.InputNullableSlice == nil {
 			break
@@ -1550,6 +1987,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Issue896a(childComplexity), true
 
+	case "Query.linkables":
+		if e.complexity.Query.Linkables == nil {
+			break
+		}
+
+		return e.complexity.Query.Linkables(childComplexity), true
+
 	case "Query.mapInput":
 		if e.complexity.Query.MapInput == nil {
 			break
@@ -1586,6 +2030,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.MapStringInterface(childComplexity, args["in"].(map[string]interface{})), true
 
+	case "Query.maskedUser":
+		if e.complexity.Query.MaskedUser == nil {
+			break
+		}
+
+		return e.complexity.Query.MaskedUser(childComplexity), true
+
 	case "Query.modelMethods":
 		if e.complexity.Query.ModelMethods == nil {
 			break
@@ -1666,6 +2117,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Overlapping(childComplexity), true
 
+	case "Query.ownedOrder":
+		if e.complexity.Query.OwnedOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Query_ownedOrder_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OwnedOrder(childComplexity, args["id"].(string)), true
+
 	case "Query.panics":
 		if e.complexity.Query.Panics == nil {
 			break
@@ -1713,6 +2176,44 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.Recursive(childComplexity, args["input"].(*RecursiveInputSlice)), true
 
+	case "Query.repeatableItem":
+		if e.complexity.Query.RepeatableItem == nil {
+			break
+		}
+
+		args, err := ec.field_Query_repeatableItem_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RepeatableItem(childComplexity, args["id"].(string), args["filter"].(*RepeatableFilter)), true
+
+	case "Query.retryFlaky":
+		if e.complexity.Query.RetryFlaky == nil {
+			break
+		}
+
+		args, err := ec.field_Query_retryFlaky_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RetryFlaky(childComplexity, args["failures"].(int)), true
+
+	case "Query.retryMissing":
+		if e.complexity.Query.RetryMissing == nil {
+			break
+		}
+
+		return e.complexity.Query.RetryMissing(childComplexity), true
+
+	case "Query.retryUser":
+		if e.complexity.Query.RetryUser == nil {
+			break
+		}
+
+		return e.complexity.Query.RetryUser(childComplexity), true
+
 	case "Query.scalarSlice":
 		if e.complexity.Query.ScalarSlice == nil {
 			break
@@ -1755,6 +2256,53 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.StringFromContextInterface(childComplexity), true
 
+	case "Query.typedMapProduct":
+		if e.complexity.Query.TypedMapProduct == nil {
+			break
+		}
+
+		return e.complexity.Query.TypedMapProduct(childComplexity), true
+
+	case "Query.typedMapStatuses":
+		if e.complexity.Query.TypedMapStatuses == nil {
+			break
+		}
+
+		args, err := ec.field_Query_typedMapStatuses_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.TypedMapStatuses(childComplexity, args["ids"].([]string)), true
+
+	case "Query.unknownColor":
+		if e.complexity.Query.UnknownColor == nil {
+			break
+		}
+
+		return e.complexity.Query.UnknownColor(childComplexity), true
+
+	case "Query.unknownSize":
+		if e.complexity.Query.UnknownSize == nil {
+			break
+		}
+
+		return e.complexity.Query.UnknownSize(childComplexity), true
+
+	case "Query.unknownSizes":
+		if e.complexity.Query.UnknownSizes == nil {
+			break
+		}
+
+		return e.complexity.Query.UnknownSizes(childComplexity), true
+
+	case "Query.unknownStatus":
+		if e.complexity.Query.UnknownStatus == nil {
+			break
+		}
+
+		return e.complexity.Query.UnknownStatus(childComplexity), true
+
 	case "Query.user":
 		if e.complexity.Query.User == nil {
 			break
@@ -1858,6 +2406,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Rectangle.Width(childComplexity), true
 
+	case "RepeatableItem.name":
+		if e.complexity.RepeatableItem.Name == nil {
+			break
+		}
+
+		return e.complexity.RepeatableItem.Name(childComplexity), true
+
+	case "RetryUser.id":
+		if e.complexity.RetryUser.ID == nil {
+			break
+		}
+
+		return e.complexity.RetryUser.ID(childComplexity), true
+
+	case "RetryUser.name":
+		if e.complexity.RetryUser.Name == nil {
+			break
+		}
+
+		return e.complexity.RetryUser.Name(childComplexity), true
+
 	case "Size.height":
 		if e.complexity.Size.Height == nil {
 			break
@@ -1952,6 +2521,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.InitPayload(childComplexity), true
 
+	case "Subscription.initialPayloadCounter":
+		if e.complexity.Subscription.InitialPayloadCounter == nil {
+			break
+		}
+
+		return e.complexity.Subscription.InitialPayloadCounter(childComplexity), true
+
+	case "Subscription.initialPayloadIncrements":
+		if e.complexity.Subscription.InitialPayloadIncrements == nil {
+			break
+		}
+
+		return e.complexity.Subscription.InitialPayloadIncrements(childComplexity), true
+
+	case "Subscription.initialPayloadLoggedCounter":
+		if e.complexity.Subscription.InitialPayloadLoggedCounter == nil {
+			break
+		}
+
+		return e.complexity.Subscription.InitialPayloadLoggedCounter(childComplexity), true
+
 	case "Subscription.issue896b":
 		if e.complexity.Subscription.Issue896b == nil {
 			break
@@ -1966,6 +2556,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.Updated(childComplexity), true
 
+	case "TypedMapProduct.labels":
+		if e.complexity.TypedMapProduct.Labels == nil {
+			break
+		}
+
+		return e.complexity.TypedMapProduct.Labels(childComplexity), true
+
+	case "TypedMapProduct.name":
+		if e.complexity.TypedMapProduct.Name == nil {
+			break
+		}
+
+		return e.complexity.TypedMapProduct.Name(childComplexity), true
+
+	case "TypedMapProduct.stock":
+		if e.complexity.TypedMapProduct.Stock == nil {
+			break
+		}
+
+		return e.complexity.TypedMapProduct.Stock(childComplexity), true
+
 	case "User.created":
 		if e.complexity.User.Created == nil {
 			break
@@ -2140,6 +2751,349 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	return 0, false
 }
 
+// FieldExecution describes how the field of the object typeName is executed, see graphql.ExecutionDescriber.
+func (e *executableSchema) FieldExecution(typeName, field string) (graphql.FieldExecution, bool) {
+	switch typeName + "." + field {
+	case "BackedByInterface.id":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "BackedByInterface.thisShouldBind":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "BackedByInterface.thisShouldBindWithError":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "CachedUser.score":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"cached"}}, true
+	case "CachedUser.posts":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"cached"}}, true
+	case "Circle.area":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "ConcreteNodeA.child":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "ConcreteNodeInterface.id":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "ConcreteNodeInterface.child":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "DeferModel.values":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "EmbeddedCase1.exportedEmbeddedPointerExportedMethod":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "EmbeddedCase2.unexportedEmbeddedPointerExportedMethod":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "EmbeddedCase3.unexportedEmbeddedInterfaceExportedMethod":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "Error.errorOnNonRequiredField":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "Error.errorOnRequiredField":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "Error.nilOnRequiredField":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "Errors.a":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Errors.b":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Errors.c":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Errors.d":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Errors.e":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "ForcedResolver.field":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "LinkablePost.url":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "LinkableUser.url":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "ModelMethods.resolverField":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "ModelMethods.noContext":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "ModelMethods.withContext":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod, Concurrent: true}, true
+	case "Mutation.defaultInput":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}, true
+	case "Mutation.overrideValueViaInput":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}, true
+	case "Mutation.updateSomething":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}, true
+	case "Mutation.updatePtrToPtr":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}, true
+	case "Mutation.createTypedMapProduct":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}, true
+	case "Mutation.typedMapRestock":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}, true
+	case "ObjectDirectives.text":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"length"}}, true
+	case "ObjectDirectives.nullableText":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"toNull"}}, true
+	case "ObjectDirectivesWithCustomGoModel.nullableText":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"toNull"}}, true
+	case "OverlappingFields.oldFoo":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "OwnedOrder.id":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"owned"}}, true
+	case "OwnedOrder.total":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"owned"}}, true
+	case "OwnedOrder.note":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"upper", "owned"}}, true
+	case "OwnedOrder.customer":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"owned"}}, true
+	case "Panics.fieldScalarMarshal":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Panics.fieldFuncMarshal":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod, Concurrent: true}, true
+	case "Panics.argUnmarshal":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Pet.friends":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Primitive.value":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Primitive.squared":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "PrimitiveString.value":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "PrimitiveString.doubled":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "PrimitiveString.len":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "PtrToAnyContainer.binding":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "Query.invalidIdentifier":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.collision":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.mapInput":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.recursive":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.nestedInputs":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.nestedOutputs":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.modelMethods":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.user":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.nullableArg":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.inputSlice":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.inputNullableSlice":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.inputOmittable":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.shapeUnion":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.autobind":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.deprecatedField":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.cachedUser":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"cached"}}, true
+	case "Query.cachedCount":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"cached"}}, true
+	case "Query.overlapping":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.defaultParameters":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.deferCase1":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.deferCase2":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveArg":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveNullableArg":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveInputNullable":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveInput":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveInputType":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveObject":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"order1", "order1", "order2", "order1"}}, true
+	case "Query.directiveObjectWithCustomGoModel":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveFieldDef":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"length"}}, true
+	case "Query.directiveField":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.directiveDouble":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"directive1", "directive2"}}, true
+	case "Query.directiveUnimplemented":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"unimplemented"}}, true
+	case "Query.embeddedCase1":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.embeddedCase2":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.embeddedCase3":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.embeddedFieldsDocument":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.enumInInput":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.unknownColor":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.unknownSize":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.unknownSizes":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.unknownStatus":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.fallbackScore":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"fallback"}}, true
+	case "Query.fallbackLevel":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"fallback"}}, true
+	case "Query.fallbackTags":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"fallback"}}, true
+	case "Query.fallbackRecommendations":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"fallback"}}, true
+	case "Query.fallbackBanner":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"fallback"}}, true
+	case "Query.fallbackGreeting":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"fallback"}}, true
+	case "Query.inputDefaultsSearch":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.linkables":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.shapes":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.noShape":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"makeNil"}}, true
+	case "Query.node":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.noShapeTypedNil":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"makeTypedNil"}}, true
+	case "Query.animal":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"makeTypedNil"}}, true
+	case "Query.notAnInterface":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.dog":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.issue896a":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.mapStringInterface":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.mapNestedStringInterface":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.maskedUser":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.errorBubble":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.errorBubbleList":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.errorList":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.errors":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.valid":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.invalid":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.ownedOrder":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"objectLog"}}, true
+	case "Query.panics":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.primitiveObject":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.primitiveStringObject":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.ptrToAnyContainer":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.ptrToSliceContainer":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.repeatableItem":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"tag", "tag", "tag", "tag"}}, true
+	case "Query.retryFlaky":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"retry"}}, true
+	case "Query.retryMissing":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"retry"}}, true
+	case "Query.retryUser":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.infinity":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.stringFromContextInterface":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.stringFromContextFunction":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.defaultScalar":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.slices":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.scalarSlice":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.typedMapProduct":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.typedMapStatuses":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.fallback":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.optionalUnion":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.vOkCaseValue":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.vOkCaseNil":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.validType":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.variadicModel":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.wrappedStruct":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.wrappedScalar":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.wrappedMap":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Query.wrappedSlice":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Rectangle.area":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "RetryUser.name":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"retry"}}, true
+	case "Subscription.updated":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Subscription.initPayload":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Subscription.directiveArg":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Subscription.directiveNullableArg":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Subscription.directiveDouble":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"directive1", "directive2"}}, true
+	case "Subscription.directiveUnimplemented":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"unimplemented"}}, true
+	case "Subscription.initialPayloadCounter":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Subscription.initialPayloadLoggedCounter":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"directive1"}}, true
+	case "Subscription.initialPayloadIncrements":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Subscription.issue896b":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "Subscription.errorRequired":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "User.friends":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "User.pets":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "VOkCaseNil.value":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "VOkCaseValue.value":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}, true
+	case "VariadicModel.value":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByMethod, Concurrent: true}, true
+	case "WrappedMap.get":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	case "WrappedSlice.get":
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}, true
+	}
+	if strings.HasPrefix(typeName, "__") || strings.HasPrefix(field, "__") {
+		return graphql.FieldExecution{}, false
+	}
+	if def := e.Schema().Types[typeName]; def != nil && def.Kind == ast.Object && def.Fields.ForName(field) != nil {
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField}, true
+	}
+	return graphql.FieldExecution{}, false
+}
+
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	rc := graphql.GetOperationContext(ctx)
 	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
@@ -2149,6 +3103,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputFieldsOrderInput,
 		ec.unmarshalInputInnerDirectives,
 		ec.unmarshalInputInnerInput,
+		ec.unmarshalInputInputDefaultsSearchInput,
 		ec.unmarshalInputInputDirectives,
 		ec.unmarshalInputInputWithEnumValue,
 		ec.unmarshalInputMapNestedInput,
@@ -2158,7 +3113,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputOmittableInput,
 		ec.unmarshalInputOuterInput,
 		ec.unmarshalInputRecursiveInputSlice,
+		ec.unmarshalInputRepeatableFilter,
 		ec.unmarshalInputSpecialInput,
+		ec.unmarshalInputTypedMapProductInput,
 		ec.unmarshalInputUpdatePtrToPtrInner,
 		ec.unmarshalInputUpdatePtrToPtrOuter,
 		ec.unmarshalInputValidInput,
@@ -2275,7 +3232,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
-//go:embed "builtinscalar.graphql" "complexity.graphql" "defaults.graphql" "defer.graphql" "directive.graphql" "embedded.graphql" "enum.graphql" "fields_order.graphql" "interfaces.graphql" "issue896.graphql" "loops.graphql" "maps.graphql" "mutation_with_custom_scalar.graphql" "nulls.graphql" "panics.graphql" "primitive_objects.graphql" "ptr_to_any.graphql" "ptr_to_ptr_input.graphql" "ptr_to_slice.graphql" "scalar_context.graphql" "scalar_default.graphql" "schema.graphql" "slices.graphql" "typefallback.graphql" "useptr.graphql" "v-ok.graphql" "validtypes.graphql" "variadic.graphql" "weird_type_cases.graphql" "wrapped_type.graphql"
+//go:embed "builtinscalar.graphql" "cached.graphql" "complexity.graphql" "defaults.graphql" "defer.graphql" "directive.graphql" "embedded.graphql" "embeddedfields.graphql" "enum.graphql" "enumunknown.graphql" "fallback.graphql" "fields_order.graphql" "initialpayload.graphql" "inputdefaults.graphql" "interfaceresolvers.graphql" "interfaces.graphql" "issue896.graphql" "loops.graphql" "maps.graphql" "masking.graphql" "mutation_with_custom_scalar.graphql" "nulls.graphql" "objectdirectives.graphql" "panics.graphql" "primitive_objects.graphql" "ptr_to_any.graphql" "ptr_to_ptr_input.graphql" "ptr_to_slice.graphql" "repeatable.graphql" "retry.graphql" "scalar_context.graphql" "scalar_default.graphql" "schema.graphql" "slices.graphql" "typedmaps.graphql" "typefallback.graphql" "useptr.graphql" "v-ok.graphql" "validtypes.graphql" "variadic.graphql" "weird_type_cases.graphql" "wrapped_type.graphql"
 var sourcesFS embed.FS
 
 func sourceData(filename string) string {
@@ -2288,28 +3245,40 @@ func sourceData(filename string) string {
 
 var sources = []*ast.Source{
 	{Name: "builtinscalar.graphql", Input: sourceData("builtinscalar.graphql"), BuiltIn: false},
+	{Name: "cached.graphql", Input: sourceData("cached.graphql"), BuiltIn: false},
 	{Name: "complexity.graphql", Input: sourceData("complexity.graphql"), BuiltIn: false},
 	{Name: "defaults.graphql", Input: sourceData("defaults.graphql"), BuiltIn: false},
 	{Name: "defer.graphql", Input: sourceData("defer.graphql"), BuiltIn: false},
 	{Name: "directive.graphql", Input: sourceData("directive.graphql"), BuiltIn: false},
 	{Name: "embedded.graphql", Input: sourceData("embedded.graphql"), BuiltIn: false},
+	{Name: "embeddedfields.graphql", Input: sourceData("embeddedfields.graphql"), BuiltIn: false},
 	{Name: "enum.graphql", Input: sourceData("enum.graphql"), BuiltIn: false},
+	{Name: "enumunknown.graphql", Input: sourceData("enumunknown.graphql"), BuiltIn: false},
+	{Name: "fallback.graphql", Input: sourceData("fallback.graphql"), BuiltIn: false},
 	{Name: "fields_order.graphql", Input: sourceData("fields_order.graphql"), BuiltIn: false},
+	{Name: "initialpayload.graphql", Input: sourceData("initialpayload.graphql"), BuiltIn: false},
+	{Name: "inputdefaults.graphql", Input: sourceData("inputdefaults.graphql"), BuiltIn: false},
+	{Name: "interfaceresolvers.graphql", Input: sourceData("interfaceresolvers.graphql"), BuiltIn: false},
 	{Name: "interfaces.graphql", Input: sourceData("interfaces.graphql"), BuiltIn: false},
 	{Name: "issue896.graphql", Input: sourceData("issue896.graphql"), BuiltIn: false},
 	{Name: "loops.graphql", Input: sourceData("loops.graphql"), BuiltIn: false},
 	{Name: "maps.graphql", Input: sourceData("maps.graphql"), BuiltIn: false},
+	{Name: "masking.graphql", Input: sourceData("masking.graphql"), BuiltIn: false},
 	{Name: "mutation_with_custom_scalar.graphql", Input: sourceData("mutation_with_custom_scalar.graphql"), BuiltIn: false},
 	{Name: "nulls.graphql", Input: sourceData("nulls.graphql"), BuiltIn: false},
+	{Name: "objectdirectives.graphql", Input: sourceData("objectdirectives.graphql"), BuiltIn: false},
 	{Name: "panics.graphql", Input: sourceData("panics.graphql"), BuiltIn: false},
 	{Name: "primitive_objects.graphql", Input: sourceData("primitive_objects.graphql"), BuiltIn: false},
 	{Name: "ptr_to_any.graphql", Input: sourceData("ptr_to_any.graphql"), BuiltIn: false},
 	{Name: "ptr_to_ptr_input.graphql", Input: sourceData("ptr_to_ptr_input.graphql"), BuiltIn: false},
 	{Name: "ptr_to_slice.graphql", Input: sourceData("ptr_to_slice.graphql"), BuiltIn: false},
+	{Name: "repeatable.graphql", Input: sourceData("repeatable.graphql"), BuiltIn: false},
+	{Name: "retry.graphql", Input: sourceData("retry.graphql"), BuiltIn: false},
 	{Name: "scalar_context.graphql", Input: sourceData("scalar_context.graphql"), BuiltIn: false},
 	{Name: "scalar_default.graphql", Input: sourceData("scalar_default.graphql"), BuiltIn: false},
 	{Name: "schema.graphql", Input: sourceData("schema.graphql"), BuiltIn: false},
 	{Name: "slices.graphql", Input: sourceData("slices.graphql"), BuiltIn: false},
+	{Name: "typedmaps.graphql", Input: sourceData("typedmaps.graphql"), BuiltIn: false},
 	{Name: "typefallback.graphql", Input: sourceData("typefallback.graphql"), BuiltIn: false},
 	{Name: "useptr.graphql", Input: sourceData("useptr.graphql"), BuiltIn: false},
 	{Name: "v-ok.graphql", Input: sourceData("v-ok.graphql"), BuiltIn: false},
@@ -2319,3 +3288,20 @@ var sources = []*ast.Source{
 	{Name: "wrapped_type.graphql", Input: sourceData("wrapped_type.graphql"), BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
+
+// SchemaSDL returns the source of the schema the package was generated from, including the sources injected by
+// plugins, so it can be served as is whether or not introspection is enabled.
+func SchemaSDL() string {
+	sdl := make([]string, len(sources))
+	for i, src := range sources {
+		sdl[i] = src.Input
+	}
+	return strings.Join(sdl, "\n")
+}
+
+// SchemaHash returns the hex encoded sha256 of SchemaSDL, computed when the package was generated. It changes
+// whenever the schema does, so it can be logged on startup or compared with the schema a persisted operation
+// manifest was built against.
+func SchemaHash() string {
+	return "bddc04799e01f7663e8e6f3277688a72279194078d9934778cb94bb34ccc853f"
+}
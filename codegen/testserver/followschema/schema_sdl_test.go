@@ -0,0 +1,23 @@
+package followschema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestSchemaSDL(t *testing.T) {
+	require.Contains(t, SchemaSDL(), "type Query {")
+
+	sum := sha256.Sum256([]byte(SchemaSDL()))
+	require.Equal(t, hex.EncodeToString(sum[:]), SchemaHash())
+
+	// the SDL is everything the server was built from, so it loads into the same schema
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: SchemaSDL()})
+	require.Nil(t, err)
+	require.Equal(t, len(parsedSchema.Types), len(schema.Types))
+}
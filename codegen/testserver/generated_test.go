@@ -56,9 +56,20 @@ func loadPackage(name string, fset *token.FileSet) *ast.Package {
 			if err != nil {
 				panic(err)
 			}
+			clearSchemaHash(src)
 			pkg.Files[filename] = src
 		}
 	}
 
 	return &pkg
 }
+
+// clearSchemaHash blanks the hash returned by SchemaHash, the schemas of the layouts differ by the package names in
+// their @goModel directives.
+func clearSchemaHash(file *ast.File) {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == "SchemaHash" {
+			fn.Body.List[0].(*ast.ReturnStmt).Results[0].(*ast.BasicLit).Value = `""`
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package singlefile
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+// cachedResolvers counts the calls of every resolver, cachedCount waits for release when it is set.
+func cachedResolvers(calls *atomic.Int32, release chan struct{}) *Stub {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.CachedUser = func(ctx context.Context, id string) (*CachedUser, error) {
+		calls.Add(1)
+		return &CachedUser{ID: id, Name: "user " + id}, nil
+	}
+	resolvers.QueryResolver.CachedCount = func(ctx context.Context) (int, error) {
+		n := calls.Add(1)
+		if release != nil {
+			<-release
+		}
+		return int(n), nil
+	}
+	resolvers.CachedUserResolver.Score = func(ctx context.Context, obj *CachedUser) (int, error) {
+		return int(calls.Add(1)), nil
+	}
+	resolvers.CachedUserResolver.Posts = func(ctx context.Context, obj *CachedUser, first int) ([]string, error) {
+		calls.Add(1)
+		posts := make([]string, first)
+		for i := range posts {
+			posts[i] = fmt.Sprintf("post %d of %s", i, obj.ID)
+		}
+		return posts, nil
+	}
+	return resolvers
+}
+
+func TestCached(t *testing.T) {
+	type result struct {
+		CachedUser struct {
+			Name  string
+			Score int
+			Posts []string
+		}
+	}
+
+	t.Run("resolved every time without the extension", func(t *testing.T) {
+		var calls atomic.Int32
+		c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: cachedResolvers(&calls, nil)})))
+		var resp result
+		c.MustPost(`{ cachedUser(id: "1") { score } }`, &resp)
+		c.MustPost(`{ cachedUser(id: "1") { score } }`, &resp)
+		require.Equal(t, 4, resp.CachedUser.Score)
+		require.EqualValues(t, 4, calls.Load())
+	})
+
+	var calls atomic.Int32
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: cachedResolvers(&calls, nil)}))
+	srv.Use(&extension.FieldCaching{Store: graphql.NewMemoryFieldCacheStore()})
+	c := client.New(srv)
+
+	t.Run("cached by key", func(t *testing.T) {
+		var resp result
+		c.MustPost(`{ cachedUser(id: "1") { name score posts(first: 2) } }`, &resp)
+		require.Equal(t, "user 1", resp.CachedUser.Name)
+		require.Equal(t, 2, resp.CachedUser.Score)
+		require.Equal(t, []string{"post 0 of 1", "post 1 of 1"}, resp.CachedUser.Posts)
+		require.EqualValues(t, 3, calls.Load())
+
+		c.MustPost(`{ cachedUser(id: "1") { name score posts(first: 2) } }`, &resp)
+		require.Equal(t, 2, resp.CachedUser.Score)
+		require.EqualValues(t, 3, calls.Load())
+
+		c.MustPost(`{ cachedUser(id: "1") { posts(first: 1) } }`, &resp)
+		require.Equal(t, []string{"post 0 of 1"}, resp.CachedUser.Posts)
+		require.EqualValues(t, 4, calls.Load())
+
+		c.MustPost(`{ cachedUser(id: "2") { name score } }`, &resp)
+		require.Equal(t, "user 2", resp.CachedUser.Name)
+		require.EqualValues(t, 6, calls.Load())
+	})
+
+	t.Run("concurrent misses share one call", func(t *testing.T) {
+		var calls atomic.Int32
+		release := make(chan struct{})
+		srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: cachedResolvers(&calls, release)}))
+		srv.Use(&extension.FieldCaching{Store: graphql.NewMemoryFieldCacheStore()})
+		c := client.New(srv)
+
+		var wg sync.WaitGroup
+		counts := make([]int, 5)
+		for i := range counts {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				var resp struct{ CachedCount int }
+				c.MustPost(`{ cachedCount }`, &resp)
+				counts[i] = resp.CachedCount
+			}(i)
+		}
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		require.Equal(t, []int{1, 1, 1, 1, 1}, counts)
+		require.EqualValues(t, 1, calls.Load())
+	})
+}
@@ -0,0 +1,8 @@
+package singlefile
+
+type UnknownStatus int
+
+const (
+	UnknownStatusUnknown UnknownStatus = iota
+	UnknownStatusActive
+)
@@ -0,0 +1,76 @@
+package singlefile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestFallback(t *testing.T) {
+	errUnavailable := errors.New("recommendations are unavailable")
+	resolvers := &Stub{}
+	resolvers.QueryResolver.FallbackScore = func(ctx context.Context) (int, error) {
+		return 0, errUnavailable
+	}
+	resolvers.QueryResolver.FallbackLevel = func(ctx context.Context) (*FallbackLevel, error) {
+		return nil, errUnavailable
+	}
+	resolvers.QueryResolver.FallbackTags = func(ctx context.Context) ([]string, error) {
+		return nil, errUnavailable
+	}
+	resolvers.QueryResolver.FallbackRecommendations = func(ctx context.Context) ([]*FallbackProduct, error) {
+		return nil, errUnavailable
+	}
+	resolvers.QueryResolver.FallbackBanner = func(ctx context.Context) (*FallbackProduct, error) {
+		return nil, errUnavailable
+	}
+	resolvers.QueryResolver.FallbackGreeting = func(ctx context.Context) (string, error) {
+		return "hi", nil
+	}
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+	c := client.New(srv)
+
+	t.Run("returns the fallback values of failing fields with warnings", func(t *testing.T) {
+		resp, err := c.RawPost(`{ fallbackScore fallbackLevel fallbackTags fallbackRecommendations { id } fallbackBanner { id } fallbackGreeting }`)
+		require.NoError(t, err)
+		require.Nil(t, resp.Errors)
+		data, err := json.Marshal(resp.Data)
+		require.NoError(t, err)
+		require.JSONEq(t, `{"fallbackScore":0,"fallbackLevel":"LOW","fallbackTags":["none"],"fallbackRecommendations":[],"fallbackBanner":null,"fallbackGreeting":"hi"}`, string(data))
+
+		var warnings []graphql.Warning
+		b, err := json.Marshal(resp.Extensions["warnings"])
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(b, &warnings))
+		// root fields are resolved concurrently
+		var paths []string
+		for _, w := range warnings {
+			require.Equal(t, "recommendations are unavailable", w.Message)
+			require.Equal(t, graphql.FallbackWarningCode, w.Code)
+			paths = append(paths, w.Path.String())
+		}
+		require.ElementsMatch(t, []string{"fallbackScore", "fallbackLevel", "fallbackTags", "fallbackRecommendations", "fallbackBanner"}, paths)
+	})
+
+	t.Run("warnings go through the error presenter", func(t *testing.T) {
+		srv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
+			presented := graphql.DefaultErrorPresenter(ctx, err)
+			presented.Message = "internal error"
+			return presented
+		})
+		resp, err := c.RawPost(`{ fallbackScore }`)
+		require.NoError(t, err)
+		warnings, err := json.Marshal(resp.Extensions["warnings"])
+		require.NoError(t, err)
+		require.JSONEq(t, `[{"message":"internal error","path":["fallbackScore"],"code":"FIELD_FALLBACK"}]`, string(warnings))
+	})
+}
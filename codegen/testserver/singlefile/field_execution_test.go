@@ -0,0 +1,38 @@
+package singlefile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestFieldExecution(t *testing.T) {
+	es := NewExecutableSchema(Config{Resolvers: &Stub{}}).(graphql.ExecutionDescriber)
+
+	for _, tc := range []struct {
+		typeName, field string
+		execution       graphql.FieldExecution
+	}{
+		{"ModelMethods", "resolverField", graphql.FieldExecution{Resolution: graphql.ResolvedByResolver, Concurrent: true}},
+		{"ModelMethods", "noContext", graphql.FieldExecution{Resolution: graphql.ResolvedByMethod}},
+		{"ModelMethods", "withContext", graphql.FieldExecution{Resolution: graphql.ResolvedByMethod, Concurrent: true}},
+		{"Mutation", "defaultInput", graphql.FieldExecution{Resolution: graphql.ResolvedByResolver}},
+		{"ObjectDirectives", "text", graphql.FieldExecution{Resolution: graphql.ResolvedByField, Directives: []string{"length"}}},
+		{"Circle", "radius", graphql.FieldExecution{Resolution: graphql.ResolvedByField}},
+	} {
+		t.Run(tc.typeName+"."+tc.field, func(t *testing.T) {
+			execution, ok := es.FieldExecution(tc.typeName, tc.field)
+			require.True(t, ok)
+			require.Equal(t, tc.execution, execution)
+		})
+	}
+
+	t.Run("only describes fields of objects", func(t *testing.T) {
+		for _, name := range [][2]string{{"Shape", "area"}, {"Query", "__schema"}, {"__Type", "name"}, {"Circle", "missing"}, {"Missing", "field"}} {
+			_, ok := es.FieldExecution(name[0], name[1])
+			require.False(t, ok, name[0]+"."+name[1])
+		}
+	})
+}
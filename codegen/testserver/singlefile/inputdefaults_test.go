@@ -0,0 +1,72 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestInputDefaults(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.InputDefaultsSearch = func(ctx context.Context, input InputDefaultsSearchInput) (*InputDefaultsSearchResult, error) {
+		cursor, cursorSet := input.Cursor.ValueOK()
+		return &InputDefaultsSearchResult{
+			Query:     input.Query,
+			Limit:     input.Limit.Value(),
+			Order:     input.Order.Value(),
+			Locale:    input.Locale.Value(),
+			Cursor:    cursor,
+			CursorSet: cursorSet,
+		}, nil
+	}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	type result struct {
+		InputDefaultsSearch struct {
+			Limit     *int
+			Order     *string
+			Locale    *string
+			Cursor    *string
+			CursorSet bool
+		}
+	}
+
+	t.Run("omitted fields get the default", func(t *testing.T) {
+		var resp result
+		c.MustPost(`{ inputDefaultsSearch(input: {query: "q"}) { limit order locale cursor cursorSet } }`, &resp)
+		require.Equal(t, 20, *resp.InputDefaultsSearch.Limit)
+		require.Equal(t, "DESC", *resp.InputDefaultsSearch.Order)
+		require.Equal(t, "en", *resp.InputDefaultsSearch.Locale)
+		require.Equal(t, "start", *resp.InputDefaultsSearch.Cursor)
+		require.True(t, resp.InputDefaultsSearch.CursorSet)
+	})
+
+	t.Run("sent values and nulls are kept", func(t *testing.T) {
+		var resp result
+		c.MustPost(`{ inputDefaultsSearch(input: {query: "q", limit: 5, order: ASC, locale: null, cursor: null}) { limit order locale cursor cursorSet } }`, &resp)
+		require.Equal(t, 5, *resp.InputDefaultsSearch.Limit)
+		require.Equal(t, "ASC", *resp.InputDefaultsSearch.Order)
+		require.Nil(t, resp.InputDefaultsSearch.Locale)
+		require.Nil(t, resp.InputDefaultsSearch.Cursor)
+		require.True(t, resp.InputDefaultsSearch.CursorSet)
+	})
+
+	t.Run("defaults are not introspectable", func(t *testing.T) {
+		var resp struct {
+			Type struct {
+				InputFields []struct {
+					Name         string
+					DefaultValue *string
+				}
+			} `json:"__type"`
+		}
+		c.MustPost(`{ __type(name: "InputDefaultsSearchInput") { inputFields { name defaultValue } } }`, &resp)
+		for _, f := range resp.Type.InputFields {
+			require.Nil(t, f.DefaultValue, f.Name)
+		}
+	})
+}
@@ -0,0 +1,91 @@
+package singlefile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+type maskingViewerKey struct{}
+
+func TestMasking(t *testing.T) {
+	type result struct {
+		MaskedUser struct {
+			Name       string
+			Email      *string
+			Phone      *string
+			InternalID string
+			Ssn        string
+		}
+	}
+	const query = `{ maskedUser { name email phone internalId ssn } }`
+
+	resolvers := &Stub{}
+	resolvers.QueryResolver.MaskedUser = func(ctx context.Context) (*MaskedUser, error) {
+		email := "user@example.com"
+		return &MaskedUser{
+			Name:       "user",
+			Email:      &email,
+			InternalID: "42",
+			Ssn:        "123-45-6789",
+		}, nil
+	}
+
+	t.Run("everything is masked without the extension", func(t *testing.T) {
+		c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+		var resp result
+		c.MustPost(query, &resp)
+		require.Equal(t, "user", resp.MaskedUser.Name)
+		require.Equal(t, "****", *resp.MaskedUser.Email)
+		require.Nil(t, resp.MaskedUser.Phone)
+		require.Equal(t, "****", resp.MaskedUser.InternalID)
+		require.Equal(t, "****", resp.MaskedUser.Ssn)
+	})
+
+	srv := handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers}))
+	srv.Use(extension.Masking{
+		Allow: func(ctx context.Context, reason string) bool {
+			return reason == "pii" && ctx.Value(maskingViewerKey{}) == "admin"
+		},
+		Encrypt: func(ctx context.Context, key string, value string) (string, error) {
+			if ctx.Value(maskingViewerKey{}) == "broken" {
+				return "", errors.New("no key")
+			}
+			return key + ":" + value, nil
+		},
+	})
+	c := client.New(srv)
+	viewer := func(name string) client.Option {
+		return func(bd *client.Request) {
+			bd.HTTP = bd.HTTP.WithContext(context.WithValue(bd.HTTP.Context(), maskingViewerKey{}, name))
+		}
+	}
+
+	t.Run("masked for viewers the policy rejects", func(t *testing.T) {
+		var resp result
+		c.MustPost(query, &resp, viewer("guest"))
+		require.Equal(t, "****", *resp.MaskedUser.Email)
+		require.Equal(t, "****", resp.MaskedUser.InternalID)
+		require.Equal(t, "ssn:123-45-6789", resp.MaskedUser.Ssn)
+	})
+
+	t.Run("readable by viewers the policy allows", func(t *testing.T) {
+		var resp result
+		c.MustPost(query, &resp, viewer("admin"))
+		require.Equal(t, "user@example.com", *resp.MaskedUser.Email)
+		require.Equal(t, "****", resp.MaskedUser.InternalID)
+	})
+
+	t.Run("masked when encryption fails", func(t *testing.T) {
+		var resp result
+		err := c.Post(query, &resp, viewer("broken"))
+		require.EqualError(t, err, `[{"message":"no key","path":["maskedUser","ssn"]}]`)
+		require.Equal(t, "****", resp.MaskedUser.Ssn)
+	})
+}
@@ -21,6 +21,12 @@ type ContentChild interface {
 	IsContentChild()
 }
 
+type Linkable interface {
+	IsLinkable()
+	GetID() string
+	GetURL() string
+}
+
 type Mammalian interface {
 	IsAnimal()
 	IsMammalian()
@@ -52,6 +58,13 @@ type B struct {
 
 func (B) IsTestUnion() {}
 
+type CachedUser struct {
+	ID    string   `json:"id"`
+	Name  string   `json:"name"`
+	Score int      `json:"score"`
+	Posts []string `json:"posts"`
+}
+
 type Cat struct {
 	Species  string `json:"species"`
 	Size     *Size  `json:"size"`
@@ -113,6 +126,10 @@ type EmbeddedDefaultScalar struct {
 	Value *string `json:"value,omitempty"`
 }
 
+type FallbackProduct struct {
+	ID string `json:"id"`
+}
+
 type FieldsOrderPayload struct {
 	FirstFieldValue *string `json:"firstFieldValue,omitempty"`
 }
@@ -141,6 +158,23 @@ type InnerObject struct {
 	ID int `json:"id"`
 }
 
+type InputDefaultsSearchInput struct {
+	Query  string                                 `json:"query"`
+	Limit  graphql.Omittable[*int]                `json:"limit,omitempty"`
+	Order  graphql.Omittable[*InputDefaultsOrder] `json:"order,omitempty"`
+	Locale graphql.Omittable[*string]             `json:"locale,omitempty"`
+	Cursor graphql.Omittable[*string]             `json:"cursor,omitempty"`
+}
+
+type InputDefaultsSearchResult struct {
+	Query     string              `json:"query"`
+	Limit     *int                `json:"limit,omitempty"`
+	Order     *InputDefaultsOrder `json:"order,omitempty"`
+	Locale    *string             `json:"locale,omitempty"`
+	Cursor    *string             `json:"cursor,omitempty"`
+	CursorSet bool                `json:"cursorSet"`
+}
+
 type InputDirectives struct {
 	Text          string           `json:"text"`
 	NullableText  *string          `json:"nullableText,omitempty"`
@@ -153,6 +187,26 @@ type InputWithEnumValue struct {
 	Enum EnumTest `json:"enum"`
 }
 
+type LinkablePost struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+func (LinkablePost) IsLinkable()         {}
+func (this LinkablePost) GetID() string  { return this.ID }
+func (this LinkablePost) GetURL() string { return this.URL }
+
+type LinkableUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+func (LinkableUser) IsLinkable()         {}
+func (this LinkableUser) GetID() string  { return this.ID }
+func (this LinkableUser) GetURL() string { return this.URL }
+
 type LoopA struct {
 	B *LoopB `json:"b"`
 }
@@ -167,6 +221,14 @@ type Map struct {
 	ID string `json:"id"`
 }
 
+type MaskedUser struct {
+	Name       string  `json:"name"`
+	Email      *string `json:"email,omitempty"`
+	Phone      *string `json:"phone,omitempty"`
+	InternalID string  `json:"internalId"`
+	Ssn        string  `json:"ssn"`
+}
+
 type Mutation struct {
 }
 
@@ -203,6 +265,17 @@ type OuterObject struct {
 	Inner *InnerObject `json:"inner"`
 }
 
+type OwnedCustomer struct {
+	Name string `json:"name"`
+}
+
+type OwnedOrder struct {
+	ID       string         `json:"id"`
+	Total    int            `json:"total"`
+	Note     *string        `json:"note,omitempty"`
+	Customer *OwnedCustomer `json:"customer"`
+}
+
 type Pet struct {
 	ID      int    `json:"id"`
 	Friends []*Pet `json:"friends,omitempty"`
@@ -211,6 +284,19 @@ type Pet struct {
 type Query struct {
 }
 
+type RepeatableFilter struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type RepeatableItem struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type RetryUser struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
 type Size struct {
 	Height int `json:"height"`
 	Weight int `json:"weight"`
@@ -230,6 +316,17 @@ type SpecialInput struct {
 type Subscription struct {
 }
 
+type TypedMapProduct struct {
+	Name   string            `json:"name"`
+	Stock  map[string]int    `json:"stock"`
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+type TypedMapProductInput struct {
+	Name  string         `json:"name"`
+	Stock map[string]int `json:"stock"`
+}
+
 type User struct {
 	ID      int        `json:"id"`
 	Friends []*User    `json:"friends"`
@@ -332,6 +429,88 @@ func (e EnumTest) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type FallbackLevel string
+
+const (
+	FallbackLevelLow  FallbackLevel = "LOW"
+	FallbackLevelHigh FallbackLevel = "HIGH"
+)
+
+var AllFallbackLevel = []FallbackLevel{
+	FallbackLevelLow,
+	FallbackLevelHigh,
+}
+
+func (e FallbackLevel) IsValid() bool {
+	switch e {
+	case FallbackLevelLow, FallbackLevelHigh:
+		return true
+	}
+	return false
+}
+
+func (e FallbackLevel) String() string {
+	return string(e)
+}
+
+func (e *FallbackLevel) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FallbackLevel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FallbackLevel", str)
+	}
+	return nil
+}
+
+func (e FallbackLevel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type InputDefaultsOrder string
+
+const (
+	InputDefaultsOrderAsc  InputDefaultsOrder = "ASC"
+	InputDefaultsOrderDesc InputDefaultsOrder = "DESC"
+)
+
+var AllInputDefaultsOrder = []InputDefaultsOrder{
+	InputDefaultsOrderAsc,
+	InputDefaultsOrderDesc,
+}
+
+func (e InputDefaultsOrder) IsValid() bool {
+	switch e {
+	case InputDefaultsOrderAsc, InputDefaultsOrderDesc:
+		return true
+	}
+	return false
+}
+
+func (e InputDefaultsOrder) String() string {
+	return string(e)
+}
+
+func (e *InputDefaultsOrder) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = InputDefaultsOrder(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid InputDefaultsOrder", str)
+	}
+	return nil
+}
+
+func (e InputDefaultsOrder) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Status string
 
 const (
@@ -372,3 +551,126 @@ func (e *Status) UnmarshalGQL(v interface{}) error {
 func (e Status) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type TypedMapStatus string
+
+const (
+	TypedMapStatusOpen    TypedMapStatus = "OPEN"
+	TypedMapStatusShipped TypedMapStatus = "SHIPPED"
+)
+
+var AllTypedMapStatus = []TypedMapStatus{
+	TypedMapStatusOpen,
+	TypedMapStatusShipped,
+}
+
+func (e TypedMapStatus) IsValid() bool {
+	switch e {
+	case TypedMapStatusOpen, TypedMapStatusShipped:
+		return true
+	}
+	return false
+}
+
+func (e TypedMapStatus) String() string {
+	return string(e)
+}
+
+func (e *TypedMapStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TypedMapStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TypedMapStatus", str)
+	}
+	return nil
+}
+
+func (e TypedMapStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UnknownColor string
+
+const (
+	UnknownColorRed   UnknownColor = "RED"
+	UnknownColorGreen UnknownColor = "GREEN"
+)
+
+var AllUnknownColor = []UnknownColor{
+	UnknownColorRed,
+	UnknownColorGreen,
+}
+
+func (e UnknownColor) IsValid() bool {
+	switch e {
+	case UnknownColorRed, UnknownColorGreen:
+		return true
+	}
+	return false
+}
+
+func (e UnknownColor) String() string {
+	return string(e)
+}
+
+func (e *UnknownColor) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UnknownColor(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UnknownColor", str)
+	}
+	return nil
+}
+
+func (e UnknownColor) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type UnknownSize string
+
+const (
+	UnknownSizeSmall UnknownSize = "SMALL"
+	UnknownSizeLarge UnknownSize = "LARGE"
+)
+
+var AllUnknownSize = []UnknownSize{
+	UnknownSizeSmall,
+	UnknownSizeLarge,
+}
+
+func (e UnknownSize) IsValid() bool {
+	switch e {
+	case UnknownSizeSmall, UnknownSizeLarge:
+		return true
+	}
+	return false
+}
+
+func (e UnknownSize) String() string {
+	return string(e)
+}
+
+func (e *UnknownSize) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UnknownSize(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UnknownSize", str)
+	}
+	return nil
+}
+
+func (e UnknownSize) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,66 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestRepeatableDirectives(t *testing.T) {
+	var calls []string
+	resolvers := &Stub{}
+	resolvers.QueryResolver.RepeatableItem = func(ctx context.Context, id string, filter *RepeatableFilter) (*RepeatableItem, error) {
+		name := id
+		return &RepeatableItem{Name: &name}, nil
+	}
+	cfg := Config{Resolvers: resolvers}
+	cfg.Directives.Tag = func(ctx context.Context, obj interface{}, next graphql.Resolver, name string) (interface{}, error) {
+		calls = append(calls, name)
+		return next(ctx)
+	}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(cfg)))
+
+	t.Run("applies every application", func(t *testing.T) {
+		calls = nil
+		var resp struct{ RepeatableItem struct{ Name string } }
+		c.MustPost(`{ repeatableItem(id: "1", filter: {name: "x"}) @tag(name: "query1") @tag(name: "query2") { name } }`, &resp)
+		require.Equal(t, "1", resp.RepeatableItem.Name)
+		// later applications wrap earlier ones
+		require.Equal(t, []string{
+			"id2", "id1",
+			"name2", "name1",
+			"query2", "query1",
+			"item2", "item1",
+			"type2", "type1",
+		}, calls)
+	})
+
+	t.Run("rejects other directives used twice", func(t *testing.T) {
+		var resp struct{}
+		err := c.Post(`{ repeatableItem(id: "1") @skip(if: false) @skip(if: false) @tag(name: "a") @tag(name: "b") { name } }`, &resp)
+		require.EqualError(t, err, `http 422: {"errors":[{"message":"The directive \"@skip\" can only be used once at this location.","locations":[{"line":1,"column":45}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}],"data":null}`)
+	})
+
+	t.Run("are repeatable in introspection", func(t *testing.T) {
+		var resp struct {
+			Schema struct {
+				Directives []struct {
+					Name         string
+					IsRepeatable bool
+				}
+			} `json:"__schema"`
+		}
+		c.MustPost(`{ __schema { directives { name isRepeatable } } }`, &resp)
+		repeatable := map[string]bool{}
+		for _, d := range resp.Schema.Directives {
+			repeatable[d.Name] = d.IsRepeatable
+		}
+		require.True(t, repeatable["tag"])
+		require.False(t, repeatable["skip"])
+	})
+}
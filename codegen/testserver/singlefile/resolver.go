@@ -8,6 +8,7 @@ import (
 	introspection1 "github.com/99designs/gqlgen/codegen/testserver/singlefile/introspection"
 	invalid_packagename "github.com/99designs/gqlgen/codegen/testserver/singlefile/invalid-packagename"
 	"github.com/99designs/gqlgen/codegen/testserver/singlefile/otherpkg"
+	"github.com/99designs/gqlgen/graphql"
 )
 
 type Resolver struct{}
@@ -17,6 +18,16 @@ func (r *backedByInterfaceResolver) ID(ctx context.Context, obj BackedByInterfac
 	panic("not implemented")
 }
 
+// Score is the resolver for the score field.
+func (r *cachedUserResolver) Score(ctx context.Context, obj *CachedUser) (int, error) {
+	panic("not implemented")
+}
+
+// Posts is the resolver for the posts field.
+func (r *cachedUserResolver) Posts(ctx context.Context, obj *CachedUser, first int) ([]string, error) {
+	panic("not implemented")
+}
+
 // Values is the resolver for the values field.
 func (r *deferModelResolver) Values(ctx context.Context, obj *DeferModel) ([]string, error) {
 	panic("not implemented")
@@ -77,6 +88,16 @@ func (r *mutationResolver) UpdatePtrToPtr(ctx context.Context, input UpdatePtrTo
 	panic("not implemented")
 }
 
+// CreateTypedMapProduct is the resolver for the createTypedMapProduct field.
+func (r *mutationResolver) CreateTypedMapProduct(ctx context.Context, input TypedMapProductInput) (*TypedMapProduct, error) {
+	panic("not implemented")
+}
+
+// TypedMapRestock is the resolver for the typedMapRestock field.
+func (r *mutationResolver) TypedMapRestock(ctx context.Context, stock map[string]int) (map[string]int, error) {
+	panic("not implemented")
+}
+
 // OldFoo is the resolver for the oldFoo field.
 func (r *overlappingFieldsResolver) OldFoo(ctx context.Context, obj *OverlappingFields) (int, error) {
 	panic("not implemented")
@@ -187,6 +208,16 @@ func (r *queryResolver) DeprecatedField(ctx context.Context) (string, error) {
 	panic("not implemented")
 }
 
+// CachedUser is the resolver for the cachedUser field.
+func (r *queryResolver) CachedUser(ctx context.Context, id string) (*CachedUser, error) {
+	panic("not implemented")
+}
+
+// CachedCount is the resolver for the cachedCount field.
+func (r *queryResolver) CachedCount(ctx context.Context) (int, error) {
+	panic("not implemented")
+}
+
 // Overlapping is the resolver for the overlapping field.
 func (r *queryResolver) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	panic("not implemented")
@@ -277,11 +308,76 @@ func (r *queryResolver) EmbeddedCase3(ctx context.Context) (*EmbeddedCase3, erro
 	panic("not implemented")
 }
 
+// EmbeddedFieldsDocument is the resolver for the embeddedFieldsDocument field.
+func (r *queryResolver) EmbeddedFieldsDocument(ctx context.Context) (*EmbeddedFieldsDocument, error) {
+	panic("not implemented")
+}
+
 // EnumInInput is the resolver for the enumInInput field.
 func (r *queryResolver) EnumInInput(ctx context.Context, input *InputWithEnumValue) (EnumTest, error) {
 	panic("not implemented")
 }
 
+// UnknownColor is the resolver for the unknownColor field.
+func (r *queryResolver) UnknownColor(ctx context.Context) (UnknownColor, error) {
+	panic("not implemented")
+}
+
+// UnknownSize is the resolver for the unknownSize field.
+func (r *queryResolver) UnknownSize(ctx context.Context) (*UnknownSize, error) {
+	panic("not implemented")
+}
+
+// UnknownSizes is the resolver for the unknownSizes field.
+func (r *queryResolver) UnknownSizes(ctx context.Context) ([]UnknownSize, error) {
+	panic("not implemented")
+}
+
+// UnknownStatus is the resolver for the unknownStatus field.
+func (r *queryResolver) UnknownStatus(ctx context.Context) (UnknownStatus, error) {
+	panic("not implemented")
+}
+
+// FallbackScore is the resolver for the fallbackScore field.
+func (r *queryResolver) FallbackScore(ctx context.Context) (int, error) {
+	panic("not implemented")
+}
+
+// FallbackLevel is the resolver for the fallbackLevel field.
+func (r *queryResolver) FallbackLevel(ctx context.Context) (*FallbackLevel, error) {
+	panic("not implemented")
+}
+
+// FallbackTags is the resolver for the fallbackTags field.
+func (r *queryResolver) FallbackTags(ctx context.Context) ([]string, error) {
+	panic("not implemented")
+}
+
+// FallbackRecommendations is the resolver for the fallbackRecommendations field.
+func (r *queryResolver) FallbackRecommendations(ctx context.Context) ([]*FallbackProduct, error) {
+	panic("not implemented")
+}
+
+// FallbackBanner is the resolver for the fallbackBanner field.
+func (r *queryResolver) FallbackBanner(ctx context.Context) (*FallbackProduct, error) {
+	panic("not implemented")
+}
+
+// FallbackGreeting is the resolver for the fallbackGreeting field.
+func (r *queryResolver) FallbackGreeting(ctx context.Context) (string, error) {
+	panic("not implemented")
+}
+
+// InputDefaultsSearch is the resolver for the inputDefaultsSearch field.
+func (r *queryResolver) InputDefaultsSearch(ctx context.Context, input InputDefaultsSearchInput) (*InputDefaultsSearchResult, error) {
+	panic("not implemented")
+}
+
+// Linkables is the resolver for the linkables field.
+func (r *queryResolver) Linkables(ctx context.Context) ([]Linkable, error) {
+	panic("not implemented")
+}
+
 // Shapes is the resolver for the shapes field.
 func (r *queryResolver) Shapes(ctx context.Context) ([]Shape, error) {
 	panic("not implemented")
@@ -332,6 +428,11 @@ func (r *queryResolver) MapNestedStringInterface(ctx context.Context, in *Nested
 	panic("not implemented")
 }
 
+// MaskedUser is the resolver for the maskedUser field.
+func (r *queryResolver) MaskedUser(ctx context.Context) (*MaskedUser, error) {
+	panic("not implemented")
+}
+
 // ErrorBubble is the resolver for the errorBubble field.
 func (r *queryResolver) ErrorBubble(ctx context.Context) (*Error, error) {
 	panic("not implemented")
@@ -362,6 +463,11 @@ func (r *queryResolver) Invalid(ctx context.Context) (string, error) {
 	panic("not implemented")
 }
 
+// OwnedOrder is the resolver for the ownedOrder field.
+func (r *queryResolver) OwnedOrder(ctx context.Context, id string) (*OwnedOrder, error) {
+	panic("not implemented")
+}
+
 // Panics is the resolver for the panics field.
 func (r *queryResolver) Panics(ctx context.Context) (*Panics, error) {
 	panic("not implemented")
@@ -387,6 +493,26 @@ func (r *queryResolver) PtrToSliceContainer(ctx context.Context) (*PtrToSliceCon
 	panic("not implemented")
 }
 
+// RepeatableItem is the resolver for the repeatableItem field.
+func (r *queryResolver) RepeatableItem(ctx context.Context, id string, filter *RepeatableFilter) (*RepeatableItem, error) {
+	panic("not implemented")
+}
+
+// RetryFlaky is the resolver for the retryFlaky field.
+func (r *queryResolver) RetryFlaky(ctx context.Context, failures int) (int, error) {
+	panic("not implemented")
+}
+
+// RetryMissing is the resolver for the retryMissing field.
+func (r *queryResolver) RetryMissing(ctx context.Context) (*int, error) {
+	panic("not implemented")
+}
+
+// RetryUser is the resolver for the retryUser field.
+func (r *queryResolver) RetryUser(ctx context.Context) (*RetryUser, error) {
+	panic("not implemented")
+}
+
 // Infinity is the resolver for the infinity field.
 func (r *queryResolver) Infinity(ctx context.Context) (float64, error) {
 	panic("not implemented")
@@ -417,6 +543,16 @@ func (r *queryResolver) ScalarSlice(ctx context.Context) ([]byte, error) {
 	panic("not implemented")
 }
 
+// TypedMapProduct is the resolver for the typedMapProduct field.
+func (r *queryResolver) TypedMapProduct(ctx context.Context) (*TypedMapProduct, error) {
+	panic("not implemented")
+}
+
+// TypedMapStatuses is the resolver for the typedMapStatuses field.
+func (r *queryResolver) TypedMapStatuses(ctx context.Context, ids []string) (map[string]*TypedMapStatus, error) {
+	panic("not implemented")
+}
+
 // Fallback is the resolver for the fallback field.
 func (r *queryResolver) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	panic("not implemented")
@@ -467,6 +603,11 @@ func (r *queryResolver) WrappedSlice(ctx context.Context) (WrappedSlice, error)
 	panic("not implemented")
 }
 
+// Name is the resolver for the name field.
+func (r *retryUserResolver) Name(ctx context.Context, obj *RetryUser) (string, error) {
+	panic("not implemented")
+}
+
 // Updated is the resolver for the updated field.
 func (r *subscriptionResolver) Updated(ctx context.Context) (<-chan string, error) {
 	panic("not implemented")
@@ -497,6 +638,21 @@ func (r *subscriptionResolver) DirectiveUnimplemented(ctx context.Context) (<-ch
 	panic("not implemented")
 }
 
+// InitialPayloadCounter is the resolver for the initialPayloadCounter field.
+func (r *subscriptionResolver) InitialPayloadCounter(ctx context.Context) (graphql.InitialPayload[int], error) {
+	panic("not implemented")
+}
+
+// InitialPayloadLoggedCounter is the resolver for the initialPayloadLoggedCounter field.
+func (r *subscriptionResolver) InitialPayloadLoggedCounter(ctx context.Context) (graphql.InitialPayload[int], error) {
+	panic("not implemented")
+}
+
+// InitialPayloadIncrements is the resolver for the initialPayloadIncrements field.
+func (r *subscriptionResolver) InitialPayloadIncrements(ctx context.Context) (<-chan int, error) {
+	panic("not implemented")
+}
+
 // Issue896b is the resolver for the issue896b field.
 func (r *subscriptionResolver) Issue896b(ctx context.Context) (<-chan []*CheckIssue896, error) {
 	panic("not implemented")
@@ -527,11 +683,19 @@ func (r *wrappedSliceResolver) Get(ctx context.Context, obj WrappedSlice, idx in
 	panic("not implemented")
 }
 
+// URL is the resolver for the url field.
+func (r *linkableResolver) URL(ctx context.Context, obj Linkable, absolute *bool) (string, error) {
+	panic("not implemented")
+}
+
 // BackedByInterface returns BackedByInterfaceResolver implementation.
 func (r *Resolver) BackedByInterface() BackedByInterfaceResolver {
 	return &backedByInterfaceResolver{r}
 }
 
+// CachedUser returns CachedUserResolver implementation.
+func (r *Resolver) CachedUser() CachedUserResolver { return &cachedUserResolver{r} }
+
 // DeferModel returns DeferModelResolver implementation.
 func (r *Resolver) DeferModel() DeferModelResolver { return &deferModelResolver{r} }
 
@@ -567,6 +731,9 @@ func (r *Resolver) PrimitiveString() PrimitiveStringResolver { return &primitive
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// RetryUser returns RetryUserResolver implementation.
+func (r *Resolver) RetryUser() RetryUserResolver { return &retryUserResolver{r} }
+
 // Subscription returns SubscriptionResolver implementation.
 func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -579,7 +746,11 @@ func (r *Resolver) WrappedMap() WrappedMapResolver { return &wrappedMapResolver{
 // WrappedSlice returns WrappedSliceResolver implementation.
 func (r *Resolver) WrappedSlice() WrappedSliceResolver { return &wrappedSliceResolver{r} }
 
+// Linkable returns LinkableResolver implementation.
+func (r *Resolver) Linkable() LinkableResolver { return &linkableResolver{r} }
+
 type backedByInterfaceResolver struct{ *Resolver }
+type cachedUserResolver struct{ *Resolver }
 type deferModelResolver struct{ *Resolver }
 type errorsResolver struct{ *Resolver }
 type forcedResolverResolver struct{ *Resolver }
@@ -591,7 +762,9 @@ type petResolver struct{ *Resolver }
 type primitiveResolver struct{ *Resolver }
 type primitiveStringResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type retryUserResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type wrappedMapResolver struct{ *Resolver }
 type wrappedSliceResolver struct{ *Resolver }
+type linkableResolver struct{ *Resolver }
@@ -8,12 +8,17 @@ import (
 	introspection1 "github.com/99designs/gqlgen/codegen/testserver/singlefile/introspection"
 	invalid_packagename "github.com/99designs/gqlgen/codegen/testserver/singlefile/invalid-packagename"
 	"github.com/99designs/gqlgen/codegen/testserver/singlefile/otherpkg"
+	"github.com/99designs/gqlgen/graphql"
 )
 
 type Stub struct {
 	BackedByInterfaceResolver struct {
 		ID func(ctx context.Context, obj BackedByInterface) (string, error)
 	}
+	CachedUserResolver struct {
+		Score func(ctx context.Context, obj *CachedUser) (int, error)
+		Posts func(ctx context.Context, obj *CachedUser, first int) ([]string, error)
+	}
 	DeferModelResolver struct {
 		Values func(ctx context.Context, obj *DeferModel) ([]string, error)
 	}
@@ -35,6 +40,8 @@ type Stub struct {
 		OverrideValueViaInput func(ctx context.Context, input FieldsOrderInput) (*FieldsOrderPayload, error)
 		UpdateSomething       func(ctx context.Context, input SpecialInput) (string, error)
 		UpdatePtrToPtr        func(ctx context.Context, input UpdatePtrToPtrOuter) (*PtrToPtrOuter, error)
+		CreateTypedMapProduct func(ctx context.Context, input TypedMapProductInput) (*TypedMapProduct, error)
+		TypedMapRestock       func(ctx context.Context, stock map[string]int) (map[string]int, error)
 	}
 	OverlappingFieldsResolver struct {
 		OldFoo func(ctx context.Context, obj *OverlappingFields) (int, error)
@@ -69,6 +76,8 @@ type Stub struct {
 		ShapeUnion                       func(ctx context.Context) (ShapeUnion, error)
 		Autobind                         func(ctx context.Context) (*Autobind, error)
 		DeprecatedField                  func(ctx context.Context) (string, error)
+		CachedUser                       func(ctx context.Context, id string) (*CachedUser, error)
+		CachedCount                      func(ctx context.Context) (int, error)
 		Overlapping                      func(ctx context.Context) (*OverlappingFields, error)
 		DefaultParameters                func(ctx context.Context, falsyBoolean *bool, truthyBoolean *bool) (*DefaultParametersMirror, error)
 		DeferCase1                       func(ctx context.Context) (*DeferModel, error)
@@ -87,7 +96,20 @@ type Stub struct {
 		EmbeddedCase1                    func(ctx context.Context) (*EmbeddedCase1, error)
 		EmbeddedCase2                    func(ctx context.Context) (*EmbeddedCase2, error)
 		EmbeddedCase3                    func(ctx context.Context) (*EmbeddedCase3, error)
+		EmbeddedFieldsDocument           func(ctx context.Context) (*EmbeddedFieldsDocument, error)
 		EnumInInput                      func(ctx context.Context, input *InputWithEnumValue) (EnumTest, error)
+		UnknownColor                     func(ctx context.Context) (UnknownColor, error)
+		UnknownSize                      func(ctx context.Context) (*UnknownSize, error)
+		UnknownSizes                     func(ctx context.Context) ([]UnknownSize, error)
+		UnknownStatus                    func(ctx context.Context) (UnknownStatus, error)
+		FallbackScore                    func(ctx context.Context) (int, error)
+		FallbackLevel                    func(ctx context.Context) (*FallbackLevel, error)
+		FallbackTags                     func(ctx context.Context) ([]string, error)
+		FallbackRecommendations          func(ctx context.Context) ([]*FallbackProduct, error)
+		FallbackBanner                   func(ctx context.Context) (*FallbackProduct, error)
+		FallbackGreeting                 func(ctx context.Context) (string, error)
+		InputDefaultsSearch              func(ctx context.Context, input InputDefaultsSearchInput) (*InputDefaultsSearchResult, error)
+		Linkables                        func(ctx context.Context) ([]Linkable, error)
 		Shapes                           func(ctx context.Context) ([]Shape, error)
 		NoShape                          func(ctx context.Context) (Shape, error)
 		Node                             func(ctx context.Context) (Node, error)
@@ -98,23 +120,31 @@ type Stub struct {
 		Issue896a                        func(ctx context.Context) ([]*CheckIssue896, error)
 		MapStringInterface               func(ctx context.Context, in map[string]interface{}) (map[string]interface{}, error)
 		MapNestedStringInterface         func(ctx context.Context, in *NestedMapInput) (map[string]interface{}, error)
+		MaskedUser                       func(ctx context.Context) (*MaskedUser, error)
 		ErrorBubble                      func(ctx context.Context) (*Error, error)
 		ErrorBubbleList                  func(ctx context.Context) ([]*Error, error)
 		ErrorList                        func(ctx context.Context) ([]*Error, error)
 		Errors                           func(ctx context.Context) (*Errors, error)
 		Valid                            func(ctx context.Context) (string, error)
 		Invalid                          func(ctx context.Context) (string, error)
+		OwnedOrder                       func(ctx context.Context, id string) (*OwnedOrder, error)
 		Panics                           func(ctx context.Context) (*Panics, error)
 		PrimitiveObject                  func(ctx context.Context) ([]Primitive, error)
 		PrimitiveStringObject            func(ctx context.Context) ([]PrimitiveString, error)
 		PtrToAnyContainer                func(ctx context.Context) (*PtrToAnyContainer, error)
 		PtrToSliceContainer              func(ctx context.Context) (*PtrToSliceContainer, error)
+		RepeatableItem                   func(ctx context.Context, id string, filter *RepeatableFilter) (*RepeatableItem, error)
+		RetryFlaky                       func(ctx context.Context, failures int) (int, error)
+		RetryMissing                     func(ctx context.Context) (*int, error)
+		RetryUser                        func(ctx context.Context) (*RetryUser, error)
 		Infinity                         func(ctx context.Context) (float64, error)
 		StringFromContextInterface       func(ctx context.Context) (*StringFromContextInterface, error)
 		StringFromContextFunction        func(ctx context.Context) (string, error)
 		DefaultScalar                    func(ctx context.Context, arg string) (string, error)
 		Slices                           func(ctx context.Context) (*Slices, error)
 		ScalarSlice                      func(ctx context.Context) ([]byte, error)
+		TypedMapProduct                  func(ctx context.Context) (*TypedMapProduct, error)
+		TypedMapStatuses                 func(ctx context.Context, ids []string) (map[string]*TypedMapStatus, error)
 		Fallback                         func(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error)
 		OptionalUnion                    func(ctx context.Context) (TestUnion, error)
 		VOkCaseValue                     func(ctx context.Context) (*VOkCaseValue, error)
@@ -126,15 +156,21 @@ type Stub struct {
 		WrappedMap                       func(ctx context.Context) (WrappedMap, error)
 		WrappedSlice                     func(ctx context.Context) (WrappedSlice, error)
 	}
+	RetryUserResolver struct {
+		Name func(ctx context.Context, obj *RetryUser) (string, error)
+	}
 	SubscriptionResolver struct {
-		Updated                func(ctx context.Context) (<-chan string, error)
-		InitPayload            func(ctx context.Context) (<-chan string, error)
-		DirectiveArg           func(ctx context.Context, arg string) (<-chan *string, error)
-		DirectiveNullableArg   func(ctx context.Context, arg *int, arg2 *int, arg3 *string) (<-chan *string, error)
-		DirectiveDouble        func(ctx context.Context) (<-chan *string, error)
-		DirectiveUnimplemented func(ctx context.Context) (<-chan *string, error)
-		Issue896b              func(ctx context.Context) (<-chan []*CheckIssue896, error)
-		ErrorRequired          func(ctx context.Context) (<-chan *Error, error)
+		Updated                     func(ctx context.Context) (<-chan string, error)
+		InitPayload                 func(ctx context.Context) (<-chan string, error)
+		DirectiveArg                func(ctx context.Context, arg string) (<-chan *string, error)
+		DirectiveNullableArg        func(ctx context.Context, arg *int, arg2 *int, arg3 *string) (<-chan *string, error)
+		DirectiveDouble             func(ctx context.Context) (<-chan *string, error)
+		DirectiveUnimplemented      func(ctx context.Context) (<-chan *string, error)
+		InitialPayloadCounter       func(ctx context.Context) (graphql.InitialPayload[int], error)
+		InitialPayloadLoggedCounter func(ctx context.Context) (graphql.InitialPayload[int], error)
+		InitialPayloadIncrements    func(ctx context.Context) (<-chan int, error)
+		Issue896b                   func(ctx context.Context) (<-chan []*CheckIssue896, error)
+		ErrorRequired               func(ctx context.Context) (<-chan *Error, error)
 	}
 	UserResolver struct {
 		Friends func(ctx context.Context, obj *User) ([]*User, error)
@@ -146,6 +182,9 @@ type Stub struct {
 	WrappedSliceResolver struct {
 		Get func(ctx context.Context, obj WrappedSlice, idx int) (string, error)
 	}
+	LinkableResolver struct {
+		URL func(ctx context.Context, obj Linkable, absolute *bool) (string, error)
+	}
 
 	FieldsOrderInputResolver struct {
 		OverrideFirstField func(ctx context.Context, obj *FieldsOrderInput, data *string) error
@@ -155,6 +194,9 @@ type Stub struct {
 func (r *Stub) BackedByInterface() BackedByInterfaceResolver {
 	return &stubBackedByInterface{r}
 }
+func (r *Stub) CachedUser() CachedUserResolver {
+	return &stubCachedUser{r}
+}
 func (r *Stub) DeferModel() DeferModelResolver {
 	return &stubDeferModel{r}
 }
@@ -188,6 +230,9 @@ func (r *Stub) PrimitiveString() PrimitiveStringResolver {
 func (r *Stub) Query() QueryResolver {
 	return &stubQuery{r}
 }
+func (r *Stub) RetryUser() RetryUserResolver {
+	return &stubRetryUser{r}
+}
 func (r *Stub) Subscription() SubscriptionResolver {
 	return &stubSubscription{r}
 }
@@ -200,6 +245,9 @@ func (r *Stub) WrappedMap() WrappedMapResolver {
 func (r *Stub) WrappedSlice() WrappedSliceResolver {
 	return &stubWrappedSlice{r}
 }
+func (r *Stub) Linkable() LinkableResolver {
+	return &stubLinkable{r}
+}
 
 func (r *Stub) FieldsOrderInput() FieldsOrderInputResolver {
 	return &stubFieldsOrderInput{r}
@@ -211,6 +259,15 @@ func (r *stubBackedByInterface) ID(ctx context.Context, obj BackedByInterface) (
 	return r.BackedByInterfaceResolver.ID(ctx, obj)
 }
 
+type stubCachedUser struct{ *Stub }
+
+func (r *stubCachedUser) Score(ctx context.Context, obj *CachedUser) (int, error) {
+	return r.CachedUserResolver.Score(ctx, obj)
+}
+func (r *stubCachedUser) Posts(ctx context.Context, obj *CachedUser, first int) ([]string, error) {
+	return r.CachedUserResolver.Posts(ctx, obj, first)
+}
+
 type stubDeferModel struct{ *Stub }
 
 func (r *stubDeferModel) Values(ctx context.Context, obj *DeferModel) ([]string, error) {
@@ -261,6 +318,12 @@ func (r *stubMutation) UpdateSomething(ctx context.Context, input SpecialInput)
 func (r *stubMutation) UpdatePtrToPtr(ctx context.Context, input UpdatePtrToPtrOuter) (*PtrToPtrOuter, error) {
 	return r.MutationResolver.UpdatePtrToPtr(ctx, input)
 }
+func (r *stubMutation) CreateTypedMapProduct(ctx context.Context, input TypedMapProductInput) (*TypedMapProduct, error) {
+	return r.MutationResolver.CreateTypedMapProduct(ctx, input)
+}
+func (r *stubMutation) TypedMapRestock(ctx context.Context, stock map[string]int) (map[string]int, error) {
+	return r.MutationResolver.TypedMapRestock(ctx, stock)
+}
 
 type stubOverlappingFields struct{ *Stub }
 
@@ -345,6 +408,12 @@ func (r *stubQuery) Autobind(ctx context.Context) (*Autobind, error) {
 func (r *stubQuery) DeprecatedField(ctx context.Context) (string, error) {
 	return r.QueryResolver.DeprecatedField(ctx)
 }
+func (r *stubQuery) CachedUser(ctx context.Context, id string) (*CachedUser, error) {
+	return r.QueryResolver.CachedUser(ctx, id)
+}
+func (r *stubQuery) CachedCount(ctx context.Context) (int, error) {
+	return r.QueryResolver.CachedCount(ctx)
+}
 func (r *stubQuery) Overlapping(ctx context.Context) (*OverlappingFields, error) {
 	return r.QueryResolver.Overlapping(ctx)
 }
@@ -399,9 +468,48 @@ func (r *stubQuery) EmbeddedCase2(ctx context.Context) (*EmbeddedCase2, error) {
 func (r *stubQuery) EmbeddedCase3(ctx context.Context) (*EmbeddedCase3, error) {
 	return r.QueryResolver.EmbeddedCase3(ctx)
 }
+func (r *stubQuery) EmbeddedFieldsDocument(ctx context.Context) (*EmbeddedFieldsDocument, error) {
+	return r.QueryResolver.EmbeddedFieldsDocument(ctx)
+}
 func (r *stubQuery) EnumInInput(ctx context.Context, input *InputWithEnumValue) (EnumTest, error) {
 	return r.QueryResolver.EnumInInput(ctx, input)
 }
+func (r *stubQuery) UnknownColor(ctx context.Context) (UnknownColor, error) {
+	return r.QueryResolver.UnknownColor(ctx)
+}
+func (r *stubQuery) UnknownSize(ctx context.Context) (*UnknownSize, error) {
+	return r.QueryResolver.UnknownSize(ctx)
+}
+func (r *stubQuery) UnknownSizes(ctx context.Context) ([]UnknownSize, error) {
+	return r.QueryResolver.UnknownSizes(ctx)
+}
+func (r *stubQuery) UnknownStatus(ctx context.Context) (UnknownStatus, error) {
+	return r.QueryResolver.UnknownStatus(ctx)
+}
+func (r *stubQuery) FallbackScore(ctx context.Context) (int, error) {
+	return r.QueryResolver.FallbackScore(ctx)
+}
+func (r *stubQuery) FallbackLevel(ctx context.Context) (*FallbackLevel, error) {
+	return r.QueryResolver.FallbackLevel(ctx)
+}
+func (r *stubQuery) FallbackTags(ctx context.Context) ([]string, error) {
+	return r.QueryResolver.FallbackTags(ctx)
+}
+func (r *stubQuery) FallbackRecommendations(ctx context.Context) ([]*FallbackProduct, error) {
+	return r.QueryResolver.FallbackRecommendations(ctx)
+}
+func (r *stubQuery) FallbackBanner(ctx context.Context) (*FallbackProduct, error) {
+	return r.QueryResolver.FallbackBanner(ctx)
+}
+func (r *stubQuery) FallbackGreeting(ctx context.Context) (string, error) {
+	return r.QueryResolver.FallbackGreeting(ctx)
+}
+func (r *stubQuery) InputDefaultsSearch(ctx context.Context, input InputDefaultsSearchInput) (*InputDefaultsSearchResult, error) {
+	return r.QueryResolver.InputDefaultsSearch(ctx, input)
+}
+func (r *stubQuery) Linkables(ctx context.Context) ([]Linkable, error) {
+	return r.QueryResolver.Linkables(ctx)
+}
 func (r *stubQuery) Shapes(ctx context.Context) ([]Shape, error) {
 	return r.QueryResolver.Shapes(ctx)
 }
@@ -432,6 +540,9 @@ func (r *stubQuery) MapStringInterface(ctx context.Context, in map[string]interf
 func (r *stubQuery) MapNestedStringInterface(ctx context.Context, in *NestedMapInput) (map[string]interface{}, error) {
 	return r.QueryResolver.MapNestedStringInterface(ctx, in)
 }
+func (r *stubQuery) MaskedUser(ctx context.Context) (*MaskedUser, error) {
+	return r.QueryResolver.MaskedUser(ctx)
+}
 func (r *stubQuery) ErrorBubble(ctx context.Context) (*Error, error) {
 	return r.QueryResolver.ErrorBubble(ctx)
 }
@@ -450,6 +561,9 @@ func (r *stubQuery) Valid(ctx context.Context) (string, error) {
 func (r *stubQuery) Invalid(ctx context.Context) (string, error) {
 	return r.QueryResolver.Invalid(ctx)
 }
+func (r *stubQuery) OwnedOrder(ctx context.Context, id string) (*OwnedOrder, error) {
+	return r.QueryResolver.OwnedOrder(ctx, id)
+}
 func (r *stubQuery) Panics(ctx context.Context) (*Panics, error) {
 	return r.QueryResolver.Panics(ctx)
 }
@@ -465,6 +579,18 @@ func (r *stubQuery) PtrToAnyContainer(ctx context.Context) (*PtrToAnyContainer,
 func (r *stubQuery) PtrToSliceContainer(ctx context.Context) (*PtrToSliceContainer, error) {
 	return r.QueryResolver.PtrToSliceContainer(ctx)
 }
+func (r *stubQuery) RepeatableItem(ctx context.Context, id string, filter *RepeatableFilter) (*RepeatableItem, error) {
+	return r.QueryResolver.RepeatableItem(ctx, id, filter)
+}
+func (r *stubQuery) RetryFlaky(ctx context.Context, failures int) (int, error) {
+	return r.QueryResolver.RetryFlaky(ctx, failures)
+}
+func (r *stubQuery) RetryMissing(ctx context.Context) (*int, error) {
+	return r.QueryResolver.RetryMissing(ctx)
+}
+func (r *stubQuery) RetryUser(ctx context.Context) (*RetryUser, error) {
+	return r.QueryResolver.RetryUser(ctx)
+}
 func (r *stubQuery) Infinity(ctx context.Context) (float64, error) {
 	return r.QueryResolver.Infinity(ctx)
 }
@@ -483,6 +609,12 @@ func (r *stubQuery) Slices(ctx context.Context) (*Slices, error) {
 func (r *stubQuery) ScalarSlice(ctx context.Context) ([]byte, error) {
 	return r.QueryResolver.ScalarSlice(ctx)
 }
+func (r *stubQuery) TypedMapProduct(ctx context.Context) (*TypedMapProduct, error) {
+	return r.QueryResolver.TypedMapProduct(ctx)
+}
+func (r *stubQuery) TypedMapStatuses(ctx context.Context, ids []string) (map[string]*TypedMapStatus, error) {
+	return r.QueryResolver.TypedMapStatuses(ctx, ids)
+}
 func (r *stubQuery) Fallback(ctx context.Context, arg FallbackToStringEncoding) (FallbackToStringEncoding, error) {
 	return r.QueryResolver.Fallback(ctx, arg)
 }
@@ -514,6 +646,12 @@ func (r *stubQuery) WrappedSlice(ctx context.Context) (WrappedSlice, error) {
 	return r.QueryResolver.WrappedSlice(ctx)
 }
 
+type stubRetryUser struct{ *Stub }
+
+func (r *stubRetryUser) Name(ctx context.Context, obj *RetryUser) (string, error) {
+	return r.RetryUserResolver.Name(ctx, obj)
+}
+
 type stubSubscription struct{ *Stub }
 
 func (r *stubSubscription) Updated(ctx context.Context) (<-chan string, error) {
@@ -534,6 +672,15 @@ func (r *stubSubscription) DirectiveDouble(ctx context.Context) (<-chan *string,
 func (r *stubSubscription) DirectiveUnimplemented(ctx context.Context) (<-chan *string, error) {
 	return r.SubscriptionResolver.DirectiveUnimplemented(ctx)
 }
+func (r *stubSubscription) InitialPayloadCounter(ctx context.Context) (graphql.InitialPayload[int], error) {
+	return r.SubscriptionResolver.InitialPayloadCounter(ctx)
+}
+func (r *stubSubscription) InitialPayloadLoggedCounter(ctx context.Context) (graphql.InitialPayload[int], error) {
+	return r.SubscriptionResolver.InitialPayloadLoggedCounter(ctx)
+}
+func (r *stubSubscription) InitialPayloadIncrements(ctx context.Context) (<-chan int, error) {
+	return r.SubscriptionResolver.InitialPayloadIncrements(ctx)
+}
 func (r *stubSubscription) Issue896b(ctx context.Context) (<-chan []*CheckIssue896, error) {
 	return r.SubscriptionResolver.Issue896b(ctx)
 }
@@ -562,6 +709,12 @@ func (r *stubWrappedSlice) Get(ctx context.Context, obj WrappedSlice, idx int) (
 	return r.WrappedSliceResolver.Get(ctx, obj, idx)
 }
 
+type stubLinkable struct{ *Stub }
+
+func (r *stubLinkable) URL(ctx context.Context, obj Linkable, absolute *bool) (string, error) {
+	return r.LinkableResolver.URL(ctx, obj, absolute)
+}
+
 type stubFieldsOrderInput struct{ *Stub }
 
 func (r *stubFieldsOrderInput) OverrideFirstField(ctx context.Context, obj *FieldsOrderInput, data *string) error {
@@ -0,0 +1,101 @@
+package singlefile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+)
+
+func TestTypedMaps(t *testing.T) {
+	resolvers := &Stub{}
+	resolvers.QueryResolver.TypedMapProduct = func(ctx context.Context) (*TypedMapProduct, error) {
+		return &TypedMapProduct{
+			Name:  "lamp",
+			Stock: map[string]int{"paris": 3, "berlin": 0},
+		}, nil
+	}
+	resolvers.QueryResolver.TypedMapStatuses = func(ctx context.Context, ids []string) (map[string]*TypedMapStatus, error) {
+		open, shipped := TypedMapStatusOpen, TypedMapStatusShipped
+		res := map[string]*TypedMapStatus{}
+		for _, id := range ids {
+			switch id {
+			case "1":
+				res[id] = &open
+			case "2":
+				res[id] = &shipped
+			default:
+				res[id] = nil
+			}
+		}
+		return res, nil
+	}
+	resolvers.MutationResolver.CreateTypedMapProduct = func(ctx context.Context, input TypedMapProductInput) (*TypedMapProduct, error) {
+		return &TypedMapProduct{Name: input.Name, Stock: input.Stock}, nil
+	}
+	resolvers.MutationResolver.TypedMapRestock = func(ctx context.Context, stock map[string]int) (map[string]int, error) {
+		res := make(map[string]int, len(stock))
+		for k, v := range stock {
+			res[k] = v + 10
+		}
+		return res, nil
+	}
+	c := client.New(handler.NewDefaultServer(NewExecutableSchema(Config{Resolvers: resolvers})))
+
+	t.Run("marshal", func(t *testing.T) {
+		var resp struct {
+			TypedMapProduct struct {
+				Stock  map[string]int
+				Labels map[string]string
+			}
+		}
+		c.MustPost(`{ typedMapProduct { stock labels } }`, &resp)
+		require.Equal(t, map[string]int{"berlin": 0, "paris": 3}, resp.TypedMapProduct.Stock)
+		require.Nil(t, resp.TypedMapProduct.Labels)
+	})
+
+	t.Run("marshal enum values", func(t *testing.T) {
+		var resp struct {
+			TypedMapStatuses map[string]interface{}
+		}
+		c.MustPost(`{ typedMapStatuses(ids: ["1", "2", "3"]) }`, &resp)
+		require.Equal(t, map[string]interface{}{"1": "OPEN", "2": "SHIPPED", "3": nil}, resp.TypedMapStatuses)
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var resp struct {
+			TypedMapRestock map[string]int
+		}
+		c.MustPost(`mutation($stock: TypedMapStock!) { typedMapRestock(stock: $stock) }`, &resp, client.Var("stock", map[string]interface{}{"paris": 1}))
+		require.Equal(t, map[string]int{"paris": 11}, resp.TypedMapRestock)
+	})
+
+	t.Run("unmarshal literal in an input", func(t *testing.T) {
+		var resp struct {
+			CreateTypedMapProduct struct {
+				Stock map[string]int
+			}
+		}
+		c.MustPost(`mutation { createTypedMapProduct(input: {name: "desk", stock: {lyon: 2}}) { stock } }`, &resp)
+		require.Equal(t, map[string]int{"lyon": 2}, resp.CreateTypedMapProduct.Stock)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		var resp struct {
+			TypedMapRestock map[string]int
+		}
+		err := c.Post(`mutation($stock: TypedMapStock!) { typedMapRestock(stock: $stock) }`, &resp, client.Var("stock", map[string]interface{}{"paris": true}))
+		require.EqualError(t, err, `[{"message":"bool is not an int","path":["typedMapRestock","stock","paris"]}]`)
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		var resp struct {
+			TypedMapRestock map[string]int
+		}
+		err := c.Post(`mutation($stock: TypedMapStock!) { typedMapRestock(stock: $stock) }`, &resp, client.Var("stock", []int{1}))
+		require.ErrorContains(t, err, "is not a map")
+	})
+}
@@ -1,6 +1,9 @@
 package complexity
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -12,16 +15,46 @@ func Calculate(es graphql.ExecutableSchema, op *ast.OperationDefinition, vars ma
 		schema: es.Schema(),
 		vars:   vars,
 	}
-	return walker.selectionSetComplexity(op.SelectionSet)
+	return walker.selectionSetComplexity(op.SelectionSet, nil)
+}
+
+// FieldCost is the complexity of a field selected by an operation, see Breakdown.
+type FieldCost struct {
+	// Path holds the response keys of the field and its parents, joined by dots.
+	Path string `json:"path"`
+	// Field is the field as Type.field.
+	Field string `json:"field"`
+	// Complexity is the complexity of the field and its selections.
+	Complexity int `json:"complexity"`
+	// Cost is the complexity the field adds to the complexity of its selections.
+	Cost int `json:"cost"`
+}
+
+// Breakdown calculates the complexity of op like Calculate, along with the complexity of each field it selects, the
+// fields adding the most first.
+func Breakdown(es graphql.ExecutableSchema, op *ast.OperationDefinition, vars map[string]interface{}) (int, []FieldCost) {
+	var costs []FieldCost
+	walker := complexityWalker{
+		es:     es,
+		schema: es.Schema(),
+		vars:   vars,
+		costs:  &costs,
+	}
+	total := walker.selectionSetComplexity(op.SelectionSet, nil)
+	sort.SliceStable(costs, func(i, j int) bool {
+		return costs[i].Cost > costs[j].Cost
+	})
+	return total, costs
 }
 
 type complexityWalker struct {
 	es     graphql.ExecutableSchema
 	schema *ast.Schema
 	vars   map[string]interface{}
+	costs  *[]FieldCost // the cost of every field is recorded when set
 }
 
-func (cw complexityWalker) selectionSetComplexity(selectionSet ast.SelectionSet) int {
+func (cw complexityWalker) selectionSetComplexity(selectionSet ast.SelectionSet, path []string) int {
 	var complexity int
 	for _, selection := range selectionSet {
 		switch s := selection.(type) {
@@ -32,10 +65,15 @@ func (cw complexityWalker) selectionSetComplexity(selectionSet ast.SelectionSet)
 				continue
 			}
 
+			var fieldPath []string
+			if cw.costs != nil {
+				fieldPath = append(append(make([]string, 0, len(path)+1), path...), s.Alias)
+			}
+
 			var childComplexity int
 			switch fieldDefinition.Kind {
 			case ast.Object, ast.Interface, ast.Union:
-				childComplexity = cw.selectionSetComplexity(s.SelectionSet)
+				childComplexity = cw.selectionSetComplexity(s.SelectionSet, fieldPath)
 			}
 
 			args := s.ArgumentMap(cw.vars)
@@ -47,11 +85,20 @@ func (cw complexityWalker) selectionSetComplexity(selectionSet ast.SelectionSet)
 			}
 			complexity = safeAdd(complexity, fieldComplexity)
 
+			if cw.costs != nil {
+				*cw.costs = append(*cw.costs, FieldCost{
+					Path:       strings.Join(fieldPath, "."),
+					Field:      s.ObjectDefinition.Name + "." + s.Name,
+					Complexity: fieldComplexity,
+					Cost:       fieldComplexity - childComplexity,
+				})
+			}
+
 		case *ast.FragmentSpread:
-			complexity = safeAdd(complexity, cw.selectionSetComplexity(s.Definition.SelectionSet))
+			complexity = safeAdd(complexity, cw.selectionSetComplexity(s.Definition.SelectionSet, path))
 
 		case *ast.InlineFragment:
-			complexity = safeAdd(complexity, cw.selectionSetComplexity(s.SelectionSet))
+			complexity = safeAdd(complexity, cw.selectionSetComplexity(s.SelectionSet, path))
 		}
 	}
 	return complexity
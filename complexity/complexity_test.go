@@ -51,7 +51,14 @@ func requireComplexity(t *testing.T, source string, complexity int) {
 	t.Helper()
 	query := gqlparser.MustLoadQuery(schema, source)
 
-	es := &graphql.ExecutableSchemaMock{
+	es := testExecutableSchema()
+
+	actualComplexity := Calculate(es, query.Operations[0], nil)
+	require.Equal(t, complexity, actualComplexity)
+}
+
+func testExecutableSchema() *graphql.ExecutableSchemaMock {
+	return &graphql.ExecutableSchemaMock{
 		ComplexityFunc: func(typeName, field string, childComplexity int, args map[string]interface{}) (int, bool) {
 			switch typeName + "." + field {
 			case "ExpensiveItem.name":
@@ -67,9 +74,6 @@ func requireComplexity(t *testing.T, source string, complexity int) {
 			return schema
 		},
 	}
-
-	actualComplexity := Calculate(es, query.Operations[0], nil)
-	require.Equal(t, complexity, actualComplexity)
 }
 
 func TestCalculate(t *testing.T) {
@@ -214,3 +218,28 @@ func TestCalculate(t *testing.T) {
 		requireComplexity(t, query, math.MaxInt64)
 	})
 }
+
+func TestBreakdown(t *testing.T) {
+	query := gqlparser.MustLoadQuery(schema, `
+	{
+		scalar
+		items: list(size: 3) {
+			name
+			list(size: 2) { scalar }
+		}
+		interface { ... on Item { scalar } }
+	}
+	`)
+
+	total, costs := Breakdown(testExecutableSchema(), query.Operations[0], nil)
+	require.Equal(t, Calculate(testExecutableSchema(), query.Operations[0], nil), total)
+	require.Equal(t, []FieldCost{
+		{Path: "items", Field: "Query.list", Complexity: 9, Cost: 6},
+		{Path: "scalar", Field: "Query.scalar", Complexity: 1, Cost: 1},
+		{Path: "items.name", Field: "Item.name", Complexity: 1, Cost: 1},
+		{Path: "items.list.scalar", Field: "Item.scalar", Complexity: 1, Cost: 1},
+		{Path: "items.list", Field: "Item.list", Complexity: 2, Cost: 1},
+		{Path: "interface.scalar", Field: "Item.scalar", Complexity: 1, Cost: 1},
+		{Path: "interface", Field: "Query.interface", Complexity: 2, Cost: 1},
+	}, costs)
+}
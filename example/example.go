@@ -0,0 +1,261 @@
+// Package example generates example responses for operations from the schema alone, to document operations, seed
+// contract tests and answer mock servers.
+//
+// Values come from, in order:
+//   - the @example(value:) directive, or an "Example:" line in the description, of the field
+//   - the @example directive, or an "Example:" line in the description, of the scalar or enum type of the field
+//   - Options.Scalars
+//   - a default for the type: "string", "1", 1, 1.5 and true for the built-in scalars, the first value of enums and the
+//     name of the scalar for custom scalars
+//
+// Example values are strings, parsed for Int, Float and Boolean fields, and checked against the values of enums.
+// Interfaces and unions are answered with the first of their possible types by name, lists with Options.ListLength
+// items and nullable fields are never null.
+package example
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Directive declares @example, add it to schemas using it.
+const Directive = `directive @example(value: String!) on FIELD_DEFINITION | SCALAR | ENUM`
+
+type Options struct {
+	// ListLength is the number of items of lists, 1 when zero.
+	ListLength int
+
+	// Scalars are the values of scalars, by name, without an example.
+	Scalars map[string]interface{}
+
+	// Variables of the operation, used by @skip and @include.
+	Variables map[string]interface{}
+}
+
+// Response parses and validates query against schema, and returns an example response to its operation named
+// operationName, or its only operation when operationName is empty.
+func Response(schema *ast.Schema, query, operationName string, opts Options) (*graphql.Response, error) {
+	doc, errs := gqlparser.LoadQuery(schema, query)
+	if errs != nil {
+		return nil, errs
+	}
+	data, err := Data(schema, doc, operationName, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &graphql.Response{Data: data}, nil
+}
+
+// Data returns example data for the operation named operationName of doc, or its only operation when operationName is
+// empty. doc must have been validated against schema.
+func Data(schema *ast.Schema, doc *ast.QueryDocument, operationName string, opts Options) (json.RawMessage, error) {
+	op := doc.Operations.ForName(operationName)
+	if op == nil {
+		if operationName == "" {
+			return nil, fmt.Errorf("operation name is required when the document has several operations")
+		}
+		return nil, fmt.Errorf("operation %s not found", operationName)
+	}
+	root := schema.Query
+	switch op.Operation {
+	case ast.Mutation:
+		root = schema.Mutation
+	case ast.Subscription:
+		root = schema.Subscription
+	}
+	if root == nil {
+		return nil, fmt.Errorf("schema does not support %s operations", op.Operation)
+	}
+
+	if opts.ListLength <= 0 {
+		opts.ListLength = 1
+	}
+	g := &generator{
+		schema: schema,
+		opts:   opts,
+		rc:     &graphql.OperationContext{Doc: doc, Variables: opts.Variables},
+	}
+	if err := g.object(root, op.SelectionSet); err != nil {
+		return nil, err
+	}
+	return g.buf.Bytes(), nil
+}
+
+type generator struct {
+	schema *ast.Schema
+	opts   Options
+	rc     *graphql.OperationContext
+	buf    bytes.Buffer
+}
+
+func (g *generator) object(def *ast.Definition, set ast.SelectionSet) error {
+	satisfies := []string{def.Name}
+	for _, impl := range g.schema.GetImplements(def) {
+		satisfies = append(satisfies, impl.Name)
+	}
+
+	g.buf.WriteByte('{')
+	for i, f := range graphql.CollectFields(g.rc, set, satisfies) {
+		if i > 0 {
+			g.buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(f.Alias)
+		g.buf.Write(key)
+		g.buf.WriteByte(':')
+
+		if f.Name == "__typename" {
+			name, _ := json.Marshal(def.Name)
+			g.buf.Write(name)
+			continue
+		}
+		field := def.Fields.ForName(f.Name)
+		if field == nil {
+			return fmt.Errorf("%s.%s is not defined", def.Name, f.Name)
+		}
+		if err := g.value(field, field.Type, f.Selections); err != nil {
+			return err
+		}
+	}
+	g.buf.WriteByte('}')
+	return nil
+}
+
+func (g *generator) value(field *ast.FieldDefinition, typ *ast.Type, set ast.SelectionSet) error {
+	if typ.Elem != nil {
+		g.buf.WriteByte('[')
+		for i := 0; i < g.opts.ListLength; i++ {
+			if i > 0 {
+				g.buf.WriteByte(',')
+			}
+			if err := g.value(field, typ.Elem, set); err != nil {
+				return err
+			}
+		}
+		g.buf.WriteByte(']')
+		return nil
+	}
+
+	def := g.schema.Types[typ.NamedType]
+	if def == nil {
+		return fmt.Errorf("type %s of %s is not defined", typ.NamedType, field.Name)
+	}
+	switch def.Kind {
+	case ast.Object:
+		return g.object(def, set)
+	case ast.Interface, ast.Union:
+		possible := append([]*ast.Definition{}, g.schema.GetPossibleTypes(def)...)
+		if len(possible) == 0 {
+			return fmt.Errorf("%s has no possible types", def.Name)
+		}
+		sort.Slice(possible, func(i, j int) bool {
+			return possible[i].Name < possible[j].Name
+		})
+		return g.object(possible[0], set)
+	}
+
+	v, err := g.leaf(field, def)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("%s: %w", field.Name, err)
+	}
+	g.buf.Write(b)
+	return nil
+}
+
+func (g *generator) leaf(field *ast.FieldDefinition, def *ast.Definition) (interface{}, error) {
+	if raw, ok := exampleOf(field.Directives, field.Description); ok {
+		v, err := parse(def, raw)
+		if err != nil {
+			return nil, fmt.Errorf("example of %s: %w", field.Name, err)
+		}
+		return v, nil
+	}
+	if raw, ok := exampleOf(def.Directives, def.Description); ok {
+		v, err := parse(def, raw)
+		if err != nil {
+			return nil, fmt.Errorf("example of %s: %w", def.Name, err)
+		}
+		return v, nil
+	}
+	if v, ok := g.opts.Scalars[def.Name]; ok {
+		return v, nil
+	}
+
+	if def.Kind == ast.Enum {
+		if len(def.EnumValues) == 0 {
+			return nil, fmt.Errorf("%s has no values", def.Name)
+		}
+		return def.EnumValues[0].Name, nil
+	}
+	switch def.Name {
+	case "String":
+		return "string", nil
+	case "ID":
+		return "1", nil
+	case "Int":
+		return 1, nil
+	case "Float":
+		return 1.5, nil
+	case "Boolean":
+		return true, nil
+	}
+	return def.Name, nil
+}
+
+// exampleOf returns the value of the @example directive, or else of the first line of the description starting with
+// "Example:".
+func exampleOf(directives ast.DirectiveList, description string) (string, bool) {
+	if d := directives.ForName("example"); d != nil {
+		if arg := d.Arguments.ForName("value"); arg != nil && arg.Value != nil {
+			return arg.Value.Raw, true
+		}
+	}
+	for _, line := range strings.Split(description, "\n") {
+		if v, ok := strings.CutPrefix(strings.TrimSpace(line), "Example:"); ok {
+			return strings.TrimSpace(v), true
+		}
+	}
+	return "", false
+}
+
+// parse converts an example to a value of def.
+func parse(def *ast.Definition, raw string) (interface{}, error) {
+	switch {
+	case def.Kind == ast.Enum:
+		if def.EnumValues.ForName(raw) == nil {
+			return nil, fmt.Errorf("%q is not a value of %s", raw, def.Name)
+		}
+		return raw, nil
+	case def.Name == "Int":
+		v, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an Int", raw)
+		}
+		return v, nil
+	case def.Name == "Float":
+		v, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a Float", raw)
+		}
+		return v, nil
+	case def.Name == "Boolean":
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a Boolean", raw)
+		}
+		return v, nil
+	}
+	return raw, nil
+}
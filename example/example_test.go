@@ -0,0 +1,94 @@
+package example
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var schema = gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: Directive + `
+	type Query {
+		user(id: ID!): User
+		search: [Result!]!
+		node: Node
+	}
+	interface Node { id: ID! }
+	type User implements Node {
+		id: ID!
+		name: String! @example(value: "Ada Lovelace")
+		"""
+		The age in years.
+		Example: 36
+		"""
+		age: Int
+		score: Float!
+		admin: Boolean!
+		status: Status!
+		createdAt: Time!
+		tags: [String!]!
+	}
+	type Post implements Node {
+		id: ID!
+		title: String!
+	}
+	union Result = User | Post
+	enum Status { ACTIVE DISABLED }
+	scalar Time @example(value: "2024-01-01T00:00:00Z")
+	scalar Map
+	type Mutation {
+		deleteUser(id: ID!): Boolean! @example(value: "false")
+		settings: Map
+	}
+`})
+
+func TestResponse(t *testing.T) {
+	t.Run("uses examples and defaults", func(t *testing.T) {
+		resp, err := Response(schema, `{
+			user(id: 1) { __typename id name age score admin status createdAt tags }
+		}`, "", Options{})
+		require.NoError(t, err)
+		assert.Equal(t, `{"user":{"__typename":"User","id":"1","name":"Ada Lovelace","age":36,"score":1.5,"admin":true,"status":"ACTIVE","createdAt":"2024-01-01T00:00:00Z","tags":["string"]}}`, string(resp.Data))
+	})
+
+	t.Run("answers abstract types with their first possible type", func(t *testing.T) {
+		resp, err := Response(schema, `{
+			search { __typename ... on User { name } ... on Post { title } }
+			node { id ... on Post { title } }
+		}`, "", Options{ListLength: 2})
+		require.NoError(t, err)
+		assert.Equal(t, `{"search":[{"__typename":"Post","title":"string"},{"__typename":"Post","title":"string"}],"node":{"id":"1","title":"string"}}`, string(resp.Data))
+	})
+
+	t.Run("keeps aliases and skipped fields out", func(t *testing.T) {
+		resp, err := Response(schema, `query Q($skip: Boolean!) {
+			first: user(id: 1) { name }
+			user(id: 2) { id name @skip(if: $skip) }
+		}`, "Q", Options{Variables: map[string]interface{}{"skip": true}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"first":{"name":"Ada Lovelace"},"user":{"id":"1"}}`, string(resp.Data))
+	})
+
+	t.Run("uses scalar options", func(t *testing.T) {
+		resp, err := Response(schema, `mutation { deleteUser(id: 1) settings }`, "", Options{
+			Scalars: map[string]interface{}{"Map": map[string]interface{}{"theme": "dark"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `{"deleteUser":false,"settings":{"theme":"dark"}}`, string(resp.Data))
+	})
+
+	t.Run("returns validation errors", func(t *testing.T) {
+		_, err := Response(schema, `{ unknown }`, "", Options{})
+		require.ErrorContains(t, err, `Cannot query field "unknown" on type "Query"`)
+	})
+
+	t.Run("returns invalid examples", func(t *testing.T) {
+		invalid := gqlparser.MustLoadSchema(&ast.Source{Input: Directive + `
+			type Query { count: Int! @example(value: "many") }
+		`})
+		_, err := Response(invalid, `{ count }`, "", Options{})
+		require.EqualError(t, err, `example of count: "many" is not an Int`)
+	})
+}
@@ -0,0 +1,90 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// CircuitBreaker runs the calls to a dependency, and fails them without running them while the dependency is
+// unhealthy. *gobreaker.CircuitBreaker of github.com/sony/gobreaker implements it.
+type CircuitBreaker interface {
+	Execute(req func() (interface{}, error)) (interface{}, error)
+}
+
+// CircuitBreakers holds a CircuitBreaker per dependency, created by New the first time the dependency is called. See
+// extension.CircuitBreaking.
+type CircuitBreakers struct {
+	New func(dependency string) CircuitBreaker
+
+	mu       sync.Mutex
+	breakers map[string]CircuitBreaker
+}
+
+// Get returns the breaker of dependency.
+func (b *CircuitBreakers) Get(dependency string) CircuitBreaker {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if cb, ok := b.breakers[dependency]; ok {
+		return cb
+	}
+	if b.breakers == nil {
+		b.breakers = map[string]CircuitBreaker{}
+	}
+	cb := b.New(dependency)
+	b.breakers[dependency] = cb
+	return cb
+}
+
+// DependencyUnavailableError is the error of calls a CircuitBreaker failed without running them. It is wrapped in a
+// *gqlerror.Error with the DEPENDENCY_UNAVAILABLE code and the name of the dependency in the "dependency" extension.
+type DependencyUnavailableError struct {
+	Dependency string
+	Err        error // The error returned by the breaker
+}
+
+func (e *DependencyUnavailableError) Error() string {
+	return fmt.Sprintf("%s is unavailable: %v", e.Dependency, e.Err)
+}
+
+func (e *DependencyUnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// WithDependency calls fn through the CircuitBreaker of dependency from the operation, or directly for operations
+// without breakers. Errors of fn are returned as is, calls failed by the breaker return a DependencyUnavailableError,
+// so only the fields relying on an unhealthy dependency are null in the response. The call is recorded as a fetch of
+// dependency, see RecordFetch.
+func WithDependency[T any](ctx context.Context, dependency string, fn func(ctx context.Context) (T, error)) (T, error) {
+	RecordFetch(ctx, dependency)
+
+	var breakers *CircuitBreakers
+	if HasOperationContext(ctx) {
+		breakers = GetOperationContext(ctx).CircuitBreakers
+	}
+	if breakers == nil || breakers.New == nil {
+		return fn(ctx)
+	}
+
+	called := false
+	res, err := breakers.Get(dependency).Execute(func() (interface{}, error) {
+		called = true
+		return fn(ctx)
+	})
+	v, _ := res.(T)
+	if err == nil || called {
+		return v, err
+	}
+	unavailable := &DependencyUnavailableError{Dependency: dependency, Err: err}
+	gqlErr := &gqlerror.Error{
+		Err:        unavailable,
+		Message:    unavailable.Error(),
+		Extensions: map[string]interface{}{"dependency": dependency},
+	}
+	errcode.Set(gqlErr, errcode.DependencyUnavailable)
+	return v, gqlErr
+}
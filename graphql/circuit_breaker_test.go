@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// openBreaker fails calls while open is true.
+type openBreaker struct{ open bool }
+
+func (b *openBreaker) Execute(req func() (interface{}, error)) (interface{}, error) {
+	if b.open {
+		return nil, errors.New("circuit breaker is open")
+	}
+	return req()
+}
+
+func TestWithDependency(t *testing.T) {
+	fetch := func(ctx context.Context) (string, error) { return "rate", nil }
+	failing := errors.New("timeout")
+
+	t.Run("calls fn without breakers", func(t *testing.T) {
+		v, err := WithDependency(context.Background(), "rates", fetch)
+		require.NoError(t, err)
+		require.Equal(t, "rate", v)
+	})
+
+	created := map[string]*openBreaker{}
+	ctx := WithOperationContext(context.Background(), &OperationContext{
+		CircuitBreakers: &CircuitBreakers{New: func(dependency string) CircuitBreaker {
+			created[dependency] = &openBreaker{}
+			return created[dependency]
+		}},
+	})
+
+	t.Run("calls fn through the breaker of the dependency", func(t *testing.T) {
+		v, err := WithDependency(ctx, "rates", fetch)
+		require.NoError(t, err)
+		require.Equal(t, "rate", v)
+
+		_, err = WithDependency(ctx, "rates", func(ctx context.Context) (string, error) { return "", failing })
+		require.Equal(t, failing, err)
+		require.Len(t, created, 1)
+	})
+
+	t.Run("fails calls to unhealthy dependencies", func(t *testing.T) {
+		created["rates"].open = true
+		v, err := WithDependency(ctx, "rates", fetch)
+		require.Equal(t, "", v)
+		require.EqualError(t, err, "input: rates is unavailable: circuit breaker is open")
+
+		var unavailable *DependencyUnavailableError
+		require.ErrorAs(t, err, &unavailable)
+		require.Equal(t, "rates", unavailable.Dependency)
+		var gqlErr *gqlerror.Error
+		require.ErrorAs(t, err, &gqlErr)
+		require.Equal(t, map[string]interface{}{"code": errcode.DependencyUnavailable, "dependency": "rates"}, gqlErr.Extensions)
+
+		v, err = WithDependency(ctx, "stock", fetch)
+		require.NoError(t, err)
+		require.Equal(t, "rate", v)
+	})
+}
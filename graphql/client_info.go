@@ -0,0 +1,21 @@
+package graphql
+
+import "context"
+
+// ClientInfo identifies the client that sent an operation. It is set on the operation context by
+// extension.ClientIdentity, for metrics, usage reporting and rate limiting extensions to tell clients apart.
+type ClientInfo struct {
+	Name    string
+	Version string
+	// Attributes holds anything else known about the client, such as claims of the token it authenticated with.
+	Attributes map[string]string
+}
+
+// GetClientInfo returns the client of the current operation, which is empty outside of an operation or when no
+// extension identified the client.
+func GetClientInfo(ctx context.Context) ClientInfo {
+	if !HasOperationContext(ctx) {
+		return ClientInfo{}
+	}
+	return GetOperationContext(ctx).ClientInfo
+}
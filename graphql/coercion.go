@@ -2,8 +2,34 @@ package graphql
 
 import (
 	"encoding/json"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/validator"
 )
 
+// CoerceInput validates v against the input type typeName of schema and coerces it, as a non-null variable of that
+// type is before execution. Errors have the path of the invalid value below the variable "input".
+func CoerceInput(schema *ast.Schema, typeName string, v interface{}) (interface{}, error) {
+	op := &ast.OperationDefinition{
+		Operation: ast.Query,
+		VariableDefinitions: ast.VariableDefinitionList{{
+			Variable:   "input",
+			Type:       ast.NonNullNamedType(typeName, nil),
+			Definition: schema.Types[typeName],
+		}},
+	}
+	if op.VariableDefinitions[0].Definition == nil {
+		return nil, fmt.Errorf("%s is not a type of the schema", typeName)
+	}
+
+	vars, err := validator.VariableValues(schema, op, map[string]interface{}{"input": v})
+	if err != nil {
+		return nil, err
+	}
+	return vars["input"], nil
+}
+
 // CoerceList applies coercion from a single value to a list.
 func CoerceList(v interface{}) []interface{} {
 	var vSlice []interface{}
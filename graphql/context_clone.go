@@ -0,0 +1,23 @@
+package graphql
+
+import "context"
+
+// ContextCloner returns ctx with fresh copies of the values that must not be shared between the executions of a
+// stream, like loggers scoped to an execution or dataloaders caching what they load.
+//
+// Operations run with the context of the request that started them, with the values set by HTTP middleware, whatever
+// the transport. Websocket operations use the context returned by the InitFunc of the connection instead. Subscription
+// events and deferred fragments are executed separately from the operation: they are given the context of the
+// operation passed through the cloners, once per event and once per deferred fragment.
+type ContextCloner func(ctx context.Context) context.Context
+
+// CloneContext passes ctx through the context cloners of its operation, in the order they were added.
+func CloneContext(ctx context.Context) context.Context {
+	if !HasOperationContext(ctx) {
+		return ctx
+	}
+	for _, clone := range GetOperationContext(ctx).ContextCloners {
+		ctx = clone(ctx)
+	}
+	return ctx
+}
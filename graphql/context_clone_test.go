@@ -0,0 +1,30 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneContext(t *testing.T) {
+	type ctxKey struct{}
+	rc := &OperationContext{
+		ContextCloners: []ContextCloner{
+			func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, ctxKey{}, "first")
+			},
+			func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, ctxKey{}, ctx.Value(ctxKey{}).(string)+" second")
+			},
+		},
+	}
+
+	require.Nil(t, CloneContext(context.Background()).Value(ctxKey{}))
+
+	ctx := WithOperationContext(context.Background(), rc)
+	require.Equal(t, "first second", CloneContext(ctx).Value(ctxKey{}))
+
+	ctx = WithResponseContext(ctx, DefaultErrorPresenter, nil)
+	require.Equal(t, "first second", WithFreshResponseContext(ctx).Value(ctxKey{}))
+}
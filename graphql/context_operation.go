@@ -18,12 +18,20 @@ type OperationContext struct {
 	OperationName string
 	Doc           *ast.QueryDocument
 	Headers       http.Header
+	RequestID     string     // set by extension.RequestID, see RequestID
+	ClientInfo    ClientInfo // set by extension.ClientIdentity, see GetClientInfo
 
 	Operation              *ast.OperationDefinition
 	DisableIntrospection   bool
 	RecoverFunc            RecoverFunc
 	ResolverMiddleware     FieldMiddleware
 	RootResolverMiddleware RootFieldMiddleware
+	ContextCloners         []ContextCloner  // see CloneContext
+	Masking                *FieldMasking    // set by extension.Masking, see MaskField
+	FieldCache             *FieldCache      // set by extension.FieldCaching, see CachedField
+	CircuitBreakers        *CircuitBreakers // set by extension.CircuitBreaking, see WithDependency
+	FetchTracker           *FetchTracker    // set by extension.NPlusOneDetection, see RecordFetch
+	Hedging                *HedgeScheduler  // set by the executor, see Hedge
 
 	Stats Stats
 }
@@ -77,6 +85,14 @@ func HasOperationContext(ctx context.Context) bool {
 	return ok && val != nil
 }
 
+// RequestID returns the id given to the current operation by extension.RequestID, or an empty string.
+func RequestID(ctx context.Context) string {
+	if !HasOperationContext(ctx) {
+		return ""
+	}
+	return GetOperationContext(ctx).RequestID
+}
+
 // This is just a convenient wrapper method for CollectFields
 func CollectFieldsCtx(ctx context.Context, satisfies []string) []CollectedField {
 	resctx := GetFieldContext(ctx)
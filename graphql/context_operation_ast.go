@@ -0,0 +1,144 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// SelectedField is a field selected by an operation, see OperationContext.Selections.
+type SelectedField struct {
+	*ast.Field
+
+	// Path holds the response keys leading to the field, its own included, without list indexes.
+	Path []string
+}
+
+// Selections returns the fields selected by the operation with its fragments expanded, parents before their children
+// in the order of the document. Fields skipped by @skip or @include are left out, fields selected several times under
+// the same response key are returned each time. The type the field is selected on is the ObjectDefinition of the field,
+// which is an interface or union for fields of abstract types.
+func (c *OperationContext) Selections() []SelectedField {
+	if c.Operation == nil {
+		return nil
+	}
+	var fields []SelectedField
+	c.walkSelections(c.Operation.SelectionSet, nil, map[string]bool{}, func(f *ast.Field, path []string) {
+		fields = append(fields, SelectedField{Field: f, Path: path})
+	})
+	return fields
+}
+
+// UsedFragments returns the fragments spread by the operation, directly or through other fragments, once each in the
+// order they are first spread. Fragments spread under a @skip or @include leaving them out are returned as well.
+func (c *OperationContext) UsedFragments() []*ast.FragmentDefinition {
+	if c.Operation == nil || c.Doc == nil {
+		return nil
+	}
+	var used []*ast.FragmentDefinition
+	seen := map[string]bool{}
+	var walk func(set ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				walk(sel.SelectionSet)
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				if seen[sel.Name] {
+					continue
+				}
+				seen[sel.Name] = true
+				if fragment := c.Doc.Fragments.ForName(sel.Name); fragment != nil {
+					used = append(used, fragment)
+					walk(fragment.SelectionSet)
+				}
+			}
+		}
+	}
+	walk(c.Operation.SelectionSet)
+	return used
+}
+
+func (c *OperationContext) walkSelections(set ast.SelectionSet, path []string, spreading map[string]bool, visit func(f *ast.Field, path []string)) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if !shouldIncludeNode(sel.Directives, c.Variables) {
+				continue
+			}
+			fieldPath := append(append(make([]string, 0, len(path)+1), path...), sel.Alias)
+			visit(sel, fieldPath)
+			c.walkSelections(sel.SelectionSet, fieldPath, spreading, visit)
+		case *ast.InlineFragment:
+			if shouldIncludeNode(sel.Directives, c.Variables) {
+				c.walkSelections(sel.SelectionSet, path, spreading, visit)
+			}
+		case *ast.FragmentSpread:
+			if spreading[sel.Name] || !shouldIncludeNode(sel.Directives, c.Variables) || c.Doc == nil {
+				continue
+			}
+			if fragment := c.Doc.Fragments.ForName(sel.Name); fragment != nil {
+				spreading[sel.Name] = true
+				c.walkSelections(fragment.SelectionSet, path, spreading, visit)
+				delete(spreading, sel.Name)
+			}
+		}
+	}
+}
+
+// VariableString returns the variable name converted to a string like String arguments are, ok is false when it is
+// missing, null or can't be converted.
+func (c *OperationContext) VariableString(name string) (value string, ok bool) {
+	return variableAs(c.Variables, name, UnmarshalString)
+}
+
+// VariableInt returns the variable name converted to an integer like Int arguments are, ok is false when it is
+// missing, null or can't be converted.
+func (c *OperationContext) VariableInt(name string) (value int64, ok bool) {
+	return variableAs(c.Variables, name, UnmarshalInt64)
+}
+
+// VariableFloat returns the variable name converted to a float like Float arguments are, ok is false when it is
+// missing, null or can't be converted.
+func (c *OperationContext) VariableFloat(name string) (value float64, ok bool) {
+	return variableAs(c.Variables, name, UnmarshalFloat)
+}
+
+// VariableBool returns the variable name converted to a boolean like Boolean arguments are, ok is false when it is
+// missing, null or can't be converted.
+func (c *OperationContext) VariableBool(name string) (value bool, ok bool) {
+	return variableAs(c.Variables, name, UnmarshalBoolean)
+}
+
+// DecodeVariable decodes the variable name into v, like encoding/json does, for input objects and lists. A missing
+// variable is an error, null leaves v as is.
+func (c *OperationContext) DecodeVariable(name string, v interface{}) error {
+	value, ok := c.Variables[name]
+	if !ok {
+		return fmt.Errorf("variable %s is not set", name)
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("variable %s: %w", name, err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("variable %s: %w", name, err)
+	}
+	return nil
+}
+
+func variableAs[T any](variables map[string]interface{}, name string, unmarshal func(interface{}) (T, error)) (T, bool) {
+	var zero T
+	value, ok := variables[name]
+	if !ok || value == nil {
+		return zero, false
+	}
+	v, err := unmarshal(value)
+	if err != nil {
+		return zero, false
+	}
+	return v, true
+}
@@ -0,0 +1,86 @@
+package graphql
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestOperationContextAST(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { user(id: ID!): User node: Node }
+		interface Node { id: ID! }
+		type User implements Node { id: ID! name: String! friends: [User!]! }
+	`})
+	doc := gqlparser.MustLoadQuery(schema, `
+		query Q($skip: Boolean!) {
+			me: user(id: 1) { ...UserFields friends { ...UserFields } }
+			node { id ... on User { name @skip(if: $skip) } }
+			user(id: 2) @include(if: false) { ...Friends }
+		}
+		fragment UserFields on User { id name }
+		fragment Friends on User { friends { ...UserFields } }
+		fragment Other on User { id }
+		query O { user(id: 3) { ...Other } }
+	`)
+	rc := &OperationContext{
+		Doc:       doc,
+		Operation: doc.Operations.ForName("Q"),
+		Variables: map[string]interface{}{
+			"skip":   true,
+			"count":  json.Number("3"),
+			"name":   "ada",
+			"filter": map[string]interface{}{"names": []interface{}{"a", "b"}},
+		},
+	}
+
+	t.Run("selections", func(t *testing.T) {
+		var selected []string
+		for _, f := range rc.Selections() {
+			selected = append(selected, f.ObjectDefinition.Name+"."+f.Name+" at "+strings.Join(f.Path, "."))
+		}
+		require.Equal(t, []string{
+			"Query.user at me",
+			"User.id at me.id",
+			"User.name at me.name",
+			"User.friends at me.friends",
+			"User.id at me.friends.id",
+			"User.name at me.friends.name",
+			"Query.node at node",
+			"Node.id at node.id",
+		}, selected)
+	})
+
+	t.Run("used fragments", func(t *testing.T) {
+		var names []string
+		for _, f := range rc.UsedFragments() {
+			names = append(names, f.Name)
+		}
+		require.Equal(t, []string{"UserFields", "Friends"}, names)
+	})
+
+	t.Run("variables", func(t *testing.T) {
+		count, ok := rc.VariableInt("count")
+		require.True(t, ok)
+		require.EqualValues(t, 3, count)
+		name, ok := rc.VariableString("name")
+		require.True(t, ok)
+		require.Equal(t, "ada", name)
+		skip, ok := rc.VariableBool("skip")
+		require.True(t, ok)
+		require.True(t, skip)
+		_, ok = rc.VariableFloat("missing")
+		require.False(t, ok)
+		_, ok = rc.VariableInt("name")
+		require.False(t, ok)
+
+		var filter struct{ Names []string }
+		require.NoError(t, rc.DecodeVariable("filter", &filter))
+		require.Equal(t, []string{"a", "b"}, filter.Names)
+		require.EqualError(t, rc.DecodeVariable("missing", &filter), "variable missing is not set")
+	})
+}
@@ -36,8 +36,11 @@ func WithResponseContext(ctx context.Context, presenterFunc ErrorPresenterFunc,
 	})
 }
 
+// WithFreshResponseContext returns ctx passed through the context cloners of the operation, with no errors or
+// extensions. Deferred fragments are executed with it.
 func WithFreshResponseContext(ctx context.Context) context.Context {
 	e := getResponseContext(ctx)
+	ctx = CloneContext(ctx)
 	return context.WithValue(ctx, resultCtx, &responseContext{
 		errorPresenter: e.errorPresenter,
 		recover:        e.recover,
@@ -92,3 +92,33 @@ func TestGetErrorFromPresenter(t *testing.T) {
 	ctx = WithFieldContext(ctx, &FieldContext{})
 	AddError(ctx, errors.New("foo1"))
 }
+
+func TestAddWarning(t *testing.T) {
+	ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+	AddWarning(ctx, nil, "partial data", "PARTIAL")
+	AddWarning(ctx, ast.Path{ast.PathName("todos")}, "limit was lowered", "LIMIT_CLAMPED")
+
+	require.Equal(t, []*Warning{
+		{Message: "partial data", Code: "PARTIAL"},
+		{Message: "limit was lowered", Path: ast.Path{ast.PathName("todos")}, Code: "LIMIT_CLAMPED"},
+	}, GetWarnings(ctx))
+	require.Len(t, GetExtension(ctx, "warnings"), 2)
+	require.Empty(t, GetErrors(ctx))
+
+	t.Run("presenter", func(t *testing.T) {
+		ctx := WithResponseContext(context.Background(), DefaultErrorPresenter, nil)
+		ctx = WithWarningPresenter(ctx, func(ctx context.Context, w *Warning) *Warning {
+			if w.Code == "INTERNAL" {
+				return nil
+			}
+			w.Extensions = map[string]interface{}{"seen": true}
+			return w
+		})
+		AddWarning(ctx, nil, "hidden", "INTERNAL")
+		AddWarning(ctx, nil, "shown", "PUBLIC")
+
+		require.Equal(t, []*Warning{
+			{Message: "shown", Code: "PUBLIC", Extensions: map[string]interface{}{"seen": true}},
+		}, GetWarnings(ctx))
+	})
+}
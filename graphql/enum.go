@@ -0,0 +1,12 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+)
+
+// AddUnknownEnumWarning adds a warning to the "warnings" response extension for a value that isn't a member of enum
+// and was returned as null. It is called by generated code for enums with the null unknown_value action.
+func AddUnknownEnumWarning(ctx context.Context, enum string, value interface{}) {
+	AddWarning(ctx, GetPath(ctx), fmt.Sprintf("%v is not a valid %s", value, enum), "UNKNOWN_ENUM_VALUE")
+}
@@ -1,12 +1,42 @@
 package errcode
 
 import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 const (
 	ValidationFailed = "GRAPHQL_VALIDATION_FAILED"
 	ParseFailed      = "GRAPHQL_PARSE_FAILED"
+
+	// SelectionLimitExceeded is set on the error for operations selecting too many fields, see
+	// executor.Executor.SetSelectionLimit.
+	SelectionLimitExceeded = "SELECTION_LIMIT_EXCEEDED"
+
+	// TokenLimitExceeded and NodeLimitExceeded are set on the error for queries too large to be parsed or validated,
+	// see executor.Executor.SetTokenLimit and executor.Executor.SetNodeLimit.
+	TokenLimitExceeded = "TOKEN_LIMIT_EXCEEDED"
+	NodeLimitExceeded  = "NODE_LIMIT_EXCEEDED"
+
+	// UploadRequiresMultipart is set on the error for operations given something else than files for variables of
+	// the Upload scalar, as files can only be sent by transports supporting uploads.
+	UploadRequiresMultipart = "UPLOAD_REQUIRES_MULTIPART"
+
+	// ReadOnly is set on the error for mutations, and optionally subscriptions, sent while the server is read-only,
+	// see executor.Executor.SetReadOnly.
+	ReadOnly = "READ_ONLY"
+
+	// DependencyUnavailable is set on the error of fields whose dependency is failed by its circuit breaker, see
+	// graphql.WithDependency.
+	DependencyUnavailable = "DEPENDENCY_UNAVAILABLE"
+
+	// ServiceOverloaded is set on the error for operations rejected to protect an overloaded server, see
+	// extension.LoadShedding and executor.Executor.SetAdmissionFunc.
+	ServiceOverloaded = "SERVICE_OVERLOADED"
 )
 
 type ErrorKind int
@@ -18,15 +48,70 @@ const (
 	KindUser
 )
 
-var codeType = map[string]ErrorKind{
-	ValidationFailed: KindProtocol,
-	ParseFailed:      KindProtocol,
+// Metadata describes the errors with a code, for error presenters and transports.
+type Metadata struct {
+	// Kind is KindProtocol unless set, as errors with codes registered by extensions usually fail the request.
+	Kind ErrorKind
+
+	// HTTPStatus is the status of HTTP responses failing with the code before execution. Zero picks the status of
+	// the Kind.
+	HTTPStatus int
+
+	// Retryable tells clients the operation may succeed when sent again, through the "retryable" extension set by
+	// Present.
+	Retryable bool
+
+	// Message replaces the message of errors shown to clients by Present. {name} is replaced by the "name" extension
+	// of the error, so details can be passed in extensions without leaking the internal message.
+	Message string
 }
 
+var (
+	codesMu sync.RWMutex
+	codes   = map[string]Metadata{
+		ValidationFailed: {Kind: KindProtocol},
+		ParseFailed:      {Kind: KindProtocol},
+
+		SelectionLimitExceeded:  {Kind: KindProtocol},
+		TokenLimitExceeded:      {Kind: KindProtocol},
+		NodeLimitExceeded:       {Kind: KindProtocol},
+		UploadRequiresMultipart: {Kind: KindProtocol},
+		ReadOnly:                {Kind: KindProtocol, HTTPStatus: http.StatusServiceUnavailable, Retryable: true},
+		DependencyUnavailable:   {Kind: KindUser, Retryable: true},
+		ServiceOverloaded:       {Kind: KindProtocol, HTTPStatus: http.StatusServiceUnavailable, Retryable: true},
+	}
+)
+
 // RegisterErrorType should be called by extensions that want to customize the http status codes for
 // errors they return
 func RegisterErrorType(code string, kind ErrorKind) {
-	codeType[code] = kind
+	codesMu.Lock()
+	defer codesMu.Unlock()
+	m := codes[code]
+	m.Kind = kind
+	codes[code] = m
+}
+
+// Register sets the metadata of the errors with code, replacing any metadata registered before.
+func Register(code string, m Metadata) {
+	codesMu.Lock()
+	defer codesMu.Unlock()
+	codes[code] = m
+}
+
+// Unregister removes the metadata of the errors with code.
+func Unregister(code string) {
+	codesMu.Lock()
+	defer codesMu.Unlock()
+	delete(codes, code)
+}
+
+// Lookup returns the metadata registered for code.
+func Lookup(code string) (Metadata, bool) {
+	codesMu.RLock()
+	defer codesMu.RUnlock()
+	m, ok := codes[code]
+	return m, ok
 }
 
 // Set the error code on a given graphql error extension
@@ -46,15 +131,70 @@ func Set(err error, value string) {
 	gqlErr.Extensions["code"] = value
 }
 
+// Get returns the error code of err, or an empty string.
+func Get(err *gqlerror.Error) string {
+	if err == nil {
+		return ""
+	}
+	code, _ := err.Extensions["code"].(string)
+	return code
+}
+
 // get the kind of the first non User error, defaults to User if no errors have a custom extension
 func GetErrorKind(errs gqlerror.List) ErrorKind {
 	for _, err := range errs {
-		if code, ok := err.Extensions["code"].(string); ok {
-			if kind, ok := codeType[code]; ok && kind != KindUser {
-				return kind
-			}
+		if m, ok := Lookup(Get(err)); ok && m.Kind != KindUser {
+			return m.Kind
 		}
 	}
 
 	return KindUser
 }
+
+// HTTPStatus returns the status of an HTTP response failing with errs before execution: the status registered for
+// the code of the first error that has one, or else the status of the kind of errs.
+func HTTPStatus(errs gqlerror.List) int {
+	for _, err := range errs {
+		if m, ok := Lookup(Get(err)); ok && m.HTTPStatus != 0 {
+			return m.HTTPStatus
+		}
+	}
+
+	switch GetErrorKind(errs) {
+	case KindProtocol:
+		return http.StatusUnprocessableEntity
+	default:
+		return http.StatusOK
+	}
+}
+
+// IsRetryable reports whether the code of err is registered as retryable.
+func IsRetryable(err *gqlerror.Error) bool {
+	m, _ := Lookup(Get(err))
+	return m.Retryable
+}
+
+var messageParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// Present applies the metadata of the code of err before it is shown to clients: the message is replaced by the
+// message of the code, and the "retryable" extension is set for retryable codes. Call it from error presenters.
+func Present(err *gqlerror.Error) *gqlerror.Error {
+	m, ok := Lookup(Get(err))
+	if !ok {
+		return err
+	}
+
+	if m.Message != "" {
+		err.Message = messageParam.ReplaceAllStringFunc(m.Message, func(param string) string {
+			name := param[1 : len(param)-1]
+			if v, ok := err.Extensions[name]; ok {
+				return fmt.Sprint(v)
+			}
+			return param
+		})
+	}
+	if m.Retryable {
+		err.Extensions["retryable"] = true
+	}
+	return err
+}
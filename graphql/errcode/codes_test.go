@@ -0,0 +1,61 @@
+package errcode
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestMetadata(t *testing.T) {
+	Register("RATE_LIMITED", Metadata{
+		Kind:       KindProtocol,
+		HTTPStatus: http.StatusTooManyRequests,
+		Retryable:  true,
+		Message:    "rate limited, retry in {retryAfter}s {unknown}",
+	})
+	Register("NOT_FOUND", Metadata{Kind: KindUser})
+	defer func() {
+		codesMu.Lock()
+		delete(codes, "RATE_LIMITED")
+		delete(codes, "NOT_FOUND")
+		codesMu.Unlock()
+	}()
+
+	withCode := func(code string) *gqlerror.Error {
+		err := gqlerror.Errorf("internal details")
+		Set(err, code)
+		return err
+	}
+
+	t.Run("http status", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, HTTPStatus(nil))
+		require.Equal(t, http.StatusOK, HTTPStatus(gqlerror.List{withCode("NOT_FOUND"), withCode("UNKNOWN")}))
+		require.Equal(t, http.StatusUnprocessableEntity, HTTPStatus(gqlerror.List{withCode("NOT_FOUND"), withCode(ValidationFailed)}))
+		require.Equal(t, http.StatusTooManyRequests, HTTPStatus(gqlerror.List{withCode(ValidationFailed), withCode("RATE_LIMITED")}))
+	})
+
+	t.Run("register error type keeps metadata", func(t *testing.T) {
+		RegisterErrorType("RATE_LIMITED", KindUser)
+		m, ok := Lookup("RATE_LIMITED")
+		require.True(t, ok)
+		require.Equal(t, KindUser, m.Kind)
+		require.Equal(t, http.StatusTooManyRequests, m.HTTPStatus)
+	})
+
+	t.Run("present", func(t *testing.T) {
+		err := withCode("RATE_LIMITED")
+		err.Extensions["retryAfter"] = 30
+		require.True(t, IsRetryable(err))
+
+		err = Present(err)
+		require.Equal(t, "rate limited, retry in 30s {unknown}", err.Message)
+		require.Equal(t, true, err.Extensions["retryable"])
+
+		err = Present(withCode("NOT_FOUND"))
+		require.Equal(t, "internal details", err.Message)
+		require.NotContains(t, err.Extensions, "retryable")
+		require.False(t, IsRetryable(err))
+	})
+}
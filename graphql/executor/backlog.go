@@ -0,0 +1,70 @@
+package executor
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Backlog is the work an executor has started and not finished past the first response of operations, which keeps
+// memory and goroutines busy after the operations were admitted.
+type Backlog struct {
+	// Deferred is the number of operations whose deferred fragments are still being resolved.
+	Deferred int64
+	// Subscriptions is the number of open subscriptions.
+	Subscriptions int64
+}
+
+// AdmissionFunc is called for each operation before it is executed, with the backlog of the executor, and rejects the
+// operation when it returns an error. Errors without a code get the SERVICE_OVERLOADED code.
+type AdmissionFunc func(ctx context.Context, rc *graphql.OperationContext, backlog Backlog) *gqlerror.Error
+
+type backlog struct {
+	deferred      atomic.Int64
+	subscriptions atomic.Int64
+}
+
+func (b *backlog) load() Backlog {
+	return Backlog{
+		Deferred:      b.deferred.Load(),
+		Subscriptions: b.subscriptions.Load(),
+	}
+}
+
+// track counts the operation of next in the backlog until its responses run out or ctx is done.
+func (b *backlog) track(ctx context.Context, op ast.Operation, next graphql.ResponseHandler) graphql.ResponseHandler {
+	var counter *atomic.Int64
+	var once sync.Once
+	done := func() {
+		once.Do(func() {
+			if counter != nil {
+				counter.Add(-1)
+			}
+		})
+	}
+
+	if op == ast.Subscription {
+		counter = &b.subscriptions
+		counter.Add(1)
+		context.AfterFunc(ctx, done)
+	}
+
+	return func(ctx context.Context) *graphql.Response {
+		resp := next(ctx)
+		if resp != nil && op != ast.Subscription && resp.HasNext != nil && *resp.HasNext {
+			if counter == nil {
+				counter = &b.deferred
+				counter.Add(1)
+				context.AfterFunc(ctx, done)
+			}
+		} else if resp == nil || op != ast.Subscription {
+			done()
+		}
+		return resp
+	}
+}
@@ -0,0 +1,60 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestBacklog(t *testing.T) {
+	responses := func(hasNext ...bool) graphql.ResponseHandler {
+		return func(ctx context.Context) *graphql.Response {
+			if len(hasNext) == 0 {
+				return nil
+			}
+			resp := &graphql.Response{HasNext: &hasNext[0]}
+			hasNext = hasNext[1:]
+			return resp
+		}
+	}
+
+	t.Run("counts queries until their deferred fragments are resolved", func(t *testing.T) {
+		var b backlog
+		ctx := context.Background()
+		next := b.track(ctx, ast.Query, responses(true, true, false))
+		next(ctx)
+		require.Equal(t, Backlog{Deferred: 1}, b.load())
+		next(ctx)
+		require.Equal(t, Backlog{Deferred: 1}, b.load())
+		next(ctx)
+		require.Equal(t, Backlog{}, b.load())
+	})
+
+	t.Run("does not count queries without deferred fragments", func(t *testing.T) {
+		var b backlog
+		ctx := context.Background()
+		next := b.track(ctx, ast.Query, responses(false))
+		next(ctx)
+		require.Equal(t, Backlog{}, b.load())
+	})
+
+	t.Run("counts subscriptions until they end", func(t *testing.T) {
+		var b backlog
+		ctx, cancel := context.WithCancel(context.Background())
+		next := b.track(ctx, ast.Subscription, responses(false, false))
+		require.Equal(t, Backlog{Subscriptions: 1}, b.load())
+		next(ctx)
+		require.Equal(t, Backlog{Subscriptions: 1}, b.load())
+
+		cancel()
+		require.Eventually(t, func() bool { return b.load() == Backlog{} }, time.Second, time.Millisecond)
+		next(ctx)
+		next(ctx)
+		require.Equal(t, Backlog{}, b.load())
+	})
+}
@@ -2,10 +2,10 @@ package executor
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
-	"github.com/vektah/gqlparser/v2/parser"
 	"github.com/vektah/gqlparser/v2/validator"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -18,9 +18,24 @@ type Executor struct {
 	extensions []graphql.HandlerExtension
 	ext        extensions
 
-	errorPresenter graphql.ErrorPresenterFunc
-	recoverFunc    graphql.RecoverFunc
-	queryCache     graphql.Cache
+	errorPresenter    graphql.ErrorPresenterFunc
+	warningPresenter  graphql.WarningPresenterFunc
+	recoverFunc       graphql.RecoverFunc
+	queryCache        graphql.Cache
+	queryParser       graphql.QueryParser
+	selectionLimit    int
+	tokenLimit        int
+	nodeLimit         int
+	errorPositions    bool
+	contextCloners    []graphql.ContextCloner
+	subscriptionLimit graphql.SubscriptionLimit
+	hedging           *graphql.HedgeScheduler
+	admission         AdmissionFunc
+	backlog           backlog
+
+	// readOnly holds the message of the errors rejecting mutations when the executor is read-only, nil otherwise.
+	readOnly              atomic.Pointer[string]
+	readOnlySubscriptions atomic.Bool
 }
 
 var _ graphql.GraphExecutor = &Executor{}
@@ -33,6 +48,9 @@ func New(es graphql.ExecutableSchema) *Executor {
 		errorPresenter: graphql.DefaultErrorPresenter,
 		recoverFunc:    graphql.DefaultRecover,
 		queryCache:     graphql.NoCache{},
+		queryParser:    DefaultQueryParser{},
+		tokenLimit:     DefaultTokenLimit,
+		nodeLimit:      DefaultNodeLimit,
 		ext:            processExtensions(nil),
 	}
 	return e
@@ -47,6 +65,8 @@ func (e *Executor) CreateOperationContext(
 		RecoverFunc:            e.recoverFunc,
 		ResolverMiddleware:     e.ext.fieldMiddleware,
 		RootResolverMiddleware: e.ext.rootFieldMiddleware,
+		ContextCloners:         e.contextCloners,
+		Hedging:                e.hedging,
 		Stats: graphql.Stats{
 			Read:           params.ReadTime,
 			OperationStart: graphql.GetStartTime(ctx),
@@ -65,8 +85,11 @@ func (e *Executor) CreateOperationContext(
 	rc.Headers = params.Headers
 
 	var listErr gqlerror.List
-	rc.Doc, listErr = e.parseQuery(ctx, &rc.Stats, params.Query)
+	rc.Doc, listErr = e.parseQuery(ctx, &rc.Stats, params.Query, params.OperationName)
 	if len(listErr) != 0 {
+		if e.errorPositions {
+			addErrorPositions(params.Query, listErr)
+		}
 		return rc, listErr
 	}
 
@@ -76,6 +99,26 @@ func (e *Executor) CreateOperationContext(
 		errcode.Set(err, errcode.ValidationFailed)
 		return rc, gqlerror.List{err}
 	}
+	if err := e.checkReadOnly(rc.Operation); err != nil {
+		return rc, gqlerror.List{err}
+	}
+
+	if e.selectionLimit > 0 && countSelections(rc.Doc, rc.Operation, e.selectionLimit) > e.selectionLimit {
+		err := gqlerror.ErrorPosf(rc.Operation.Position, "operation selects more than %d fields once fragments are expanded", e.selectionLimit)
+		errcode.Set(err, errcode.SelectionLimitExceeded)
+		return rc, gqlerror.List{err}
+	}
+
+	for _, p := range e.ext.operationVariablesMutators {
+		var err *gqlerror.Error
+		if params.Variables, err = p.MutateOperationVariables(ctx, rc.Operation, params.Variables); err != nil {
+			return rc, gqlerror.List{err}
+		}
+	}
+
+	if err := checkUploads(e.es.Schema(), rc.Operation, params.Variables); err != nil {
+		return rc, gqlerror.List{err}
+	}
 
 	var err error
 	rc.Variables, err = validator.VariableValues(e.es.Schema(), rc.Operation, params.Variables)
@@ -95,6 +138,15 @@ func (e *Executor) CreateOperationContext(
 		}
 	}
 
+	if e.admission != nil {
+		if err := e.admission(ctx, rc, e.backlog.load()); err != nil {
+			if err.Extensions["code"] == nil {
+				errcode.Set(err, errcode.ServiceOverloaded)
+			}
+			return rc, gqlerror.List{err}
+		}
+	}
+
 	return rc, nil
 }
 
@@ -108,14 +160,17 @@ func (e *Executor) DispatchOperation(
 	res := e.ext.operationMiddleware(ctx, func(ctx context.Context) graphql.ResponseHandler {
 		innerCtx = ctx
 
-		tmpResponseContext := graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+		tmpResponseContext := e.withResponseContext(ctx)
 		responses := e.es.Exec(tmpResponseContext)
 		if errs := graphql.GetErrors(tmpResponseContext); errs != nil {
 			return graphql.OneShot(&graphql.Response{Errors: errs})
 		}
 
-		return func(ctx context.Context) *graphql.Response {
-			ctx = graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+		handler := func(ctx context.Context) *graphql.Response {
+			if rc.Operation.Operation == ast.Subscription {
+				ctx = graphql.CloneContext(ctx)
+			}
+			ctx = e.withResponseContext(ctx)
 			resp := e.ext.responseMiddleware(ctx, func(ctx context.Context) *graphql.Response {
 				resp := responses(ctx)
 				if resp == nil {
@@ -131,13 +186,18 @@ func (e *Executor) DispatchOperation(
 
 			return resp
 		}
+		tracked := e.backlog.track(ctx, rc.Operation.Operation, handler)
+		if rc.Operation.Operation == ast.Subscription {
+			return graphql.LimitResponses(tracked, e.subscriptionLimit)
+		}
+		return tracked
 	})
 
 	return res, innerCtx
 }
 
 func (e *Executor) DispatchError(ctx context.Context, list gqlerror.List) *graphql.Response {
-	ctx = graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+	ctx = e.withResponseContext(ctx)
 	for _, gErr := range list {
 		graphql.AddError(ctx, gErr)
 	}
@@ -153,6 +213,14 @@ func (e *Executor) DispatchError(ctx context.Context, list gqlerror.List) *graph
 	return resp
 }
 
+func (e *Executor) withResponseContext(ctx context.Context) context.Context {
+	ctx = graphql.WithResponseContext(ctx, e.errorPresenter, e.recoverFunc)
+	if e.warningPresenter != nil {
+		ctx = graphql.WithWarningPresenter(ctx, e.warningPresenter)
+	}
+	return ctx
+}
+
 func (e *Executor) PresentRecoveredError(ctx context.Context, err interface{}) error {
 	return e.errorPresenter(ctx, e.recoverFunc(ctx, err))
 }
@@ -161,15 +229,127 @@ func (e *Executor) SetQueryCache(cache graphql.Cache) {
 	e.queryCache = cache
 }
 
+// SetQueryParser replaces the parser and validator of queries, DefaultQueryParser by default. Documents it returns are
+// cached in the query cache like those of the default one.
+func (e *Executor) SetQueryParser(p graphql.QueryParser) {
+	e.queryParser = p
+}
+
 func (e *Executor) SetErrorPresenter(f graphql.ErrorPresenterFunc) {
 	e.errorPresenter = f
 }
 
+// SetWarningPresenter sets the presenter warnings added with graphql.AddWarning go through before they are added to
+// the response.
+func (e *Executor) SetWarningPresenter(f graphql.WarningPresenterFunc) {
+	e.warningPresenter = f
+}
+
 func (e *Executor) SetRecoverFunc(f graphql.RecoverFunc) {
 	e.recoverFunc = f
 }
 
-// parseQuery decodes the incoming query and validates it, pulling from cache if present.
+// SetSelectionLimit caps the number of fields an operation may select, counting every field of a fragment each time
+// it is spread. It catches documents that fan out through fragments and aliases while staying within depth and
+// complexity limits. Zero, the default, disables the check.
+func (e *Executor) SetSelectionLimit(limit int) {
+	e.selectionLimit = limit
+}
+
+// SetTokenLimit caps the number of tokens of queries, comments left aside, checked before they are parsed so payloads
+// crafted to exhaust the parser are rejected early. It is DefaultTokenLimit unless changed, zero disables the check.
+func (e *Executor) SetTokenLimit(limit int) {
+	e.tokenLimit = limit
+}
+
+// SetNodeLimit caps the number of nodes of parsed documents, checked before they are validated. Definitions,
+// selections, arguments, directives and values are nodes, the fields of input objects and items of lists included.
+// It is DefaultNodeLimit unless changed, zero disables the check.
+func (e *Executor) SetNodeLimit(limit int) {
+	e.nodeLimit = limit
+}
+
+// SetErrorPositions adds a "positions" extension to parse and validation errors, listing the line, column and text of
+// the token at each of their locations, so editors and client SDKs can highlight the offending part of the query
+// without parsing it. See ErrorPosition.
+func (e *Executor) SetErrorPositions(enabled bool) {
+	e.errorPositions = enabled
+}
+
+// SetReadOnly rejects mutations with a READ_ONLY error carrying message while readOnly is true, and keeps executing
+// queries. It can be called while operations execute, to switch to read-only during migrations or incidents without
+// redeploying. An empty message is replaced by a generic one.
+func (e *Executor) SetReadOnly(readOnly bool, message string) {
+	if !readOnly {
+		e.readOnly.Store(nil)
+		return
+	}
+	if message == "" {
+		message = "the server is read-only"
+	}
+	e.readOnly.Store(&message)
+}
+
+// SetReadOnlySubscriptions sets whether new subscriptions are rejected as well while the executor is read-only.
+// Subscriptions started before are not stopped.
+func (e *Executor) SetReadOnlySubscriptions(reject bool) {
+	e.readOnlySubscriptions.Store(reject)
+}
+
+func (e *Executor) checkReadOnly(op *ast.OperationDefinition) *gqlerror.Error {
+	message := e.readOnly.Load()
+	if message == nil {
+		return nil
+	}
+	if op.Operation == ast.Mutation || op.Operation == ast.Subscription && e.readOnlySubscriptions.Load() {
+		err := gqlerror.ErrorPosf(op.Position, "%s", *message)
+		errcode.Set(err, errcode.ReadOnly)
+		return err
+	}
+	return nil
+}
+
+// AddContextCloner adds a cloner applied to the context of each subscription event and deferred fragment, see
+// graphql.ContextCloner.
+func (e *Executor) AddContextCloner(f graphql.ContextCloner) {
+	e.contextCloners = append(e.contextCloners, f)
+}
+
+// SetSubscriptionLimit caps the number of events each subscription delivers per second, see
+// graphql.SubscriptionLimit. Events are still executed as they are produced, only their delivery is throttled.
+func (e *Executor) SetSubscriptionLimit(limit graphql.SubscriptionLimit) {
+	e.subscriptionLimit = limit
+}
+
+// SetHedging lets resolvers of queries hedge their calls with graphql.Hedge, running maxInFlight hedged calls at most
+// across all operations. Zero disables hedging, graphql.Hedge then calls functions once.
+func (e *Executor) SetHedging(maxInFlight int) {
+	if maxInFlight <= 0 {
+		e.hedging = nil
+		return
+	}
+	e.hedging = graphql.NewHedgeScheduler(maxInFlight)
+}
+
+// Hedging returns the scheduler of hedged calls, nil unless SetHedging enabled them.
+func (e *Executor) Hedging() *graphql.HedgeScheduler {
+	return e.hedging
+}
+
+// SetAdmissionFunc sets a function admitting operations before they are executed from the work the executor is still
+// busy with, to reject expensive operations while deferred fragments or subscriptions pile up rather than run out of
+// memory. See AdmissionFunc.
+func (e *Executor) SetAdmissionFunc(f AdmissionFunc) {
+	e.admission = f
+}
+
+// Backlog returns the work the executor is still busy with past the first response of operations.
+func (e *Executor) Backlog() Backlog {
+	return e.backlog.load()
+}
+
+// parseQuery decodes the incoming query and validates it, pulling from cache if present. The operation named
+// operationName is recorded in the OperationInfo of ctx as soon as the query is parsed, before it is validated.
 //
 // NOTE: This should NOT look at variables, they will change per request. It should only parse and
 // validate
@@ -178,6 +358,7 @@ func (e *Executor) parseQuery(
 	ctx context.Context,
 	stats *graphql.Stats,
 	query string,
+	operationName string,
 ) (*ast.QueryDocument, gqlerror.List) {
 	stats.Parsing.Start = graphql.Now()
 
@@ -186,29 +367,41 @@ func (e *Executor) parseQuery(
 
 		stats.Parsing.End = now
 		stats.Validation.Start = now
+		setOperationInfo(ctx, doc.(*ast.QueryDocument), operationName)
 		return doc.(*ast.QueryDocument), nil
 	}
 
-	doc, err := parser.ParseQuery(&ast.Source{Input: query})
-	if err != nil {
-		gqlErr, ok := err.(*gqlerror.Error)
-		if ok {
-			errcode.Set(gqlErr, errcode.ParseFailed)
-			return nil, gqlerror.List{gqlErr}
+	if e.tokenLimit > 0 {
+		if err := checkTokenLimit(query, e.tokenLimit); err != nil {
+			return nil, gqlerror.List{err}
 		}
 	}
+
+	doc, listErr := e.queryParser.ParseQuery(query)
+	if len(listErr) != 0 {
+		for _, e := range listErr {
+			errcode.Set(e, errcode.ParseFailed)
+		}
+		return nil, listErr
+	}
 	stats.Parsing.End = graphql.Now()
+	setOperationInfo(ctx, doc, operationName)
 
 	stats.Validation.Start = graphql.Now()
 
-	if len(doc.Operations) == 0 {
-		err = gqlerror.Errorf("no operation provided")
-		gqlErr, _ := err.(*gqlerror.Error)
+	if doc == nil || len(doc.Operations) == 0 {
+		err := gqlerror.Errorf("no operation provided")
 		errcode.Set(err, errcode.ValidationFailed)
-		return nil, gqlerror.List{gqlErr}
+		return nil, gqlerror.List{err}
+	}
+
+	if e.nodeLimit > 0 {
+		if err := checkNodeLimit(doc, e.nodeLimit); err != nil {
+			return nil, gqlerror.List{err}
+		}
 	}
 
-	listErr := validator.Validate(e.es.Schema(), doc)
+	listErr = e.queryParser.ValidateQuery(e.es.Schema(), doc)
 	if len(listErr) != 0 {
 		for _, e := range listErr {
 			errcode.Set(e, errcode.ValidationFailed)
@@ -220,3 +413,14 @@ func (e *Executor) parseQuery(
 
 	return doc, nil
 }
+
+// setOperationInfo records the operation named operationName of doc in the OperationInfo of ctx, if there is one.
+func setOperationInfo(ctx context.Context, doc *ast.QueryDocument, operationName string) {
+	info := graphql.GetOperationInfo(ctx)
+	if info == nil || doc == nil {
+		return
+	}
+	if op := doc.Operations.ForName(operationName); op != nil {
+		info.Set(op.Name, op.Operation)
+	}
+}
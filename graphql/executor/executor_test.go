@@ -2,7 +2,11 @@ package executor_test
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -12,6 +16,7 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/executor"
 	"github.com/99designs/gqlgen/graphql/executor/testexecutor"
 )
 
@@ -169,6 +174,42 @@ func TestExecutor(t *testing.T) {
 	})
 }
 
+func TestContextCloners(t *testing.T) {
+	type ctxKey struct{}
+	exec := testexecutor.New()
+	clones := 0
+	exec.AddContextCloner(func(ctx context.Context) context.Context {
+		clones++
+		return context.WithValue(ctx, ctxKey{}, clones)
+	})
+	var values []interface{}
+	exec.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		values = append(values, ctx.Value(ctxKey{}))
+		return next(ctx)
+	})
+
+	t.Run("queries are not cloned", func(t *testing.T) {
+		resp := query(exec, "", "{name}")
+		assert.Equal(t, `{"name":"test"}`, string(resp.Data))
+		assert.Equal(t, []interface{}{nil}, values)
+	})
+
+	t.Run("each subscription event is cloned", func(t *testing.T) {
+		values = nil
+		ctx := graphql.StartOperationTrace(context.Background())
+		rc, err := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "subscription { name }"})
+		require.Nil(t, err)
+		responses, ctx := exec.DispatchOperation(ctx, rc)
+		for i := 0; i < 2; i++ {
+			go exec.SendNextSubscriptionMessage()
+			resp := responses(ctx)
+			require.NotNil(t, resp)
+			assert.Equal(t, `{"name":"test"}`, string(resp.Data))
+		}
+		assert.Equal(t, []interface{}{1, 2}, values)
+	})
+}
+
 type testParamMutator struct {
 	Mutate func(context.Context, *graphql.RawParams) *gqlerror.Error
 }
@@ -201,6 +242,256 @@ func (m *testCtxMutator) MutateOperationContext(ctx context.Context, rc *graphql
 	return m.Mutate(ctx, rc)
 }
 
+func TestSubscriptionLimit(t *testing.T) {
+	exec := testexecutor.New()
+	merged := 0
+	exec.SetSubscriptionLimit(graphql.SubscriptionLimit{
+		Rate: 20,
+		Reduce: func(waiting, next *graphql.Response) *graphql.Response {
+			merged++
+			return next
+		},
+	})
+
+	ctx, cancel := context.WithCancel(graphql.StartOperationTrace(context.Background()))
+	defer cancel()
+	rc, err := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "subscription { name }"})
+	require.Nil(t, err)
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+
+	go exec.SendNextSubscriptionMessage()
+	require.NotNil(t, responses(ctx))
+
+	exec.SendNextSubscriptionMessage()
+	exec.SendNextSubscriptionMessage()
+	resp := responses(ctx)
+	require.NotNil(t, resp)
+	assert.Equal(t, `{"name":"test"}`, string(resp.Data))
+	assert.Equal(t, 1, merged)
+
+	cancel()
+	assert.Nil(t, responses(ctx))
+}
+
+func TestAdmissionFunc(t *testing.T) {
+	exec := testexecutor.New()
+	exec.SetAdmissionFunc(func(ctx context.Context, rc *graphql.OperationContext, backlog executor.Backlog) *gqlerror.Error {
+		if backlog.Subscriptions > 0 && rc.Operation.Operation == ast.Subscription {
+			return gqlerror.Errorf("too many subscriptions")
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(graphql.StartOperationTrace(context.Background()))
+	defer cancel()
+	rc, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "subscription { name }"})
+	require.Empty(t, errs)
+	exec.DispatchOperation(ctx, rc)
+	assert.Equal(t, executor.Backlog{Subscriptions: 1}, exec.Backlog())
+
+	_, errs = exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "subscription { name }"})
+	require.Len(t, errs, 1)
+	assert.Equal(t, "too many subscriptions", errs[0].Message)
+	assert.Equal(t, errcode.ServiceOverloaded, errs[0].Extensions["code"])
+	assert.Empty(t, query(exec, "", "{name}").Errors)
+
+	cancel()
+	require.Eventually(t, func() bool { return exec.Backlog() == executor.Backlog{} }, time.Second, time.Millisecond)
+}
+
+func TestSelectionLimit(t *testing.T) {
+	exec := testexecutor.New()
+	exec.SetSelectionLimit(8)
+
+	t.Run("allows operations within the limit", func(t *testing.T) {
+		resp := query(exec, "", "fragment F on Query { a: name b: name } { ...F ...F c: name }")
+		assert.Empty(t, resp.Errors)
+	})
+
+	t.Run("counts each spread of a fragment", func(t *testing.T) {
+		resp := query(exec, "", `
+			fragment F0 on Query { a: name b: name c: name }
+			fragment F1 on Query { x: find(id: 1) ...F0 }
+			query { ...F1 ...F1 ... on Query { ...F1 } }`)
+		assert.Equal(t, "", string(resp.Data))
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "operation selects more than 8 fields once fragments are expanded", resp.Errors[0].Message)
+		assert.Equal(t, errcode.SelectionLimitExceeded, resp.Errors[0].Extensions["code"])
+		assert.Equal(t, errcode.KindProtocol, errcode.GetErrorKind(resp.Errors))
+	})
+}
+
+func TestParserLimits(t *testing.T) {
+	exec := testexecutor.New()
+
+	t.Run("applies default limits", func(t *testing.T) {
+		resp := query(exec, "", "{"+strings.Repeat(" name", executor.DefaultTokenLimit)+" }")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, errcode.TokenLimitExceeded, resp.Errors[0].Extensions["code"])
+	})
+
+	exec.SetTokenLimit(8)
+	exec.SetNodeLimit(3)
+
+	t.Run("allows queries within the limits", func(t *testing.T) {
+		resp := query(exec, "", "# comment\n{ name }")
+		assert.Empty(t, resp.Errors)
+		assert.Equal(t, `{"name":"test"}`, string(resp.Data))
+	})
+
+	t.Run("rejects queries with too many tokens", func(t *testing.T) {
+		resp := query(exec, "", "{ a: name\n b: name c: name }")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "query has more than 8 tokens", resp.Errors[0].Message)
+		assert.Equal(t, []gqlerror.Location{{Line: 2, Column: 11}}, resp.Errors[0].Locations)
+		assert.Equal(t, map[string]interface{}{"code": errcode.TokenLimitExceeded, "limit": 8}, resp.Errors[0].Extensions)
+	})
+
+	t.Run("rejects documents with too many nodes", func(t *testing.T) {
+		resp := query(exec, "", "{ a: name b: name }")
+		assert.Empty(t, resp.Errors)
+
+		resp = query(exec, "", "{ find(id: 1) }")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "query has more than 3 nodes", resp.Errors[0].Message)
+		assert.Equal(t, []gqlerror.Location{{Line: 1, Column: 12}}, resp.Errors[0].Locations)
+		assert.Equal(t, map[string]interface{}{"code": errcode.NodeLimitExceeded, "limit": 3}, resp.Errors[0].Extensions)
+	})
+
+	t.Run("can be disabled", func(t *testing.T) {
+		exec.SetTokenLimit(0)
+		exec.SetNodeLimit(0)
+		resp := query(exec, "", "{ a: name b: name c: name }")
+		assert.Empty(t, resp.Errors)
+	})
+}
+
+func TestReadOnly(t *testing.T) {
+	exec := testexecutor.New()
+	exec.SetReadOnly(true, "down for maintenance")
+
+	t.Run("serves queries", func(t *testing.T) {
+		resp := query(exec, "", "{name}")
+		assert.Empty(t, resp.Errors)
+		assert.Equal(t, `{"name":"test"}`, string(resp.Data))
+	})
+
+	t.Run("rejects mutations", func(t *testing.T) {
+		resp := query(exec, "", "mutation {name}")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "down for maintenance", resp.Errors[0].Message)
+		assert.Equal(t, errcode.ReadOnly, resp.Errors[0].Extensions["code"])
+		assert.Equal(t, http.StatusServiceUnavailable, errcode.HTTPStatus(resp.Errors))
+	})
+
+	t.Run("rejects subscriptions when asked to", func(t *testing.T) {
+		ctx := graphql.StartOperationTrace(context.Background())
+		_, errs := exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "subscription {name}"})
+		assert.Empty(t, errs)
+
+		exec.SetReadOnlySubscriptions(true)
+		defer exec.SetReadOnlySubscriptions(false)
+		_, errs = exec.CreateOperationContext(ctx, &graphql.RawParams{Query: "subscription {name}"})
+		require.Len(t, errs, 1)
+		assert.Equal(t, errcode.ReadOnly, errs[0].Extensions["code"])
+	})
+
+	t.Run("uses a default message", func(t *testing.T) {
+		exec.SetReadOnly(true, "")
+		resp := query(exec, "", "mutation {name}")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "the server is read-only", resp.Errors[0].Message)
+	})
+
+	t.Run("can be switched off", func(t *testing.T) {
+		exec.SetReadOnly(false, "")
+		resp := query(exec, "", "mutation {name}")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "mutations are not supported", resp.Errors[0].Message)
+	})
+}
+
+func TestErrorPositions(t *testing.T) {
+	exec := testexecutor.New()
+
+	t.Run("off by default", func(t *testing.T) {
+		resp := query(exec, "", "{ nmae }")
+		require.Len(t, resp.Errors, 1)
+		assert.NotContains(t, resp.Errors[0].Extensions, "positions")
+	})
+
+	exec.SetErrorPositions(true)
+
+	t.Run("validation errors", func(t *testing.T) {
+		resp := query(exec, "", "query($s: String = \"\u00e9t\u00e9\") { name(x: $s) }")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, []executor.ErrorPosition{{Line: 1, Column: 29, Token: "name"}}, resp.Errors[0].Extensions["positions"])
+		b, err := json.Marshal(resp.Errors[0].Extensions)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"code":"GRAPHQL_VALIDATION_FAILED","positions":[{"line":1,"column":29,"token":"name"}]}`, string(b))
+	})
+
+	t.Run("parse errors", func(t *testing.T) {
+		resp := query(exec, "", "{ name(x: ) }")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, []executor.ErrorPosition{{Line: 1, Column: 11, Token: ")"}}, resp.Errors[0].Extensions["positions"])
+
+		resp = query(exec, "", "{ name")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, []executor.ErrorPosition{{Line: 1, Column: 7}}, resp.Errors[0].Extensions["positions"])
+	})
+}
+
+// limitedParser rejects queries longer than max characters and counts the documents it validates.
+type limitedParser struct {
+	executor.DefaultQueryParser
+	max       int
+	validated int
+}
+
+func (p *limitedParser) ParseQuery(query string) (*ast.QueryDocument, gqlerror.List) {
+	if len(query) > p.max {
+		return nil, gqlerror.List{gqlerror.Errorf("query is longer than %d characters", p.max)}
+	}
+	return p.DefaultQueryParser.ParseQuery(query)
+}
+
+func (p *limitedParser) ValidateQuery(schema *ast.Schema, doc *ast.QueryDocument) gqlerror.List {
+	p.validated++
+	return p.DefaultQueryParser.ValidateQuery(schema, doc)
+}
+
+func TestQueryParser(t *testing.T) {
+	exec := testexecutor.New()
+	p := &limitedParser{max: 10}
+	exec.SetQueryParser(p)
+	exec.SetQueryCache(graphql.MapCache{})
+
+	t.Run("parses and validates queries", func(t *testing.T) {
+		resp := query(exec, "", "{name}")
+		assert.Equal(t, `{"name":"test"}`, string(resp.Data))
+		assert.Equal(t, 1, p.validated)
+
+		resp = query(exec, "", "{nmae}")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, errcode.ValidationFailed, resp.Errors[0].Extensions["code"])
+		assert.Equal(t, 2, p.validated)
+	})
+
+	t.Run("sets the code of parse errors", func(t *testing.T) {
+		resp := query(exec, "", "{ name name }")
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "query is longer than 10 characters", resp.Errors[0].Message)
+		assert.Equal(t, errcode.ParseFailed, resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("caches documents", func(t *testing.T) {
+		resp := query(exec, "", "{name}")
+		assert.Equal(t, `{"name":"test"}`, string(resp.Data))
+		assert.Equal(t, 2, p.validated)
+	})
+}
+
 func TestErrorServer(t *testing.T) {
 	exec := testexecutor.NewError()
 
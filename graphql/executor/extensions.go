@@ -15,6 +15,7 @@ func (e *Executor) Use(extension graphql.HandlerExtension) {
 
 	switch extension.(type) {
 	case graphql.OperationParameterMutator,
+		graphql.OperationVariablesMutator,
 		graphql.OperationContextMutator,
 		graphql.OperationInterceptor,
 		graphql.RootFieldInterceptor,
@@ -54,6 +55,7 @@ type extensions struct {
 	rootFieldMiddleware        graphql.RootFieldMiddleware
 	fieldMiddleware            graphql.FieldMiddleware
 	operationParameterMutators []graphql.OperationParameterMutator
+	operationVariablesMutators []graphql.OperationVariablesMutator
 	operationContextMutators   []graphql.OperationContextMutator
 }
 
@@ -118,6 +120,10 @@ func processExtensions(exts []graphql.HandlerExtension) extensions {
 			e.operationParameterMutators = append(e.operationParameterMutators, p)
 		}
 
+		if p, ok := p.(graphql.OperationVariablesMutator); ok {
+			e.operationVariablesMutators = append(e.operationVariablesMutators, p)
+		}
+
 		if p, ok := p.(graphql.OperationContextMutator); ok {
 			e.operationContextMutators = append(e.operationContextMutators, p)
 		}
@@ -0,0 +1,27 @@
+package executor
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DefaultQueryParser is the graphql.QueryParser of executors, parsing and validating queries with gqlparser.
+// Repeatable directives may be used several times at a location.
+type DefaultQueryParser struct{}
+
+var _ graphql.QueryParser = DefaultQueryParser{}
+
+func (DefaultQueryParser) ParseQuery(query string) (*ast.QueryDocument, gqlerror.List) {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil, gqlerror.List{gqlerror.WrapIfUnwrapped(err)}
+	}
+	return doc, nil
+}
+
+func (DefaultQueryParser) ValidateQuery(schema *ast.Schema, doc *ast.QueryDocument) gqlerror.List {
+	return validate(schema, doc)
+}
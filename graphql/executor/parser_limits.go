@@ -0,0 +1,142 @@
+package executor
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/lexer"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const (
+	// DefaultTokenLimit is the number of tokens queries may have unless changed with Executor.SetTokenLimit.
+	DefaultTokenLimit = 15000
+
+	// DefaultNodeLimit is the number of nodes documents may have unless changed with Executor.SetNodeLimit.
+	DefaultNodeLimit = 10000
+)
+
+// checkTokenLimit returns an error located at the first token of query over limit, comments left aside. Queries the
+// lexer fails on are left to the parser.
+func checkTokenLimit(query string, limit int) *gqlerror.Error {
+	lex := lexer.New(&ast.Source{Input: query})
+	n := 0
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil || tok.Kind == lexer.EOF {
+			return nil
+		}
+		if tok.Kind == lexer.Comment {
+			continue
+		}
+		if n++; n > limit {
+			err := gqlerror.ErrorLocf("", tok.Pos.Line, tok.Pos.Column, "query has more than %d tokens", limit)
+			errcode.Set(err, errcode.TokenLimitExceeded)
+			err.Extensions["limit"] = limit
+			return err
+		}
+	}
+}
+
+// nodeCounter counts the nodes of a document: its definitions, selections, arguments, directives and values, the
+// fields of input objects and items of lists included. Counting stops at the first node over limit.
+type nodeCounter struct {
+	limit    int
+	n        int
+	exceeded bool
+	over     *ast.Position
+}
+
+// checkNodeLimit returns an error located at the first node of doc over limit.
+func checkNodeLimit(doc *ast.QueryDocument, limit int) *gqlerror.Error {
+	c := &nodeCounter{limit: limit}
+	for _, op := range doc.Operations {
+		c.operation(op)
+	}
+	for _, fragment := range doc.Fragments {
+		if c.add(fragment.Position) {
+			c.directives(fragment.Directives)
+			c.selections(fragment.SelectionSet)
+		}
+	}
+	if !c.exceeded {
+		return nil
+	}
+	err := gqlerror.Errorf("query has more than %d nodes", limit)
+	if c.over != nil {
+		err = gqlerror.ErrorLocf("", c.over.Line, c.over.Column, "query has more than %d nodes", limit)
+	}
+	errcode.Set(err, errcode.NodeLimitExceeded)
+	err.Extensions["limit"] = limit
+	return err
+}
+
+// add counts a node at pos, and returns whether its children should be counted.
+func (c *nodeCounter) add(pos *ast.Position) bool {
+	if c.exceeded {
+		return false
+	}
+	if c.n++; c.n > c.limit {
+		c.exceeded, c.over = true, pos
+		return false
+	}
+	return true
+}
+
+func (c *nodeCounter) operation(op *ast.OperationDefinition) {
+	if !c.add(op.Position) {
+		return
+	}
+	for _, def := range op.VariableDefinitions {
+		if c.add(def.Position) {
+			c.value(def.DefaultValue)
+			c.directives(def.Directives)
+		}
+	}
+	c.directives(op.Directives)
+	c.selections(op.SelectionSet)
+}
+
+func (c *nodeCounter) selections(set ast.SelectionSet) {
+	for _, sel := range set {
+		if !c.add(sel.GetPosition()) {
+			return
+		}
+		switch sel := sel.(type) {
+		case *ast.Field:
+			c.arguments(sel.Arguments)
+			c.directives(sel.Directives)
+			c.selections(sel.SelectionSet)
+		case *ast.InlineFragment:
+			c.directives(sel.Directives)
+			c.selections(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			c.directives(sel.Directives)
+		}
+	}
+}
+
+func (c *nodeCounter) directives(directives ast.DirectiveList) {
+	for _, dir := range directives {
+		if c.add(dir.Position) {
+			c.arguments(dir.Arguments)
+		}
+	}
+}
+
+func (c *nodeCounter) arguments(args ast.ArgumentList) {
+	for _, arg := range args {
+		if c.add(arg.Position) {
+			c.value(arg.Value)
+		}
+	}
+}
+
+func (c *nodeCounter) value(v *ast.Value) {
+	if v == nil || !c.add(v.Position) {
+		return
+	}
+	for _, child := range v.Children {
+		c.value(child.Value)
+	}
+}
@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/lexer"
+)
+
+// ErrorPosition is an item of the "positions" extension of parse and validation errors, see
+// Executor.SetErrorPositions. Line and Column are those of the matching location of the error, counted in runes from
+// 1, and Token is the text of the token found there, empty at the end of the query.
+type ErrorPosition struct {
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Token  string `json:"token,omitempty"`
+}
+
+// addErrorPositions sets the "positions" extension of the errors with locations in query.
+func addErrorPositions(query string, errs gqlerror.List) {
+	var lines []string
+	for _, err := range errs {
+		if len(err.Locations) == 0 {
+			continue
+		}
+		if lines == nil {
+			lines = strings.Split(query, "\n")
+		}
+		positions := make([]ErrorPosition, 0, len(err.Locations))
+		for _, loc := range err.Locations {
+			positions = append(positions, ErrorPosition{
+				Line:   loc.Line,
+				Column: loc.Column,
+				Token:  tokenAt(lines, loc.Line, loc.Column),
+			})
+		}
+		if err.Extensions == nil {
+			err.Extensions = map[string]interface{}{}
+		}
+		err.Extensions["positions"] = positions
+	}
+}
+
+// tokenAt returns the text of the token starting at line and column of lines.
+func tokenAt(lines []string, line, column int) string {
+	if line < 1 || line > len(lines) || column < 1 {
+		return ""
+	}
+	runes := []rune(lines[line-1])
+	if column > len(runes) {
+		return ""
+	}
+	// the following lines are kept for block strings
+	rest := []rune(strings.Join(append([]string{string(runes[column-1:])}, lines[line:]...), "\n"))
+	lex := lexer.New(&ast.Source{Input: string(rest)})
+	tok, err := lex.ReadToken()
+	if err != nil || tok.Kind == lexer.EOF || tok.Pos.Start != 0 {
+		// the rune itself for characters the lexer rejects, like unterminated strings
+		return string(rest[:1])
+	}
+	return string(rest[tok.Pos.Start:min(tok.Pos.End, len(rest))])
+}
@@ -0,0 +1,73 @@
+package executor
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// selectionCounter counts the fields an operation selects as if its fragments were expanded, without expanding
+// them. Fragment sizes are memoized, so documents spreading the same fragments over and over are counted in linear
+// time, and counting stops as soon as the limit is exceeded.
+type selectionCounter struct {
+	doc       *ast.QueryDocument
+	limit     int
+	fragments map[string]int
+	visiting  map[string]bool
+}
+
+// countSelections returns the number of fields selected by op once fragments are expanded, or limit+1 if that is more
+// than limit. A fragment spreading itself counts as exceeding the limit.
+func countSelections(doc *ast.QueryDocument, op *ast.OperationDefinition, limit int) int {
+	c := &selectionCounter{
+		doc:       doc,
+		limit:     limit,
+		fragments: map[string]int{},
+		visiting:  map[string]bool{},
+	}
+	return c.count(op.SelectionSet)
+}
+
+func (c *selectionCounter) count(set ast.SelectionSet) int {
+	n := 0
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			n = c.add(n, 1+c.count(sel.SelectionSet))
+		case *ast.InlineFragment:
+			n = c.add(n, c.count(sel.SelectionSet))
+		case *ast.FragmentSpread:
+			n = c.add(n, c.fragment(sel.Name))
+		}
+		if n > c.limit {
+			break
+		}
+	}
+	return n
+}
+
+func (c *selectionCounter) fragment(name string) int {
+	if n, ok := c.fragments[name]; ok {
+		return n
+	}
+	if c.visiting[name] {
+		return c.limit + 1
+	}
+	def := c.doc.Fragments.ForName(name)
+	if def == nil {
+		return 0
+	}
+
+	c.visiting[name] = true
+	n := c.count(def.SelectionSet)
+	delete(c.visiting, name)
+
+	c.fragments[name] = n
+	return n
+}
+
+// add sums sizes, saturating at limit+1 so huge documents can't overflow.
+func (c *selectionCounter) add(a, b int) int {
+	if a > c.limit-b {
+		return c.limit + 1
+	}
+	return a + b
+}
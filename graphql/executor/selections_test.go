@@ -0,0 +1,33 @@
+package executor
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestCountSelections(t *testing.T) {
+	count := func(query string, limit int) int {
+		doc, err := parser.ParseQuery(&ast.Source{Input: query})
+		require.NoError(t, err)
+		return countSelections(doc, doc.Operations[0], limit)
+	}
+
+	require.Equal(t, 3, count("{ a { b c } }", 10))
+	require.Equal(t, 5, count("{ ...F ...F d } fragment F on Query { a { b } }", 10))
+
+	t.Run("fragment bombs stop at the limit", func(t *testing.T) {
+		query := "{ ...F0 }\nfragment F40 on Query { a }\n"
+		for i := 0; i < 40; i++ {
+			query += "fragment F" + strconv.Itoa(i) + " on Query { ...F" + strconv.Itoa(i+1) + " ...F" + strconv.Itoa(i+1) + " }\n"
+		}
+		require.Equal(t, 1001, count(query, 1000))
+	})
+
+	t.Run("cycles exceed the limit", func(t *testing.T) {
+		require.Equal(t, 101, count("{ ...A } fragment A on Query { ...B } fragment B on Query { ...A }", 100))
+	})
+}
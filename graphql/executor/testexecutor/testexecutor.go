@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"time"
 
 	"github.com/vektah/gqlparser/v2"
@@ -193,7 +194,7 @@ func (e *TestExecutor) SendNextSubscriptionMessage() {
 	select {
 	case e.next <- struct{}{}:
 	case <-time.After(1 * time.Second):
-		fmt.Println("WARNING: no active subscription")
+		slog.Warn("no active subscription")
 	}
 }
 
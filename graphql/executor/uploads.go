@@ -0,0 +1,72 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// uploadScalar is the scalar files are sent as, named by the multipart request spec.
+const uploadScalar = "Upload"
+
+// checkUploads returns an error for the first value of the Upload scalar given something else than files, in variables
+// or in the fields of input objects they hold. Files are only put in variables by transports supporting uploads, such
+// as transport.MultipartForm, so any other value was sent through a transport that can't carry them.
+func checkUploads(schema *ast.Schema, op *ast.OperationDefinition, vars map[string]interface{}) *gqlerror.Error {
+	if def := schema.Types[uploadScalar]; def == nil || def.Kind != ast.Scalar {
+		return nil
+	}
+	for _, v := range op.VariableDefinitions {
+		value, ok := vars[v.Variable]
+		if !ok {
+			continue
+		}
+		path, ok := notUpload(schema, v.Type, value)
+		if !ok {
+			continue
+		}
+		err := gqlerror.ErrorPosf(v.Position, "variable $%s%s must be a file, uploads require a multipart request", v.Variable, path)
+		errcode.Set(err, errcode.UploadRequiresMultipart)
+		return err
+	}
+	return nil
+}
+
+// notUpload returns the path in value of the first value of the Upload scalar that doesn't hold files, for a value of
+// type typ.
+func notUpload(schema *ast.Schema, typ *ast.Type, value interface{}) (string, bool) {
+	if value == nil {
+		return "", false
+	}
+	if typ.Elem != nil {
+		list, ok := value.([]interface{})
+		if !ok {
+			return notUpload(schema, typ.Elem, value)
+		}
+		for i, item := range list {
+			if path, ok := notUpload(schema, typ.Elem, item); ok {
+				return fmt.Sprintf("[%d]%s", i, path), true
+			}
+		}
+		return "", false
+	}
+	if typ.NamedType == uploadScalar {
+		_, ok := value.(graphql.Upload)
+		return "", !ok
+	}
+	def := schema.Types[typ.NamedType]
+	fields, ok := value.(map[string]interface{})
+	if def == nil || def.Kind != ast.InputObject || !ok {
+		return "", false
+	}
+	for _, f := range def.Fields {
+		if path, ok := notUpload(schema, f.Type, fields[f.Name]); ok {
+			return "." + f.Name + path, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,65 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+func TestCheckUploads(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		scalar Upload
+		type Query { name: String! }
+		type Mutation {
+			single(file: Upload): String!
+			multiple(files: [Upload!]!, name: String): String!
+			post(input: PostInput!): String!
+		}
+		input PostInput { title: String! attachments: [AttachmentInput!] }
+		input AttachmentInput { name: String! file: Upload! }
+	`})
+	check := func(query string, vars map[string]interface{}) *gqlerror.Error {
+		doc, err := parser.ParseQuery(&ast.Source{Input: query})
+		require.NoError(t, err)
+		return checkUploads(schema, doc.Operations[0], vars)
+	}
+	file := graphql.Upload{Filename: "a.txt"}
+
+	require.Nil(t, check(`mutation($file: Upload) { single(file: $file) }`, nil))
+	require.Nil(t, check(`mutation($file: Upload) { single(file: $file) }`, map[string]interface{}{"file": nil}))
+	require.Nil(t, check(`mutation($file: Upload) { single(file: $file) }`, map[string]interface{}{"file": file}))
+	require.Nil(t, check(`mutation($files: [Upload!]!, $name: String) { multiple(files: $files, name: $name) }`, map[string]interface{}{
+		"files": []interface{}{file, file},
+		"name":  "not a file",
+	}))
+
+	err := check(`mutation($file: Upload) { single(file: $file) }`, map[string]interface{}{"file": "a.txt"})
+	require.EqualError(t, err, "input:1: variable $file must be a file, uploads require a multipart request")
+	require.Equal(t, errcode.UploadRequiresMultipart, err.Extensions["code"])
+
+	err = check(`mutation($files: [Upload!]!) { multiple(files: $files) }`, map[string]interface{}{
+		"files": []interface{}{file, map[string]interface{}{"name": "b.txt"}},
+	})
+	require.EqualError(t, err, "input:1: variable $files[1] must be a file, uploads require a multipart request")
+
+	post := `mutation($input: PostInput!) { post(input: $input) }`
+	require.Nil(t, check(post, map[string]interface{}{"input": map[string]interface{}{
+		"title":       "a",
+		"attachments": []interface{}{map[string]interface{}{"name": "a", "file": file}},
+	}}))
+	err = check(post, map[string]interface{}{"input": map[string]interface{}{
+		"title": "a",
+		"attachments": []interface{}{
+			map[string]interface{}{"name": "a", "file": file},
+			map[string]interface{}{"name": "b", "file": "b.txt"},
+		},
+	}})
+	require.EqualError(t, err, "input:1: variable $input.attachments[1].file must be a file, uploads require a multipart request")
+}
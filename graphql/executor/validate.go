@@ -0,0 +1,41 @@
+package executor
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+const uniqueDirectivesRule = "UniqueDirectivesPerLocation"
+
+// validate validates doc against schema. The UniqueDirectivesPerLocation rule of the validator rejects any directive
+// used twice at a location, so its errors are replaced by the ones of a check allowing repeatable directives.
+func validate(schema *ast.Schema, doc *ast.QueryDocument) gqlerror.List {
+	listErr := validator.Validate(schema, doc)
+
+	var errs gqlerror.List
+	for _, err := range listErr {
+		if err.Rule != uniqueDirectivesRule {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) == len(listErr) {
+		return listErr
+	}
+
+	observers := &validator.Events{}
+	observers.OnDirectiveList(func(walker *validator.Walker, directives []*ast.Directive) {
+		seen := map[string]bool{}
+		for _, dir := range directives {
+			if seen[dir.Name] && (dir.Definition == nil || !dir.Definition.IsRepeatable) {
+				err := &gqlerror.Error{Rule: uniqueDirectivesRule}
+				validator.Message(`The directive "@%s" can only be used once at this location.`, dir.Name)(err)
+				validator.At(dir.Position)(err)
+				errs = append(errs, err)
+			}
+			seen[dir.Name] = true
+		}
+	})
+	validator.Walk(schema, doc, observers)
+	return errs
+}
@@ -0,0 +1,28 @@
+package graphql
+
+import "context"
+
+// FallbackWarningCode is the code of the warnings replacing the errors of fields with @fallback, see WithFallback.
+const FallbackWarningCode = "FIELD_FALLBACK"
+
+// WithFallback returns the value of resolve, or the value of fallback when resolve fails. The error of resolve then
+// goes through the error presenter and its message is added as a warning with the FallbackWarningCode code, so the
+// response carries the fallback value without failing. The error is returned as is when fallback fails too. Generated
+// code calls it for fields with @fallback.
+func WithFallback(ctx context.Context, fallback, resolve func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	v, err := resolve(ctx)
+	if err == nil {
+		return v, nil
+	}
+	fb, fbErr := fallback(ctx)
+	if fbErr != nil {
+		return v, err
+	}
+
+	message := err.Error()
+	if presented := getResponseContext(ctx).errorPresenter(ctx, ErrorOnPath(ctx, err)); presented != nil {
+		message = presented.Message
+	}
+	AddWarning(ctx, GetPath(ctx), message, FallbackWarningCode)
+	return fb, nil
+}
@@ -0,0 +1,72 @@
+package graphql
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// FetchKey identifies the fetches of a field from a downstream, such as a table or a service.
+type FetchKey struct {
+	Object string // The type of the parent of the field
+	Field  string
+	Label  string // The downstream fetched, as given to RecordFetch
+}
+
+// FetchCount is the number of times a fetch ran in an operation.
+type FetchCount struct {
+	FetchKey
+	Count int
+	Path  ast.Path // The path of the field the first time it fetched
+}
+
+// FetchTracker counts the fetches resolvers of an operation make, see RecordFetch and extension.NPlusOneDetection.
+type FetchTracker struct {
+	mu      sync.Mutex
+	fetches map[FetchKey]*FetchCount
+}
+
+// RecordFetch records a fetch from the downstream label by the field of ctx, in the FetchTracker of the operation.
+// Call it where resolvers reach a database or service without batching, fetches of dataloaders run outside of the
+// fields and are not counted. It does nothing for operations without a tracker. WithDependency records its calls.
+func RecordFetch(ctx context.Context, label string) {
+	if !HasOperationContext(ctx) {
+		return
+	}
+	t := GetOperationContext(ctx).FetchTracker
+	fc := GetFieldContext(ctx)
+	if t == nil || fc == nil {
+		return
+	}
+	key := FetchKey{Object: fc.Object, Field: fc.Field.Name, Label: label}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.fetches == nil {
+		t.fetches = map[FetchKey]*FetchCount{}
+	}
+	if c, ok := t.fetches[key]; ok {
+		c.Count++
+		return
+	}
+	t.fetches[key] = &FetchCount{FetchKey: key, Count: 1, Path: fc.Path()}
+}
+
+// Counts returns the fetches recorded so far, the most frequent first.
+func (t *FetchTracker) Counts() []FetchCount {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	counts := make([]FetchCount, 0, len(t.fetches))
+	for _, c := range t.fetches {
+		counts = append(counts, *c)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Path.String() < counts[j].Path.String()
+	})
+	return counts
+}
@@ -0,0 +1,124 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/internal/flight"
+)
+
+// FieldCacheStore stores the values of fields with the @cached directive, see extension.FieldCaching. Values are the
+// ones returned by resolvers, Get must return them with the same go type.
+type FieldCacheStore interface {
+	// Get looks up the value of key, ok is false when it is missing or expired.
+	Get(ctx context.Context, key string) (value interface{}, ok bool)
+
+	// Set stores value as the value of key for ttl.
+	Set(ctx context.Context, key string, value interface{}, ttl time.Duration)
+}
+
+// FieldCache caches the values of @cached fields in a FieldCacheStore. Concurrent misses of the same key share one call
+// of the resolver.
+type FieldCache struct {
+	Store   FieldCacheStore
+	flights flight.Group
+}
+
+// CachedField returns the value of key from the FieldCache of the operation, or the value resolve returns, stored for
+// ttl. Errors are not cached, and resolve is always called for operations without a FieldCache. Generated code calls it
+// for fields with @cached.
+func CachedField(ctx context.Context, key string, ttl time.Duration, resolve func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	var cache *FieldCache
+	if HasOperationContext(ctx) {
+		cache = GetOperationContext(ctx).FieldCache
+	}
+	if cache == nil || cache.Store == nil {
+		return resolve(ctx)
+	}
+
+	if v, ok := cache.Store.Get(ctx, key); ok {
+		return v, nil
+	}
+	return cache.flights.Do(key, func() (interface{}, error) {
+		v, err := resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cache.Store.Set(ctx, key, v, ttl)
+		return v, nil
+	})
+}
+
+// CacheKey joins parts into the key of a @cached field. Strings are used as is and pointers are dereferenced, numbers
+// and booleans are formatted and other values are encoded as JSON.
+func CacheKey(parts ...interface{}) string {
+	var sb strings.Builder
+	for _, part := range parts {
+		v := reflect.ValueOf(part)
+		for v.Kind() == reflect.Pointer && !v.IsNil() {
+			v = v.Elem()
+		}
+		switch v.Kind() {
+		case reflect.Invalid, reflect.Pointer:
+			sb.WriteString("null")
+		case reflect.String:
+			sb.WriteString(v.String())
+		case reflect.Bool:
+			sb.WriteString(strconv.FormatBool(v.Bool()))
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			fmt.Fprint(&sb, v.Interface())
+		default:
+			b, err := json.Marshal(v.Interface())
+			if err != nil {
+				fmt.Fprint(&sb, v.Interface())
+				continue
+			}
+			sb.Write(b)
+		}
+	}
+	return sb.String()
+}
+
+// MemoryFieldCacheStore is a FieldCacheStore keeping values in memory. Expired values are removed when they are read,
+// live values are never evicted, so it suits keys of bounded sets of objects.
+type MemoryFieldCacheStore struct {
+	mu      sync.Mutex
+	entries map[string]memoryFieldCacheEntry
+}
+
+type memoryFieldCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func NewMemoryFieldCacheStore() *MemoryFieldCacheStore {
+	return &MemoryFieldCacheStore{entries: map[string]memoryFieldCacheEntry{}}
+}
+
+func (s *MemoryFieldCacheStore) Get(_ context.Context, key string) (interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(s.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+func (s *MemoryFieldCacheStore) Set(_ context.Context, key string, value interface{}, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries[key] = memoryFieldCacheEntry{value: value, expires: time.Now().Add(ttl)}
+}
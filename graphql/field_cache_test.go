@@ -0,0 +1,54 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheKey(t *testing.T) {
+	id := "1"
+	var missing *int
+	require.Equal(t, "user:1:2:true:null", CacheKey("user:", &id, ":", 2, ":", true, ":", missing))
+	require.Equal(t, `Query.users{"filter":{"name":"a"},"first":2}`, CacheKey("Query.users", map[string]interface{}{
+		"first":  2,
+		"filter": map[string]interface{}{"name": "a"},
+	}))
+}
+
+func TestCachedField(t *testing.T) {
+	calls := 0
+	resolve := func(ctx context.Context) (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("unavailable")
+		}
+		return calls, nil
+	}
+
+	ctx := WithOperationContext(context.Background(), &OperationContext{})
+	_, err := CachedField(ctx, "key", time.Minute, resolve)
+	require.EqualError(t, err, "unavailable")
+	v, err := CachedField(ctx, "key", time.Minute, resolve)
+	require.NoError(t, err)
+	require.Equal(t, 2, v)
+
+	ctx = WithOperationContext(context.Background(), &OperationContext{
+		FieldCache: &FieldCache{Store: NewMemoryFieldCacheStore()},
+	})
+	_, err = CachedField(ctx, "key", time.Minute, resolve)
+	require.NoError(t, err)
+	_, err = CachedField(ctx, "expired", time.Nanosecond, resolve)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+
+	v, err = CachedField(ctx, "key", time.Minute, resolve)
+	require.NoError(t, err)
+	require.Equal(t, 3, v)
+	v, err = CachedField(ctx, "expired", time.Nanosecond, resolve)
+	require.NoError(t, err)
+	require.Equal(t, 5, v)
+}
@@ -0,0 +1,44 @@
+package graphql
+
+// FieldResolution tells where the value of a field comes from.
+type FieldResolution string
+
+const (
+	ResolvedByResolver FieldResolution = "resolver" // A method of the resolvers
+	ResolvedByMethod   FieldResolution = "method"   // A method of the model
+	ResolvedByField    FieldResolution = "field"    // A field of the model, or a key of a map model
+)
+
+// FieldExecution describes how the generated code executes a field.
+type FieldExecution struct {
+	Resolution FieldResolution `json:"resolution"`
+	// Concurrent is true for fields resolved in a goroutine of their own.
+	Concurrent bool `json:"concurrent,omitempty"`
+	// Directives holds the directives applied to the field at runtime, outermost first, including the builtin
+	// @fallback, @cached and @retry.
+	Directives []string `json:"directives,omitempty"`
+}
+
+// ExecutionDescriber is implemented by the executable schemas generated by gqlgen, describing how each field of an
+// object type is executed. ok is false for interface fields, introspection fields and fields that don't exist.
+type ExecutionDescriber interface {
+	FieldExecution(typeName, field string) (execution FieldExecution, ok bool)
+}
+
+// FieldMeta describes the Go implementation of a field, see the export_field_meta option of gqlgen.yml.
+type FieldMeta struct {
+	// GoName is the name of the resolver method, model method or model field the field is resolved with.
+	GoName     string
+	Resolution FieldResolution
+	Args       []ArgMeta
+	// Directives holds the directives applied to the field at runtime, like FieldExecution.Directives.
+	Directives []string
+}
+
+// ArgMeta describes an argument of a field and the parameter it is passed as.
+type ArgMeta struct {
+	Name   string
+	GoName string
+	// GoType is the type of the parameter, with the full import path of its package.
+	GoType string
+}
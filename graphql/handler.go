@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
@@ -28,6 +29,8 @@ type (
 		Variables     map[string]interface{} `json:"variables"`
 		Extensions    map[string]interface{} `json:"extensions"`
 		Headers       http.Header            `json:"headers"`
+		// DocumentID names a persisted document sent in place of the query, resolved by extension.PersistedDocuments.
+		DocumentID string `json:"documentId"`
 
 		ReadTime TraceTiming `json:"-"`
 	}
@@ -63,6 +66,12 @@ type (
 		MutateOperationParameters(ctx context.Context, request *RawParams) *gqlerror.Error
 	}
 
+	// OperationVariablesMutator is called once the query is parsed and validated, before the variables of the
+	// operation are. It allows filling in variables the client didn't send, or rewriting the ones it did.
+	OperationVariablesMutator interface {
+		MutateOperationVariables(ctx context.Context, op *ast.OperationDefinition, variables map[string]interface{}) (map[string]interface{}, *gqlerror.Error)
+	}
+
 	// OperationContextMutator is called after creating the request context, but before executing the root resolver.
 	OperationContextMutator interface {
 		MutateOperationContext(ctx context.Context, rc *OperationContext) *gqlerror.Error
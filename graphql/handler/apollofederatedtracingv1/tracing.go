@@ -3,7 +3,6 @@ package apollofederatedtracingv1
 import (
 	"context"
 	"encoding/base64"
-	"fmt"
 
 	"google.golang.org/protobuf/proto"
 
@@ -101,7 +100,7 @@ func (t *Tracer) InterceptResponse(ctx context.Context, next graphql.ResponseHan
 		// marshal the protobuf ...
 		p, err := proto.Marshal(tb.Trace)
 		if err != nil {
-			fmt.Print(err)
+			graphql.GetLogger(ctx).Warn("could not marshal federated trace", "error", err)
 		}
 
 		// ... then set the previously instantiated string as the base64 formatted string as required
@@ -65,6 +65,32 @@ func TestApolloTracing(t *testing.T) {
 	require.Equal(t, "String!", ftv1.Root.Child[0].Type)
 }
 
+func TestApolloTracing_clientInfo(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.POST{})
+	h.Use(extension.ClientIdentity{
+		Extract: func(ctx context.Context, params *graphql.RawParams) (graphql.ClientInfo, error) {
+			return graphql.ClientInfo{Name: "web", Version: "1.2.3"}, nil
+		},
+	})
+	h.Use(&apollofederatedtracingv1.Tracer{})
+
+	resp := doRequest(h, http.MethodPost, "/graphql", `{"query":"{ name }"}`)
+	var respData struct {
+		Extensions struct {
+			FTV1 string `json:"ftv1"`
+		} `json:"extensions"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &respData))
+	pbuf, err := base64.StdEncoding.DecodeString(respData.Extensions.FTV1)
+	require.NoError(t, err)
+
+	ftv1 := &generated.Trace{}
+	require.NoError(t, proto.Unmarshal(pbuf, ftv1))
+	require.Equal(t, "web", ftv1.ClientName)
+	require.Equal(t, "1.2.3", ftv1.ClientVersion)
+}
+
 func TestApolloTracing_Concurrent(t *testing.T) {
 	h := testserver.New()
 	h.AddTransport(transport.POST{})
@@ -2,7 +2,6 @@ package apollofederatedtracingv1
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"time"
 
@@ -47,15 +46,19 @@ func NewTreeBuilder() *TreeBuilder {
 // StartTimer marks the time using protobuf timestamp format for use in timing calculations
 func (tb *TreeBuilder) StartTimer(ctx context.Context) {
 	if tb.startTime != nil {
-		fmt.Println(fmt.Errorf("StartTimer called twice"))
+		graphql.GetLogger(ctx).Warn("StartTimer called twice")
 	}
 	if tb.stopped {
-		fmt.Println(fmt.Errorf("StartTimer called after StopTimer"))
+		graphql.GetLogger(ctx).Warn("StartTimer called after StopTimer")
 	}
 
 	rc := graphql.GetOperationContext(ctx)
 	start := rc.Stats.OperationStart
 
+	client := graphql.GetClientInfo(ctx)
+	tb.Trace.ClientName = client.Name
+	tb.Trace.ClientVersion = client.Version
+
 	tb.Trace.StartTime = timestamppb.New(start)
 	tb.startTime = &start
 }
@@ -63,10 +66,10 @@ func (tb *TreeBuilder) StartTimer(ctx context.Context) {
 // StopTimer marks the end of the timer, along with setting the related fields in the protobuf representation
 func (tb *TreeBuilder) StopTimer(ctx context.Context) {
 	if tb.startTime == nil {
-		fmt.Println(fmt.Errorf("StopTimer called before StartTimer"))
+		graphql.GetLogger(ctx).Warn("StopTimer called before StartTimer")
 	}
 	if tb.stopped {
-		fmt.Println(fmt.Errorf("StopTimer called twice"))
+		graphql.GetLogger(ctx).Warn("StopTimer called twice")
 	}
 
 	ts := graphql.Now().UTC()
@@ -79,11 +82,11 @@ func (tb *TreeBuilder) StopTimer(ctx context.Context) {
 // field as now - tree.StartTime; these are used by Apollo to calculate how fields are being resolved in the AST
 func (tb *TreeBuilder) WillResolveField(ctx context.Context) {
 	if tb.startTime == nil {
-		fmt.Println(fmt.Errorf("WillResolveField called before StartTimer"))
+		graphql.GetLogger(ctx).Warn("WillResolveField called before StartTimer")
 		return
 	}
 	if tb.stopped {
-		fmt.Println(fmt.Errorf("WillResolveField called after StopTimer"))
+		graphql.GetLogger(ctx).Warn("WillResolveField called after StopTimer")
 		return
 	}
 	fc := graphql.GetFieldContext(ctx)
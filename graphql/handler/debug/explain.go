@@ -0,0 +1,173 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/executor"
+)
+
+// ExplainPath is the path ExplainHandler is usually served on.
+const ExplainPath = "/debug/graphql/explain"
+
+// Explanation is the execution plan of an operation, see Explain.
+type Explanation struct {
+	Operation  string          `json:"operation,omitempty"`
+	Type       ast.Operation   `json:"type"`
+	Complexity int             `json:"complexity"`
+	Fields     []*PlannedField `json:"fields"`
+}
+
+// PlannedField is a field selected by an operation, along with how the generated code executes it. Fields selected
+// several times under the same response key are merged.
+type PlannedField struct {
+	// Path holds the response keys of the field and its parents, joined by dots.
+	Path string `json:"path"`
+	// Field is the field as Type.field, the type being an interface or union for fields of abstract types.
+	Field string `json:"field"`
+	Type  string `json:"type"`
+
+	// The execution of the field, left empty for fields of abstract types and introspection fields, or when the
+	// executable schema isn't a graphql.ExecutionDescriber.
+	graphql.FieldExecution
+	// Implementations holds the execution of fields of interfaces, by object implementing them.
+	Implementations map[string]graphql.FieldExecution `json:"implementations,omitempty"`
+
+	// Complexity is the complexity of the field and its selections.
+	Complexity int             `json:"complexity"`
+	Selections []*PlannedField `json:"selections,omitempty"`
+}
+
+// Explain returns the execution plan of the operation of rc: the tree of the fields it selects, which of them run a
+// resolver, a method or read a field of the model, the directives applied to them and their complexity. Nothing is
+// resolved.
+func Explain(es graphql.ExecutableSchema, rc *graphql.OperationContext) *Explanation {
+	total, costs := complexity.Breakdown(es, rc.Operation, rc.Variables)
+	costOf := make(map[string]int, len(costs))
+	for _, c := range costs {
+		key := c.Path + " " + c.Field
+		if c.Complexity > costOf[key] {
+			costOf[key] = c.Complexity
+		}
+	}
+
+	describer, _ := es.(graphql.ExecutionDescriber)
+	e := &Explanation{
+		Operation:  rc.Operation.Name,
+		Type:       rc.Operation.Operation,
+		Complexity: total,
+	}
+	planned := map[string]*PlannedField{}
+	// Selections returns parents right before their children, so the last field planned at a path is the parent of
+	// the fields below it.
+	last := map[string]*PlannedField{}
+	for _, sel := range rc.Selections() {
+		path := strings.Join(sel.Path, ".")
+		field := sel.ObjectDefinition.Name + "." + sel.Name
+		if f, ok := planned[path+" "+field]; ok {
+			last[path] = f
+			continue
+		}
+
+		f := &PlannedField{
+			Path:       path,
+			Field:      field,
+			Type:       sel.Definition.Type.String(),
+			Complexity: costOf[path+" "+field],
+		}
+		if describer != nil {
+			switch sel.ObjectDefinition.Kind {
+			case ast.Object:
+				f.FieldExecution, _ = describer.FieldExecution(sel.ObjectDefinition.Name, sel.Name)
+			case ast.Interface:
+				for _, impl := range es.Schema().GetPossibleTypes(sel.ObjectDefinition) {
+					if execution, ok := describer.FieldExecution(impl.Name, sel.Name); ok {
+						if f.Implementations == nil {
+							f.Implementations = map[string]graphql.FieldExecution{}
+						}
+						f.Implementations[impl.Name] = execution
+					}
+				}
+			}
+		}
+
+		planned[path+" "+field] = f
+		last[path] = f
+		if len(sel.Path) == 1 {
+			e.Fields = append(e.Fields, f)
+		} else if parent := last[strings.Join(sel.Path[:len(sel.Path)-1], ".")]; parent != nil {
+			parent.Selections = append(parent.Selections, f)
+		}
+	}
+	return e
+}
+
+// ExplainHandler returns a handler answering operations with their Explanation instead of executing them. Operations
+// are sent like to the GET and POST transports, and are parsed and validated against the schema of es, errors being
+// returned like the transports do.
+//
+// It is meant for development, don't serve it in production.
+func ExplainHandler(es graphql.ExecutableSchema) http.Handler {
+	exec := executor.New(es)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		params, err := explainParams(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(&graphql.Response{Errors: gqlerror.List{{Message: err.Error()}}})
+			return
+		}
+
+		rc, errs := exec.CreateOperationContext(graphql.StartOperationTrace(r.Context()), params)
+		if errs != nil {
+			w.WriteHeader(errcode.HTTPStatus(errs))
+			_ = json.NewEncoder(w).Encode(&graphql.Response{Errors: errs})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(Explain(es, rc))
+	})
+}
+
+func explainParams(r *http.Request) (*graphql.RawParams, error) {
+	params := &graphql.RawParams{}
+	switch r.Method {
+	case http.MethodGet:
+		query, err := url.ParseQuery(r.URL.RawQuery)
+		if err != nil {
+			return nil, err
+		}
+		params.Query = query.Get("query")
+		params.OperationName = query.Get("operationName")
+		if variables := query.Get("variables"); variables != "" {
+			if err := decodeJSON(variables, &params.Variables); err != nil {
+				return nil, fmt.Errorf("variables could not be decoded")
+			}
+		}
+	case http.MethodPost:
+		dec := json.NewDecoder(r.Body)
+		dec.UseNumber()
+		if err := dec.Decode(params); err != nil {
+			return nil, fmt.Errorf("json request body could not be decoded: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("%s requests are not supported", r.Method)
+	}
+	params.Headers = r.Header
+	return params, nil
+}
+
+func decodeJSON(s string, v interface{}) error {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
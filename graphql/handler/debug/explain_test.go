@@ -0,0 +1,116 @@
+package debug_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/debug"
+)
+
+type describedSchema struct {
+	*graphql.ExecutableSchemaMock
+	executions map[string]graphql.FieldExecution
+}
+
+func (s describedSchema) FieldExecution(typeName, field string) (graphql.FieldExecution, bool) {
+	if execution, ok := s.executions[typeName+"."+field]; ok {
+		return execution, true
+	}
+	if def := s.Schema().Types[typeName]; def != nil && def.Kind == ast.Object && def.Fields.ForName(field) != nil {
+		return graphql.FieldExecution{Resolution: graphql.ResolvedByField}, true
+	}
+	return graphql.FieldExecution{}, false
+}
+
+func TestExplainHandler(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { user(id: ID!): User node: Node }
+		interface Node { id: ID! }
+		type User implements Node { id: ID! name: String! friends: [User!]! }
+	`})
+	es := describedSchema{
+		ExecutableSchemaMock: &graphql.ExecutableSchemaMock{
+			SchemaFunc: func() *ast.Schema { return schema },
+			ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+				if typeName+"."+fieldName == "User.friends" {
+					return 10 * childComplexity, true
+				}
+				return 0, false
+			},
+			ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+				panic("explained operations are not executed")
+			},
+		},
+		executions: map[string]graphql.FieldExecution{
+			"Query.user":   {Resolution: graphql.ResolvedByResolver, Concurrent: true},
+			"User.name":    {Resolution: graphql.ResolvedByMethod},
+			"User.friends": {Resolution: graphql.ResolvedByResolver, Concurrent: true, Directives: []string{"cached"}},
+		},
+	}
+	h := debug.ExplainHandler(es)
+
+	t.Run("explains operations", func(t *testing.T) {
+		query := `query Profile { user(id: 1) { name friends { id } friends { name } } node { id } }`
+		r := httptest.NewRequest("POST", debug.ExplainPath, strings.NewReader(`{"query":"`+query+`"}`))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"operation":"Profile","type":"query","complexity":24,"fields":[
+			{"path":"user","field":"Query.user","type":"User","resolution":"resolver","concurrent":true,"complexity":22,"selections":[
+				{"path":"user.name","field":"User.name","type":"String!","resolution":"method","complexity":1},
+				{"path":"user.friends","field":"User.friends","type":"[User!]!","resolution":"resolver","concurrent":true,"directives":["cached"],"complexity":10,"selections":[
+					{"path":"user.friends.id","field":"User.id","type":"ID!","resolution":"field","complexity":1},
+					{"path":"user.friends.name","field":"User.name","type":"String!","resolution":"method","complexity":1}
+				]}
+			]},
+			{"path":"node","field":"Query.node","type":"Node","resolution":"field","complexity":2,"selections":[
+				{"path":"node.id","field":"Node.id","type":"ID!","resolution":"","implementations":{"User":{"resolution":"field"}},"complexity":1}
+			]}
+		]}`, w.Body.String())
+	})
+
+	t.Run("reads operations from the query string", func(t *testing.T) {
+		params := url.Values{
+			"query":     {`query($id: ID!) { user(id: $id) { id } }`},
+			"variables": {`{"id":"1"}`},
+		}
+		r := httptest.NewRequest("GET", debug.ExplainPath+"?"+params.Encode(), nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"type":"query","complexity":2,"fields":[
+			{"path":"user","field":"Query.user","type":"User","resolution":"resolver","concurrent":true,"complexity":2,"selections":[
+				{"path":"user.id","field":"User.id","type":"ID!","resolution":"field","complexity":1}
+			]}
+		]}`, w.Body.String())
+	})
+
+	t.Run("returns validation errors", func(t *testing.T) {
+		r := httptest.NewRequest("POST", debug.ExplainPath, strings.NewReader(`{"query":"{ missing }"}`))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		require.Contains(t, w.Body.String(), `Cannot query field \"missing\" on type \"Query\".`)
+	})
+
+	t.Run("rejects other methods", func(t *testing.T) {
+		r := httptest.NewRequest("PUT", debug.ExplainPath, nil)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.JSONEq(t, `{"errors":[{"message":"PUT requests are not supported"}],"data":null}`, w.Body.String())
+	})
+}
@@ -18,6 +18,11 @@ const (
 	errPersistedQueryNotFoundCode = "PERSISTED_QUERY_NOT_FOUND"
 )
 
+func init() {
+	// the client sends the operation again along with the query
+	errcode.Register(errPersistedQueryNotFoundCode, errcode.Metadata{Kind: errcode.KindUser, Retryable: true})
+}
+
 // AutomaticPersistedQuery saves client upload by optimistically sending only the hashes of queries, if the server
 // does not yet know what the query is for the hash it will respond telling the client to send the query along with the
 // hash in the next request.
@@ -32,12 +37,17 @@ type ApqStats struct {
 
 	// SentQuery is true if the incoming request sent the full query
 	SentQuery bool
+
+	// Signature of the operation, see graphql.OperationSignature. Clients hash the query as they send it, so queries
+	// that only differ by their formatting have different hashes but the same signature.
+	Signature string
 }
 
 const apqExtension = "APQ"
 
 var _ interface {
 	graphql.OperationParameterMutator
+	graphql.OperationContextMutator
 	graphql.HandlerExtension
 } = AutomaticPersistedQuery{}
 
@@ -97,6 +107,13 @@ func (a AutomaticPersistedQuery) MutateOperationParameters(ctx context.Context,
 	return nil
 }
 
+func (a AutomaticPersistedQuery) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if stats, ok := rc.Stats.GetExtension(apqExtension).(*ApqStats); ok {
+		stats.Signature = graphql.OperationSignature(rc.Doc, rc.OperationName)
+	}
+	return nil
+}
+
 func GetApqStats(ctx context.Context) *ApqStats {
 	rc := graphql.GetOperationContext(ctx)
 	if rc == nil {
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -31,6 +33,10 @@ func TestAPQIntegration(t *testing.T) {
 	require.NotNil(t, stats)
 	require.True(t, stats.SentQuery)
 	require.Equal(t, "30166fc3298853f22709fce1e4a00e98f1b6a3160eaaaf9cb3b7db6a16073b07", stats.Hash)
+
+	doc, err := parser.ParseQuery(&ast.Source{Input: "{ name }"})
+	require.NoError(t, err)
+	require.Equal(t, graphql.OperationSignature(doc, ""), stats.Signature)
 }
 
 func TestAPQ(t *testing.T) {
@@ -0,0 +1,260 @@
+package extension
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// RedactedValue replaces the values of the arguments redacted by AuditLog.
+const RedactedValue = "[REDACTED]"
+
+// AuditStatus is the outcome of an audited mutation.
+type AuditStatus string
+
+const (
+	// AuditSucceeded mutations returned no errors.
+	AuditSucceeded AuditStatus = "succeeded"
+	// AuditPartial mutations returned data and errors, some of their fields may have been applied.
+	AuditPartial AuditStatus = "partial"
+	// AuditFailed mutations returned errors and no data.
+	AuditFailed AuditStatus = "failed"
+)
+
+// AuditEntry records the execution of a mutation.
+type AuditEntry struct {
+	// ID identifies the entry, it is the same for every attempt to write it so sinks can drop duplicates.
+	ID            string
+	Time          time.Time
+	Duration      time.Duration
+	RequestID     string
+	Client        graphql.ClientInfo
+	Viewer        string
+	OperationName string
+	Fields        []AuditField
+	Status        AuditStatus
+	Errors        []string
+}
+
+// AuditField is a root field of an audited mutation.
+type AuditField struct {
+	Name  string
+	Alias string
+	// Arguments with the variables of the operation replaced by their values, and redacted arguments by
+	// RedactedValue.
+	Arguments map[string]interface{}
+}
+
+// AuditSink stores audit entries, like a database table or a message queue. Write is retried when it fails, so it
+// may be called several times with the same entry.
+type AuditSink interface {
+	Write(ctx context.Context, entry AuditEntry) error
+}
+
+// AuditSinkFunc is an AuditSink calling itself.
+type AuditSinkFunc func(ctx context.Context, entry AuditEntry) error
+
+func (f AuditSinkFunc) Write(ctx context.Context, entry AuditEntry) error {
+	return f(ctx, entry)
+}
+
+// AuditLog records every executed mutation to Sink: its root fields and their arguments, who sent it and whether it
+// succeeded. Each operation is recorded once, when its last response has been produced, by a write in the background
+// that doesn't hold up the response: failed writes are retried, and entries that still can't be written are handed to
+// OnError. Call Wait on shutdown so pending entries aren't lost. Operations rejected before execution are not
+// recorded.
+type AuditLog struct {
+	Sink AuditSink
+
+	// Viewer returns who sent the operation, like the subject of its token.
+	Viewer func(ctx context.Context) string
+
+	// Redact lists argument names, matched at any depth of input objects, whose values are replaced by RedactedValue.
+	Redact []string
+
+	// Attempts is the number of times an entry is written before giving up, 3 when zero, and RetryDelay the time
+	// between attempts, doubled after each of them, 100ms when zero.
+	Attempts   int
+	RetryDelay time.Duration
+
+	// OnError is called with the entries that could not be written. They are logged with graphql.GetLogger when nil.
+	OnError func(ctx context.Context, entry AuditEntry, err error)
+
+	mutation string
+	pending  *sync.WaitGroup
+}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = &AuditLog{}
+
+func (a AuditLog) ExtensionName() string {
+	return "AuditLog"
+}
+
+func (a *AuditLog) Validate(schema graphql.ExecutableSchema) error {
+	if a.Sink == nil {
+		return fmt.Errorf("AuditLog.Sink can not be nil")
+	}
+	if m := schema.Schema().Mutation; m != nil {
+		a.mutation = m.Name
+	}
+	a.pending = &sync.WaitGroup{}
+	return nil
+}
+
+// Wait blocks until the entries being written are written or handed to OnError.
+func (a *AuditLog) Wait() {
+	if a.pending != nil {
+		a.pending.Wait()
+	}
+}
+
+func (a AuditLog) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	if rc.Operation == nil || rc.Operation.Operation != ast.Mutation {
+		return next(ctx)
+	}
+
+	start := graphql.Now()
+	entry := AuditEntry{
+		ID:            newAuditID(),
+		Time:          start,
+		RequestID:     rc.RequestID,
+		Client:        rc.ClientInfo,
+		OperationName: rc.Operation.Name,
+		Fields:        a.fields(rc),
+	}
+	if a.Viewer != nil {
+		entry.Viewer = a.Viewer(ctx)
+	}
+
+	var (
+		mu      sync.Mutex
+		hasData bool
+		once    sync.Once
+	)
+	finish := func() {
+		once.Do(func() {
+			mu.Lock()
+			defer mu.Unlock()
+			entry.Duration = graphql.Now().Sub(start)
+			entry.Status = AuditSucceeded
+			if len(entry.Errors) > 0 {
+				entry.Status = AuditFailed
+				if hasData {
+					entry.Status = AuditPartial
+				}
+			}
+			a.pending.Add(1)
+			go func(entry AuditEntry) {
+				defer a.pending.Done()
+				a.write(ctx, entry)
+			}(entry)
+		})
+	}
+	// the operation is recorded even when the client goes away before its last response
+	context.AfterFunc(ctx, finish)
+
+	responses := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil {
+			finish()
+			return nil
+		}
+		mu.Lock()
+		if len(resp.Data) > 0 && string(resp.Data) != "null" {
+			hasData = true
+		}
+		for _, err := range resp.Errors {
+			entry.Errors = append(entry.Errors, err.Message)
+		}
+		mu.Unlock()
+		if resp.HasNext == nil || !*resp.HasNext {
+			finish()
+		}
+		return resp
+	}
+}
+
+func (a AuditLog) fields(rc *graphql.OperationContext) []AuditField {
+	collected := graphql.CollectFields(rc, rc.Operation.SelectionSet, []string{a.mutation})
+	fields := make([]AuditField, 0, len(collected))
+	for _, f := range collected {
+		args := f.ArgumentMap(rc.Variables)
+		for name, value := range args {
+			args[name] = a.redact(name, value)
+		}
+		fields = append(fields, AuditField{Name: f.Name, Alias: f.Alias, Arguments: args})
+	}
+	return fields
+}
+
+// redact returns value, or RedactedValue if name is redacted, with the redacted fields of input objects replaced.
+func (a AuditLog) redact(name string, value interface{}) interface{} {
+	for _, r := range a.Redact {
+		if r == name {
+			return RedactedValue
+		}
+	}
+	switch value := value.(type) {
+	case map[string]interface{}:
+		redacted := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			redacted[k] = a.redact(k, v)
+		}
+		return redacted
+	case []interface{}:
+		redacted := make([]interface{}, len(value))
+		for i, v := range value {
+			redacted[i] = a.redact("", v)
+		}
+		return redacted
+	}
+	return value
+}
+
+func (a AuditLog) write(ctx context.Context, entry AuditEntry) {
+	attempts := a.Attempts
+	if attempts <= 0 {
+		attempts = 3
+	}
+	delay := a.RetryDelay
+	if delay <= 0 {
+		delay = 100 * time.Millisecond
+	}
+
+	// the entry is written even if the client is gone, the mutation was executed
+	ctx = context.WithoutCancel(ctx)
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			<-time.After(delay)
+			delay *= 2
+		}
+		if err = a.Sink.Write(ctx, entry); err == nil {
+			return
+		}
+	}
+
+	if a.OnError != nil {
+		a.OnError(ctx, entry, err)
+		return
+	}
+	graphql.GetLogger(ctx).Error("could not write audit entry", "error", err, "entry", entry)
+}
+
+func newAuditID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,244 @@
+package extension_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func newAuditServer(audit *extension.AuditLog) *handler.Server {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { name: String! }
+		type Mutation { createUser(input: UserInput!, note: String): String }
+		input UserInput { name: String! password: String! tags: [String!] }
+	`})
+	h := handler.New(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			rc := graphql.GetOperationContext(ctx)
+			if rc.Operation.Name == "Failing" {
+				return graphql.OneShot(graphql.ErrorResponse(ctx, "cannot create user"))
+			}
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"createUser":"1"}`)})
+		},
+	})
+	h.AddTransport(transport.POST{})
+	h.Use(audit)
+	return h
+}
+
+func TestAuditLog(t *testing.T) {
+	var entries []extension.AuditEntry
+	audit := &extension.AuditLog{
+		Sink: extension.AuditSinkFunc(func(ctx context.Context, entry extension.AuditEntry) error {
+			entries = append(entries, entry)
+			return nil
+		}),
+		Viewer: func(ctx context.Context) string { return "alice" },
+		Redact: []string{"password"},
+	}
+	h := newAuditServer(audit)
+
+	t.Run("records mutations", func(t *testing.T) {
+		entries = nil
+		resp := doRequest(h, "POST", "/graphql", `{"query":"mutation Create($name: String!) { created: createUser(input: {name: $name, password: \"secret\", tags: [\"a\"]}) }","variables":{"name":"bob"}}`)
+		require.Equal(t, `{"data":{"createUser":"1"}}`, resp.Body.String())
+
+		audit.Wait()
+		require.Len(t, entries, 1)
+		entry := entries[0]
+		require.Len(t, entry.ID, 32)
+		require.Equal(t, "alice", entry.Viewer)
+		require.Equal(t, "Create", entry.OperationName)
+		require.Equal(t, extension.AuditSucceeded, entry.Status)
+		require.Empty(t, entry.Errors)
+		require.Equal(t, []extension.AuditField{{
+			Name:  "createUser",
+			Alias: "created",
+			Arguments: map[string]interface{}{
+				"input": map[string]interface{}{"name": "bob", "password": extension.RedactedValue, "tags": []interface{}{"a"}},
+			},
+		}}, entry.Fields)
+	})
+
+	t.Run("records failures", func(t *testing.T) {
+		entries = nil
+		doRequest(h, "POST", "/graphql", `{"query":"mutation Failing { createUser(input: {name: \"bob\", password: \"secret\"}) }"}`)
+
+		audit.Wait()
+		require.Len(t, entries, 1)
+		require.Equal(t, extension.AuditFailed, entries[0].Status)
+		require.Equal(t, []string{"cannot create user"}, entries[0].Errors)
+	})
+
+	t.Run("ignores queries and rejected mutations", func(t *testing.T) {
+		entries = nil
+		doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		doRequest(h, "POST", "/graphql", `{"query":"mutation { createUser }"}`)
+		audit.Wait()
+		require.Empty(t, entries)
+	})
+
+	t.Run("does not hold up the response", func(t *testing.T) {
+		release := make(chan struct{})
+		written := make(chan extension.AuditEntry, 1)
+		audit := &extension.AuditLog{
+			Sink: extension.AuditSinkFunc(func(ctx context.Context, entry extension.AuditEntry) error {
+				<-release
+				written <- entry
+				return nil
+			}),
+		}
+		h := newAuditServer(audit)
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"mutation { createUser(input: {name: \"bob\", password: \"secret\"}) }"}`)
+		require.Equal(t, `{"data":{"createUser":"1"}}`, resp.Body.String())
+		require.Empty(t, written)
+
+		close(release)
+		audit.Wait()
+		require.Equal(t, "createUser", (<-written).Fields[0].Name)
+	})
+}
+
+func TestAuditLogRetries(t *testing.T) {
+	attempts := 0
+	var failed *extension.AuditEntry
+	audit := &extension.AuditLog{
+		Sink: extension.AuditSinkFunc(func(ctx context.Context, entry extension.AuditEntry) error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("unavailable")
+			}
+			return nil
+		}),
+		RetryDelay: 1,
+		OnError: func(ctx context.Context, entry extension.AuditEntry, err error) {
+			failed = &entry
+		},
+	}
+	h := newAuditServer(audit)
+	const mutation = `{"query":"mutation { createUser(input: {name: \"bob\", password: \"secret\"}) }"}`
+
+	t.Run("retries failed writes", func(t *testing.T) {
+		doRequest(h, "POST", "/graphql", mutation)
+		audit.Wait()
+		require.Equal(t, 3, attempts)
+		require.Nil(t, failed)
+	})
+
+	t.Run("hands entries over after the last attempt", func(t *testing.T) {
+		attempts = -10
+		doRequest(h, "POST", "/graphql", mutation)
+		audit.Wait()
+		require.Equal(t, -7, attempts)
+		require.NotNil(t, failed)
+		require.Equal(t, "createUser", failed.Fields[0].Name)
+	})
+
+	t.Run("logs entries without OnError", func(t *testing.T) {
+		var log bytes.Buffer
+		audit := &extension.AuditLog{
+			Sink: extension.AuditSinkFunc(func(ctx context.Context, entry extension.AuditEntry) error {
+				return errors.New("unavailable")
+			}),
+			Attempts: 1,
+		}
+		h := newAuditServer(audit)
+		h.SetLogger(slog.New(slog.NewTextHandler(&log, nil)))
+		doRequest(h, "POST", "/graphql", mutation)
+		audit.Wait()
+		require.Contains(t, log.String(), `msg="could not write audit entry"`)
+		require.Contains(t, log.String(), `error=unavailable`)
+	})
+}
+
+func TestAuditLogOncePerOperation(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { name: String! }
+		type Mutation { createUser: User }
+		type User { id: ID! name: String! }
+	`})
+	written := make(chan extension.AuditEntry, 2)
+	audit := &extension.AuditLog{
+		Sink: extension.AuditSinkFunc(func(ctx context.Context, entry extension.AuditEntry) error {
+			written <- entry
+			return nil
+		}),
+	}
+	h := handler.New(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			hasNext, done := true, false
+			responses := []*graphql.Response{
+				{Data: []byte(`{"createUser":{"id":"1"}}`), HasNext: &hasNext},
+				{Data: []byte(`null`), Errors: gqlerror.List{{Message: "name unavailable"}}, HasNext: &done},
+			}
+			return func(ctx context.Context) *graphql.Response {
+				if len(responses) == 0 {
+					return nil
+				}
+				resp := responses[0]
+				responses = responses[1:]
+				return resp
+			}
+		},
+	})
+	h.AddTransport(transport.SSE{})
+	h.AddTransport(transport.POST{})
+	h.Use(audit)
+	const mutation = `{"query":"mutation { createUser { id ... @defer { name } } }"}`
+
+	t.Run("records the operation after its last response", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(mutation))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/event-stream")
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		audit.Wait()
+
+		require.Len(t, written, 1)
+		entry := <-written
+		require.Equal(t, extension.AuditPartial, entry.Status)
+		require.Equal(t, []string{"name unavailable"}, entry.Errors)
+	})
+
+	t.Run("records the operation when the request ends first", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		// POST only sends the first response
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(mutation)).WithContext(ctx)
+		r.Header.Set("Content-Type", "application/json")
+		h.ServeHTTP(httptest.NewRecorder(), r)
+		require.Empty(t, written)
+
+		cancel()
+		select {
+		case entry := <-written:
+			require.Equal(t, extension.AuditSucceeded, entry.Status)
+		case <-time.After(time.Second):
+			t.Fatal("the operation was not recorded")
+		}
+		audit.Wait()
+		require.Empty(t, written)
+	})
+}
@@ -0,0 +1,42 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CircuitBreaking runs the calls resolvers make to dependencies with graphql.WithDependency through a circuit breaker
+// per dependency, created by New the first time the dependency is called and shared by all operations. Calls to an
+// unhealthy dependency fail without being run, with a DEPENDENCY_UNAVAILABLE error on the fields relying on it, while
+// the rest of the response is resolved.
+type CircuitBreaking struct {
+	New func(dependency string) graphql.CircuitBreaker
+
+	breakers *graphql.CircuitBreakers
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &CircuitBreaking{}
+
+func (c CircuitBreaking) ExtensionName() string {
+	return "CircuitBreaking"
+}
+
+func (c *CircuitBreaking) Validate(schema graphql.ExecutableSchema) error {
+	if c.New == nil {
+		return fmt.Errorf("CircuitBreaking.New can not be nil")
+	}
+	c.breakers = &graphql.CircuitBreakers{New: c.New}
+	return nil
+}
+
+func (c CircuitBreaking) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	rc.CircuitBreakers = c.breakers
+	return nil
+}
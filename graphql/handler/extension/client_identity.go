@@ -0,0 +1,75 @@
+package extension
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const (
+	// DefaultClientNameHeader and DefaultClientVersionHeader are the headers Apollo clients identify themselves with.
+	DefaultClientNameHeader    = "apollographql-client-name"
+	DefaultClientVersionHeader = "apollographql-client-version"
+)
+
+// ClientIdentity sets the graphql.ClientInfo of every operation, read by extensions that report or limit usage per
+// client. By default the client is named by the apollographql-client-name and apollographql-client-version headers.
+type ClientIdentity struct {
+	// NameHeader and VersionHeader replace the default headers.
+	NameHeader    string
+	VersionHeader string
+
+	// Extract, when set, identifies clients in place of the headers, for example from the claims of a JWT. Errors
+	// returned by it fail the operation. FromHeaders gives the identity read from the headers, to fall back on.
+	Extract func(ctx context.Context, params *graphql.RawParams) (graphql.ClientInfo, error)
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = ClientIdentity{}
+
+func (c ClientIdentity) ExtensionName() string {
+	return "ClientIdentity"
+}
+
+func (c ClientIdentity) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (c ClientIdentity) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if c.Extract == nil {
+		graphql.GetOperationContext(ctx).ClientInfo = c.FromHeaders(rawParams.Headers)
+		return nil
+	}
+
+	info, err := c.Extract(ctx, rawParams)
+	if err != nil {
+		var gqlErr *gqlerror.Error
+		if errors.As(err, &gqlErr) {
+			return gqlErr
+		}
+		return gqlerror.Errorf("%s", err.Error())
+	}
+	graphql.GetOperationContext(ctx).ClientInfo = info
+	return nil
+}
+
+// FromHeaders returns the client named by the headers.
+func (c ClientIdentity) FromHeaders(headers http.Header) graphql.ClientInfo {
+	nameHeader, versionHeader := c.NameHeader, c.VersionHeader
+	if nameHeader == "" {
+		nameHeader = DefaultClientNameHeader
+	}
+	if versionHeader == "" {
+		versionHeader = DefaultClientVersionHeader
+	}
+	return graphql.ClientInfo{
+		Name:    headers.Get(nameHeader),
+		Version: headers.Get(versionHeader),
+	}
+}
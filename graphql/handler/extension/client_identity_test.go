@@ -0,0 +1,77 @@
+package extension_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestClientIdentity(t *testing.T) {
+	var client graphql.ClientInfo
+	newServer := func(ext extension.ClientIdentity) *testserver.TestServer {
+		h := testserver.New()
+		h.Use(ext)
+		h.AddTransport(&transport.POST{})
+		h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			client = graphql.GetClientInfo(ctx)
+			return next(ctx)
+		})
+		return h
+	}
+	request := func(h *testserver.TestServer, headers map[string]string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("apollo headers", func(t *testing.T) {
+		request(newServer(extension.ClientIdentity{}), map[string]string{
+			"apollographql-client-name":    "web",
+			"apollographql-client-version": "1.2.3",
+		})
+		require.Equal(t, graphql.ClientInfo{Name: "web", Version: "1.2.3"}, client)
+	})
+
+	t.Run("custom headers", func(t *testing.T) {
+		request(newServer(extension.ClientIdentity{NameHeader: "X-Client", VersionHeader: "X-Client-Version"}), map[string]string{
+			"X-Client":                  "ios",
+			"X-Client-Version":          "42",
+			"apollographql-client-name": "web",
+		})
+		require.Equal(t, graphql.ClientInfo{Name: "ios", Version: "42"}, client)
+	})
+
+	t.Run("custom extractor", func(t *testing.T) {
+		ext := extension.ClientIdentity{}
+		ext.Extract = func(ctx context.Context, params *graphql.RawParams) (graphql.ClientInfo, error) {
+			token := params.Headers.Get("Authorization")
+			if token == "bad" {
+				return graphql.ClientInfo{}, errors.New("invalid token")
+			}
+			info := ext.FromHeaders(params.Headers)
+			info.Attributes = map[string]string{"sub": token}
+			return info, nil
+		}
+		h := newServer(ext)
+
+		request(h, map[string]string{"Authorization": "user-1", "apollographql-client-name": "web"})
+		require.Equal(t, graphql.ClientInfo{Name: "web", Attributes: map[string]string{"sub": "user-1"}}, client)
+
+		resp := request(h, map[string]string{"Authorization": "bad"})
+		require.Equal(t, `{"errors":[{"message":"invalid token"}],"data":null}`, resp.Body.String())
+	})
+}
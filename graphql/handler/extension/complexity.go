@@ -3,7 +3,9 @@ package extension
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/complexity"
@@ -19,6 +21,18 @@ const errComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
 type ComplexityLimit struct {
 	Func func(ctx context.Context, rc *graphql.OperationContext) int
 
+	// DebugHeader names a request header which, set to true, adds the fields adding the most complexity to the error
+	// of rejected operations, in a "complexityBreakdown" extension listing complexity.FieldCost items. Clients can then
+	// fix their operations without access to the server logs. Breakdowns are never added when empty.
+	DebugHeader string
+
+	// AllowDebug reports whether the client of the request in ctx may ask for breakdowns with DebugHeader. The
+	// breakdown costs memory in the size of the operation, so it is off when nil.
+	AllowDebug func(ctx context.Context) bool
+
+	// DebugFields is the number of fields of breakdowns, 10 when zero.
+	DebugFields int
+
 	es graphql.ExecutableSchema
 }
 
@@ -72,12 +86,35 @@ func (c ComplexityLimit) MutateOperationContext(ctx context.Context, rc *graphql
 	if complexityCalcs > limit {
 		err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", complexityCalcs, limit)
 		errcode.Set(err, errComplexityLimit)
+		if c.debug(ctx, rc) {
+			err.Extensions["complexityBreakdown"] = c.breakdown(op, rc.Variables)
+		}
 		return err
 	}
 
 	return nil
 }
 
+func (c ComplexityLimit) debug(ctx context.Context, rc *graphql.OperationContext) bool {
+	if c.DebugHeader == "" || c.AllowDebug == nil || !c.AllowDebug(ctx) {
+		return false
+	}
+	on, _ := strconv.ParseBool(rc.Headers.Get(c.DebugHeader))
+	return on
+}
+
+func (c ComplexityLimit) breakdown(op *ast.OperationDefinition, vars map[string]interface{}) []complexity.FieldCost {
+	_, costs := complexity.Breakdown(c.es, op, vars)
+	n := c.DebugFields
+	if n <= 0 {
+		n = 10
+	}
+	if len(costs) > n {
+		costs = costs[:n]
+	}
+	return costs
+}
+
 func GetComplexityStats(ctx context.Context) *ComplexityStats {
 	rc := graphql.GetOperationContext(ctx)
 	if rc == nil {
@@ -0,0 +1,85 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DefaultEstimateKey is the request extension ComplexityEstimate reads when none is set.
+const DefaultEstimateKey = "estimateOnly"
+
+const complexityEstimateExtension = "ComplexityEstimate"
+
+// ComplexityEstimate answers operations sent with the estimateOnly extension set to true with their complexity instead
+// of executing them, so clients and tools can check their budget before sending an operation for real:
+//
+//	{"query": "{ users { name } }", "extensions": {"estimateOnly": true}}
+//
+// is answered with {"data":null,"extensions":{"complexity":{"estimate":12}}}. The limit of ComplexityLimit is added as
+// "limit" when it is used as well, operations exceeding it keep failing with its error.
+type ComplexityEstimate struct {
+	// Key is the request extension asking for an estimate, DefaultEstimateKey when empty.
+	Key string
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = &ComplexityEstimate{}
+
+// EstimateStats is the complexity returned for an estimateOnly operation.
+type EstimateStats struct {
+	Estimate int `json:"estimate"`
+	Limit    int `json:"limit,omitempty"`
+}
+
+func (c ComplexityEstimate) ExtensionName() string {
+	return complexityEstimateExtension
+}
+
+func (c *ComplexityEstimate) Validate(schema graphql.ExecutableSchema) error {
+	c.es = schema
+	return nil
+}
+
+func (c ComplexityEstimate) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	key := c.Key
+	if key == "" {
+		key = DefaultEstimateKey
+	}
+	switch v := rawParams.Extensions[key].(type) {
+	case nil:
+	case bool:
+		if v {
+			graphql.GetOperationContext(ctx).Stats.SetExtension(complexityEstimateExtension, true)
+		}
+	default:
+		return gqlerror.Errorf("%s extension must be a boolean, got %T", key, v)
+	}
+	return nil
+}
+
+func (c ComplexityEstimate) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	if estimate, _ := rc.Stats.GetExtension(complexityEstimateExtension).(bool); !estimate {
+		return next(ctx)
+	}
+
+	stats := &EstimateStats{}
+	if limit := GetComplexityStats(ctx); limit != nil {
+		stats.Estimate = limit.Complexity
+		stats.Limit = limit.ComplexityLimit
+	} else {
+		stats.Estimate = complexity.Calculate(c.es, rc.Operation, rc.Variables)
+	}
+	return graphql.OneShot(&graphql.Response{
+		Extensions: map[string]interface{}{"complexity": stats},
+	})
+}
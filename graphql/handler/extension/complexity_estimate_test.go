@@ -0,0 +1,60 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestComplexityEstimate(t *testing.T) {
+	h := testserver.New()
+	h.Use(&extension.ComplexityEstimate{})
+	h.AddTransport(&transport.POST{})
+	h.SetCalculatedComplexity(3)
+	executed := false
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		executed = true
+		return next(ctx)
+	})
+
+	t.Run("estimates without executing", func(t *testing.T) {
+		executed = false
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }","extensions":{"estimateOnly":true}}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"data":null,"extensions":{"complexity":{"estimate":3}}}`, resp.Body.String())
+		require.False(t, executed)
+	})
+
+	t.Run("executes other operations", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }","extensions":{"estimateOnly":false}}`)
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.True(t, executed)
+	})
+
+	t.Run("rejects invalid values", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }","extensions":{"estimateOnly":"yes"}}`)
+		require.Equal(t, `{"errors":[{"message":"estimateOnly extension must be a boolean, got string"}],"data":null}`, resp.Body.String())
+	})
+}
+
+func TestComplexityEstimateWithLimit(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.FixedComplexityLimit(5))
+	h.Use(&extension.ComplexityEstimate{Key: "cost"})
+	h.AddTransport(&transport.POST{})
+	h.SetCalculatedComplexity(3)
+
+	resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }","extensions":{"cost":true}}`)
+	require.Equal(t, `{"data":null,"extensions":{"complexity":{"estimate":3,"limit":5}}}`, resp.Body.String())
+
+	h.SetCalculatedComplexity(6)
+	resp = doRequest(h, "POST", "/graphql", `{"query":"{ name }","extensions":{"cost":true}}`)
+	require.Equal(t, `{"errors":[{"message":"operation has complexity 6, which exceeds the limit of 5","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}],"data":null}`, resp.Body.String())
+}
@@ -108,6 +108,45 @@ func TestFixedComplexity(t *testing.T) {
 	})
 }
 
+type allowDebugKey struct{}
+
+func TestComplexityBreakdown(t *testing.T) {
+	h := testserver.New()
+	h.Use(&extension.ComplexityLimit{
+		Func:        func(ctx context.Context, rc *graphql.OperationContext) int { return 5 },
+		DebugHeader: "X-Complexity-Debug",
+		DebugFields: 2,
+		AllowDebug:  func(ctx context.Context) bool { return ctx.Value(allowDebugKey{}) != nil },
+	})
+	h.AddTransport(&transport.POST{})
+	h.SetCalculatedComplexity(2)
+
+	request := func(debug string) string {
+		return requestBreakdown(h, debug, true)
+	}
+
+	require.Equal(t, `{"errors":[{"message":"operation has complexity 6, which exceeds the limit of 5","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}],"data":null}`, request(""))
+	require.Equal(t, `{"errors":[{"message":"operation has complexity 6, which exceeds the limit of 5","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}],"data":null}`, request("false"))
+	require.Equal(t, `{"errors":[{"message":"operation has complexity 6, which exceeds the limit of 5","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED","complexityBreakdown":[{"path":"a","field":"Query.name","complexity":2,"cost":2},{"path":"b","field":"Query.name","complexity":2,"cost":2}]}}],"data":null}`, request("true"))
+
+	// clients that are not allowed to debug don't get breakdowns, whatever they send
+	require.Equal(t, `{"errors":[{"message":"operation has complexity 6, which exceeds the limit of 5","extensions":{"code":"COMPLEXITY_LIMIT_EXCEEDED"}}],"data":null}`, requestBreakdown(h, "true", false))
+}
+
+func requestBreakdown(h http.Handler, debug string, allowed bool) string {
+	r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ a: name b: name c: name }"}`))
+	r.Header.Set("Content-Type", "application/json")
+	if debug != "" {
+		r.Header.Set("X-Complexity-Debug", debug)
+	}
+	if allowed {
+		r = r.WithContext(context.WithValue(r.Context(), allowDebugKey{}, true))
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Body.String()
+}
+
 func doRequest(handler http.Handler, method string, target string, body string) *httptest.ResponseRecorder {
 	r := httptest.NewRequest(method, target, strings.NewReader(body))
 	r.Header.Set("Content-Type", "application/json")
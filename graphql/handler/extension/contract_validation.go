@@ -0,0 +1,317 @@
+package extension
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ContractViolation is a value of a response that does not match the type of its field in the schema.
+type ContractViolation struct {
+	Path ast.Path
+	// Field is the field that returned the value, as Type.field with the object type it was resolved on.
+	Field   string
+	Message string
+}
+
+func (v ContractViolation) Error() string {
+	return fmt.Sprintf("%s at %s: %s", v.Field, v.Path.String(), v.Message)
+}
+
+// ContractValidation checks the value returned for every field against the schema, to catch bound models, custom
+// marshalers and resolvers drifting from what the schema promises: nulls in non-null fields, enum values the enum
+// doesn't have, and values of the wrong shape for Int (32-bit integers), Float, Boolean, String and ID. Custom scalars
+// are not checked.
+//
+// It runs as field middleware, so violations are attributed to the field that returned them before null bubbling
+// hides them. It inspects every value, use it in development and tests rather than in production.
+type ContractValidation struct {
+	// OnViolation is called with each violation. They are logged with graphql.GetLogger when nil.
+	OnViolation func(ctx context.Context, violation ContractViolation)
+
+	// ReportErrors adds each violation to the errors of the response as well, in place of the error of the generated
+	// code for nulls in non-null fields.
+	ReportErrors bool
+
+	schema *ast.Schema
+}
+
+var _ interface {
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = &ContractValidation{}
+
+func (c ContractValidation) ExtensionName() string {
+	return "ContractValidation"
+}
+
+func (c *ContractValidation) Validate(schema graphql.ExecutableSchema) error {
+	c.schema = schema.Schema()
+	return nil
+}
+
+func (c ContractValidation) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	res, err := next(ctx)
+	fc := graphql.GetFieldContext(ctx)
+	if err != nil || fc == nil || fc.Field.Field == nil || fc.Field.Definition == nil {
+		return res, err
+	}
+
+	owner := fc.Object
+	if fc.Field.ObjectDefinition != nil {
+		owner = fc.Field.ObjectDefinition.Name
+	}
+	w := contractWalker{ctx: ctx, schema: c.schema, field: owner + "." + fc.Field.Name}
+	w.value(fc.Path(), fc.Field.Definition.Type, reflect.ValueOf(res))
+	for _, v := range w.violations {
+		if c.OnViolation != nil {
+			c.OnViolation(ctx, v)
+		} else {
+			graphql.GetLogger(ctx).Warn("contract violation", "field", v.Field, "path", v.Path.String(), "message", v.Message)
+		}
+		if c.ReportErrors {
+			graphql.AddError(ctx, &gqlerror.Error{
+				Message: fmt.Sprintf("%s: %s", v.Field, v.Message),
+				Path:    v.Path,
+			})
+		}
+	}
+	return res, err
+}
+
+type contractWalker struct {
+	ctx        context.Context
+	schema     *ast.Schema
+	field      string
+	violations []ContractViolation
+}
+
+func (w *contractWalker) report(path ast.Path, format string, args ...interface{}) {
+	w.violations = append(w.violations, ContractViolation{
+		Path:    append(ast.Path{}, path...),
+		Field:   w.field,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// value checks the Go value v returned for a field of type typ. The fields of objects are checked when they are
+// resolved in turn.
+func (w *contractWalker) value(path ast.Path, typ *ast.Type, v reflect.Value) {
+	if marshaled, ok := w.marshal(v); ok {
+		w.marshaled(path, typ, marshaled)
+		return
+	}
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+	if isNil(v) {
+		if typ.NonNull {
+			w.report(path, "null for non-null type %s", typ.String())
+		}
+		return
+	}
+
+	if typ.Elem != nil {
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			w.report(path, "%s is not a list", v.Type())
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			w.value(append(path, ast.PathIndex(i)), typ.Elem, v.Index(i))
+		}
+		return
+	}
+
+	def := w.schema.Types[typ.NamedType]
+	if def == nil {
+		return
+	}
+	switch def.Kind {
+	case ast.Enum:
+		if v.Kind() != reflect.String || def.EnumValues.ForName(v.String()) == nil {
+			w.report(path, "%s is not a value of %s", goValue(v), def.Name)
+		}
+	case ast.Scalar:
+		w.scalar(path, def.Name, v)
+	}
+}
+
+// marshal returns the JSON written by the marshaler of v, when it has one.
+func (w *contractWalker) marshal(v reflect.Value) (interface{}, bool) {
+	if !v.IsValid() || !v.CanInterface() || isNil(v) {
+		return nil, false
+	}
+	var buf bytes.Buffer
+	switch m := v.Interface().(type) {
+	case graphql.Marshaler:
+		m.MarshalGQL(&buf)
+	case graphql.ContextMarshaler:
+		if m.MarshalGQLContext(w.ctx, &buf) != nil {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+	dec := json.NewDecoder(&buf)
+	dec.UseNumber()
+	var data interface{}
+	if dec.Decode(&data) != nil {
+		return nil, false
+	}
+	return data, true
+}
+
+// marshaled checks the JSON value written by a marshaler for a field of type typ.
+func (w *contractWalker) marshaled(path ast.Path, typ *ast.Type, value interface{}) {
+	if value == nil {
+		if typ.NonNull {
+			w.report(path, "null for non-null type %s", typ.String())
+		}
+		return
+	}
+
+	if typ.Elem != nil {
+		list, ok := value.([]interface{})
+		if !ok {
+			w.report(path, "%s is not a list", jsonKind(value))
+			return
+		}
+		for i, item := range list {
+			w.marshaled(append(path, ast.PathIndex(i)), typ.Elem, item)
+		}
+		return
+	}
+
+	def := w.schema.Types[typ.NamedType]
+	if def == nil {
+		return
+	}
+	switch def.Kind {
+	case ast.Enum:
+		if name, ok := value.(string); !ok || def.EnumValues.ForName(name) == nil {
+			w.report(path, "%s is not a value of %s", jsonValue(value), def.Name)
+		}
+	case ast.Scalar:
+		w.jsonScalar(path, def.Name, value)
+	}
+}
+
+func (w *contractWalker) scalar(path ast.Path, typename string, v reflect.Value) {
+	switch typename {
+	case "Int":
+		switch {
+		case v.CanInt():
+			if v.Int() < math.MinInt32 || v.Int() > math.MaxInt32 {
+				w.report(path, "%d is not an Int", v.Int())
+			}
+		case v.CanUint():
+			if v.Uint() > math.MaxInt32 {
+				w.report(path, "%d is not an Int", v.Uint())
+			}
+		default:
+			w.report(path, "%s is not an Int", goValue(v))
+		}
+	case "Float":
+		if !v.CanInt() && !v.CanUint() && !v.CanFloat() {
+			w.report(path, "%s is not a Float", goValue(v))
+		}
+	case "Boolean":
+		if v.Kind() != reflect.Bool {
+			w.report(path, "%s is not a Boolean", goValue(v))
+		}
+	case "String":
+		if v.Kind() != reflect.String {
+			w.report(path, "%s is not a String", goValue(v))
+		}
+	case "ID":
+		// integers are marshaled as strings by MarshalIntID
+		if v.Kind() != reflect.String && !v.CanInt() && !v.CanUint() {
+			w.report(path, "%s is not an ID", goValue(v))
+		}
+	}
+}
+
+func (w *contractWalker) jsonScalar(path ast.Path, typename string, value interface{}) {
+	switch typename {
+	case "Int":
+		n, ok := value.(json.Number)
+		if _, err := strconv.ParseInt(string(n), 10, 32); !ok || err != nil {
+			w.report(path, "%s is not an Int", jsonValue(value))
+		}
+	case "Float":
+		if _, ok := value.(json.Number); !ok {
+			w.report(path, "%s is not a Float", jsonValue(value))
+		}
+	case "Boolean":
+		if _, ok := value.(bool); !ok {
+			w.report(path, "%s is not a Boolean", jsonValue(value))
+		}
+	case "String":
+		if _, ok := value.(string); !ok {
+			w.report(path, "%s is not a String", jsonValue(value))
+		}
+	case "ID":
+		if _, ok := value.(string); !ok {
+			w.report(path, "%s is not an ID", jsonValue(value))
+		}
+	}
+}
+
+func isNil(v reflect.Value) bool {
+	if !v.IsValid() {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return v.IsNil()
+	}
+	return false
+}
+
+// goValue describes v for violations, as JSON for basic values and by type otherwise.
+func goValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b, _ := json.Marshal(v.Interface())
+		return string(b)
+	}
+	return v.Type().String()
+}
+
+// jsonKind names the JSON type of value.
+func jsonKind(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "a list"
+	case string:
+		return "a string"
+	case json.Number:
+		return "a number"
+	case bool:
+		return "a boolean"
+	}
+	return "null"
+}
+
+// jsonValue returns value as JSON, or its kind for lists and objects.
+func jsonValue(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}, []interface{}:
+		return jsonKind(value)
+	}
+	b, _ := json.Marshal(value)
+	return string(b)
+}
@@ -0,0 +1,130 @@
+package extension_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+// contractStatus marshals like a generated enum, without checking its value.
+type contractStatus string
+
+func (s contractStatus) MarshalGQL(w io.Writer) {
+	if s == "" {
+		io.WriteString(w, "null")
+		return
+	}
+	io.WriteString(w, strconv.Quote(string(s)))
+}
+
+func TestContractValidation(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { user: User node: Node count: Int }
+		interface Node { id: ID! }
+		type User implements Node { id: ID! name: String! age: Int status: Status! tags: [String!]! }
+		enum Status { ACTIVE DISABLED }
+	`})
+	c := &extension.ContractValidation{}
+	require.NoError(t, c.Validate(&graphql.ExecutableSchemaMock{SchemaFunc: func() *ast.Schema { return schema }}))
+
+	var violations []string
+	c.OnViolation = func(ctx context.Context, violation extension.ContractViolation) {
+		violations = append(violations, violation.Error())
+	}
+	// resolve runs the field of typeName through the extension, within the user field when typeName is User
+	resolve := func(ctx context.Context, typeName, field string, res interface{}, err error) (interface{}, error) {
+		def := schema.Types[typeName]
+		fc := &graphql.FieldContext{
+			Object: typeName,
+			Field: graphql.CollectedField{Field: &ast.Field{
+				Alias:            field,
+				Name:             field,
+				Definition:       def.Fields.ForName(field),
+				ObjectDefinition: def,
+			}},
+		}
+		if typeName == "User" {
+			fc.Object = "Node"
+			ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: graphql.CollectedField{Field: &ast.Field{Alias: "user"}}})
+		}
+		return c.InterceptField(graphql.WithFieldContext(ctx, fc), func(ctx context.Context) (interface{}, error) {
+			return res, err
+		})
+	}
+	name := "bob"
+
+	t.Run("accepts valid values", func(t *testing.T) {
+		violations = nil
+		ctx := context.Background()
+		resolve(ctx, "Query", "count", 3, nil)
+		resolve(ctx, "Query", "user", nil, nil)
+		resolve(ctx, "User", "id", 1, nil)
+		resolve(ctx, "User", "name", &name, nil)
+		resolve(ctx, "User", "age", (*int)(nil), nil)
+		resolve(ctx, "User", "status", contractStatus("ACTIVE"), nil)
+		resolve(ctx, "User", "tags", []string{"a"}, nil)
+		require.Empty(t, violations)
+	})
+
+	t.Run("reports violations with the field returning them", func(t *testing.T) {
+		violations = nil
+		ctx := context.Background()
+		resolve(ctx, "Query", "count", int64(4000000000), nil)
+		resolve(ctx, "User", "id", true, nil)
+		resolve(ctx, "User", "name", (*string)(nil), nil)
+		resolve(ctx, "User", "age", 1.5, nil)
+		resolve(ctx, "User", "status", contractStatus("BANNED"), nil)
+		resolve(ctx, "User", "tags", []*string{&name, nil}, nil)
+		require.Equal(t, []string{
+			"Query.count at count: 4000000000 is not an Int",
+			"User.id at user.id: true is not an ID",
+			"User.name at user.name: null for non-null type String!",
+			"User.age at user.age: 1.5 is not an Int",
+			`User.status at user.status: "BANNED" is not a value of Status`,
+			"User.tags at user.tags[1]: null for non-null type String!",
+		}, violations)
+	})
+
+	t.Run("checks the output of marshalers", func(t *testing.T) {
+		violations = nil
+		resolve(context.Background(), "User", "status", contractStatus(""), nil)
+		require.Equal(t, []string{"User.status at user.status: null for non-null type Status!"}, violations)
+	})
+
+	t.Run("leaves errors alone", func(t *testing.T) {
+		violations = nil
+		_, err := resolve(context.Background(), "User", "name", nil, errors.New("boom"))
+		require.EqualError(t, err, "boom")
+		require.Empty(t, violations)
+	})
+
+	t.Run("reports errors", func(t *testing.T) {
+		c := &extension.ContractValidation{ReportErrors: true, OnViolation: func(context.Context, extension.ContractViolation) {}}
+		require.NoError(t, c.Validate(&graphql.ExecutableSchemaMock{SchemaFunc: func() *ast.Schema { return schema }}))
+		ctx := graphql.WithResponseContext(context.Background(), graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+		fc := &graphql.FieldContext{
+			Object: "Query",
+			Field: graphql.CollectedField{Field: &ast.Field{
+				Alias:            "count",
+				Name:             "count",
+				Definition:       schema.Query.Fields.ForName("count"),
+				ObjectDefinition: schema.Query,
+			}},
+		}
+		res, err := c.InterceptField(graphql.WithFieldContext(ctx, fc), func(ctx context.Context) (interface{}, error) {
+			return "3", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "3", res)
+		require.Equal(t, `input: count Query.count: "3" is not an Int`+"\n", graphql.GetErrors(ctx).Error())
+	})
+}
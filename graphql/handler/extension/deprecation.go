@@ -0,0 +1,255 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const deprecationExtension = "DeprecationUsage"
+
+// deprecatedCode is the code of the warnings added for deprecated elements.
+const deprecatedCode = "DEPRECATED"
+
+// DeprecationUsage detects the deprecated fields, arguments, input fields and enum values used by operations, and
+// counts them per client to tell when they are no longer used and can be removed. Clients are told apart by the
+// graphql.ClientInfo set by ClientIdentity, so use it before this extension.
+type DeprecationUsage struct {
+	// Counter is incremented once per operation for each deprecated element it uses. A DeprecationCounts keeps the
+	// counts in memory, implement DeprecationCounter to export them to a metrics system instead.
+	Counter DeprecationCounter
+
+	// Warn adds a warning with the DEPRECATED code to the response extensions for each deprecated element used.
+	Warn bool
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = &DeprecationUsage{}
+
+// DeprecatedUse is a deprecated element used by an operation.
+type DeprecatedUse struct {
+	// Coordinate is the schema coordinate of the element, like Query.user, Query.user(id:) or Role.ADMIN.
+	Coordinate string
+	Reason     string
+}
+
+// DeprecationCounter counts the deprecated elements used per client.
+type DeprecationCounter interface {
+	IncDeprecationUsage(client graphql.ClientInfo, coordinate string)
+}
+
+type deprecationStats struct {
+	uses   []DeprecatedUse
+	warned atomic.Bool
+}
+
+func (d DeprecationUsage) ExtensionName() string {
+	return deprecationExtension
+}
+
+func (d *DeprecationUsage) Validate(schema graphql.ExecutableSchema) error {
+	d.es = schema
+	return nil
+}
+
+func (d *DeprecationUsage) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	w := deprecationWalker{schema: d.es.Schema(), seen: map[string]bool{}, fragments: map[string]bool{}}
+	w.selectionSet(op.SelectionSet)
+	for _, v := range op.VariableDefinitions {
+		w.input(v.Type, rc.Variables[v.Variable])
+	}
+	if len(w.uses) == 0 {
+		return nil
+	}
+	sort.Slice(w.uses, func(i, j int) bool { return w.uses[i].Coordinate < w.uses[j].Coordinate })
+
+	rc.Stats.SetExtension(deprecationExtension, &deprecationStats{uses: w.uses})
+	if d.Counter != nil {
+		for _, use := range w.uses {
+			d.Counter.IncDeprecationUsage(rc.ClientInfo, use.Coordinate)
+		}
+	}
+	return nil
+}
+
+func (d *DeprecationUsage) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !d.Warn || !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+	stats, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(deprecationExtension).(*deprecationStats)
+	// subscriptions only warn on their first response
+	if stats != nil && stats.warned.CompareAndSwap(false, true) {
+		for _, use := range stats.uses {
+			graphql.AddWarning(ctx, nil, fmt.Sprintf("%s is deprecated: %s", use.Coordinate, use.Reason), deprecatedCode)
+		}
+	}
+	return next(ctx)
+}
+
+// GetDeprecationUsage returns the deprecated elements used by the current operation, sorted by coordinate.
+func GetDeprecationUsage(ctx context.Context) []DeprecatedUse {
+	if !graphql.HasOperationContext(ctx) {
+		return nil
+	}
+	stats, _ := graphql.GetOperationContext(ctx).Stats.GetExtension(deprecationExtension).(*deprecationStats)
+	if stats == nil {
+		return nil
+	}
+	return stats.uses
+}
+
+// DeprecationCountKey identifies a deprecated element used by a client.
+type DeprecationCountKey struct {
+	ClientName    string
+	ClientVersion string
+	Coordinate    string
+}
+
+// DeprecationCounts counts the deprecated elements used per client in memory.
+type DeprecationCounts struct {
+	mu     sync.Mutex
+	counts map[DeprecationCountKey]int64
+}
+
+func (c *DeprecationCounts) IncDeprecationUsage(client graphql.ClientInfo, coordinate string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts == nil {
+		c.counts = map[DeprecationCountKey]int64{}
+	}
+	c.counts[DeprecationCountKey{ClientName: client.Name, ClientVersion: client.Version, Coordinate: coordinate}]++
+}
+
+// Counts returns a copy of the counts.
+func (c *DeprecationCounts) Counts() map[DeprecationCountKey]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := make(map[DeprecationCountKey]int64, len(c.counts))
+	for k, v := range c.counts {
+		counts[k] = v
+	}
+	return counts
+}
+
+type deprecationWalker struct {
+	schema    *ast.Schema
+	uses      []DeprecatedUse
+	seen      map[string]bool
+	fragments map[string]bool
+}
+
+func (w *deprecationWalker) add(coordinate string, dirs ast.DirectiveList) {
+	d := dirs.ForName("deprecated")
+	if d == nil || w.seen[coordinate] {
+		return
+	}
+	w.seen[coordinate] = true
+
+	reason := "No longer supported"
+	if arg := d.Arguments.ForName("reason"); arg != nil && arg.Value != nil {
+		reason = arg.Value.Raw
+	}
+	w.uses = append(w.uses, DeprecatedUse{Coordinate: coordinate, Reason: reason})
+}
+
+func (w *deprecationWalker) selectionSet(set ast.SelectionSet) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if sel.Definition == nil || sel.ObjectDefinition == nil {
+				continue
+			}
+			field := sel.ObjectDefinition.Name + "." + sel.Name
+			w.add(field, sel.Definition.Directives)
+			for _, arg := range sel.Arguments {
+				if def := sel.Definition.Arguments.ForName(arg.Name); def != nil {
+					w.add(field+"("+arg.Name+":)", def.Directives)
+				}
+				w.value(arg.Value)
+			}
+			w.selectionSet(sel.SelectionSet)
+		case *ast.InlineFragment:
+			w.selectionSet(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			if sel.Definition != nil && !w.fragments[sel.Name] {
+				w.fragments[sel.Name] = true
+				w.selectionSet(sel.Definition.SelectionSet)
+			}
+		}
+	}
+}
+
+// value walks the literal value of an argument.
+func (w *deprecationWalker) value(v *ast.Value) {
+	if v == nil || v.Definition == nil {
+		return
+	}
+	switch v.Kind {
+	case ast.EnumValue:
+		if value := v.Definition.EnumValues.ForName(v.Raw); value != nil {
+			w.add(v.Definition.Name+"."+value.Name, value.Directives)
+		}
+	case ast.ObjectValue:
+		for _, child := range v.Children {
+			if field := v.Definition.Fields.ForName(child.Name); field != nil {
+				w.add(v.Definition.Name+"."+field.Name, field.Directives)
+			}
+			w.value(child.Value)
+		}
+	case ast.ListValue:
+		for _, child := range v.Children {
+			w.value(child.Value)
+		}
+	}
+}
+
+// input walks the value of a variable of type typ.
+func (w *deprecationWalker) input(typ *ast.Type, v interface{}) {
+	def := w.schema.Types[typ.Name()]
+	if def == nil || v == nil {
+		return
+	}
+	if typ.Elem != nil {
+		if list, ok := v.([]interface{}); ok {
+			for _, item := range list {
+				w.input(typ.Elem, item)
+			}
+			return
+		}
+		w.input(typ.Elem, v)
+		return
+	}
+	switch def.Kind {
+	case ast.Enum:
+		if name, ok := v.(string); ok {
+			if value := def.EnumValues.ForName(name); value != nil {
+				w.add(def.Name+"."+value.Name, value.Directives)
+			}
+		}
+	case ast.InputObject:
+		fields, _ := v.(map[string]interface{})
+		for name, fieldValue := range fields {
+			if field := def.Fields.ForName(name); field != nil {
+				w.add(def.Name+"."+field.Name, field.Directives)
+				w.input(field.Type, fieldValue)
+			}
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package extension_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestDeprecationUsage(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query {
+			user(id: ID, login: String @deprecated(reason: "use id")): User
+			users(filter: Filter): [User!]!
+			legacy: String @deprecated
+		}
+		type User {
+			name: String!
+			nick: String @deprecated(reason: "use name")
+		}
+		input Filter {
+			role: Role
+			admin: Boolean @deprecated(reason: "use role")
+		}
+		enum Role { USER ADMIN SUPERUSER @deprecated(reason: "use ADMIN") }
+	`})
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{}`)})
+		},
+	}
+
+	counts := &extension.DeprecationCounts{}
+	newServer := func(ext *extension.DeprecationUsage) *handler.Server {
+		h := handler.New(es)
+		h.AddTransport(&transport.POST{})
+		h.Use(extension.ClientIdentity{})
+		h.Use(ext)
+		return h
+	}
+	var uses []extension.DeprecatedUse
+	request := func(h *handler.Server, client string, body string) string {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("apollographql-client-name", client)
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w.Body.String()
+	}
+
+	h := newServer(&extension.DeprecationUsage{Counter: counts})
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		uses = extension.GetDeprecationUsage(ctx)
+		return next(ctx)
+	})
+
+	t.Run("fields and arguments", func(t *testing.T) {
+		resp := request(h, "web", `{"query":"{ user(login: \"a\") { name nick ...f } legacy } fragment f on User { nick }"}`)
+		require.Equal(t, `{"data":{}}`, resp)
+		require.Equal(t, []extension.DeprecatedUse{
+			{Coordinate: "Query.legacy", Reason: "No longer supported"},
+			{Coordinate: "Query.user(login:)", Reason: "use id"},
+			{Coordinate: "User.nick", Reason: "use name"},
+		}, uses)
+	})
+
+	t.Run("literal inputs and enums", func(t *testing.T) {
+		request(h, "web", `{"query":"{ users(filter: {role: SUPERUSER, admin: true}) { name } }"}`)
+		require.Equal(t, []extension.DeprecatedUse{
+			{Coordinate: "Filter.admin", Reason: "use role"},
+			{Coordinate: "Role.SUPERUSER", Reason: "use ADMIN"},
+		}, uses)
+	})
+
+	t.Run("variables", func(t *testing.T) {
+		request(h, "ios", `{"query":"query($f: Filter) { users(filter: $f) { name } }","variables":{"f":{"role":"SUPERUSER"}}}`)
+		require.Equal(t, []extension.DeprecatedUse{
+			{Coordinate: "Role.SUPERUSER", Reason: "use ADMIN"},
+		}, uses)
+	})
+
+	t.Run("no deprecated elements", func(t *testing.T) {
+		request(h, "web", `{"query":"{ user(id: 1) { name } }"}`)
+		require.Nil(t, uses)
+	})
+
+	t.Run("counts per client", func(t *testing.T) {
+		require.Equal(t, map[extension.DeprecationCountKey]int64{
+			{ClientName: "web", Coordinate: "Query.legacy"}:       1,
+			{ClientName: "web", Coordinate: "Query.user(login:)"}: 1,
+			{ClientName: "web", Coordinate: "User.nick"}:          1,
+			{ClientName: "web", Coordinate: "Filter.admin"}:       1,
+			{ClientName: "web", Coordinate: "Role.SUPERUSER"}:     1,
+			{ClientName: "ios", Coordinate: "Role.SUPERUSER"}:     1,
+		}, counts.Counts())
+	})
+
+	t.Run("warnings", func(t *testing.T) {
+		h := newServer(&extension.DeprecationUsage{Warn: true})
+		resp := request(h, "web", `{"query":"{ legacy user(id: 1) { nick } }"}`)
+		require.Equal(t, `{"data":{},"extensions":{"warnings":[`+
+			`{"message":"Query.legacy is deprecated: No longer supported","code":"DEPRECATED"},`+
+			`{"message":"User.nick is deprecated: use name","code":"DEPRECATED"}]}}`, resp)
+
+		resp = request(h, "web", `{"query":"{ user(id: 1) { name } }"}`)
+		require.Equal(t, `{"data":{}}`, resp)
+	})
+}
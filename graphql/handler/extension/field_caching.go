@@ -0,0 +1,42 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// FieldCaching caches the values of fields with the @cached directive in Store. The generated code looks the key of the
+// directive up before calling the resolver, and stores the value it returns for the ttl of the directive. Concurrent
+// misses of the same key, across operations, share one call of the resolver. Without the extension @cached fields are
+// always resolved.
+type FieldCaching struct {
+	Store graphql.FieldCacheStore
+
+	cache *graphql.FieldCache
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = &FieldCaching{}
+
+func (c FieldCaching) ExtensionName() string {
+	return "FieldCaching"
+}
+
+func (c *FieldCaching) Validate(schema graphql.ExecutableSchema) error {
+	if c.Store == nil {
+		return fmt.Errorf("FieldCaching.Store can not be nil")
+	}
+	c.cache = &graphql.FieldCache{Store: c.Store}
+	return nil
+}
+
+func (c FieldCaching) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	rc.FieldCache = c.cache
+	return nil
+}
@@ -25,6 +25,6 @@ func (c Introspection) Validate(schema graphql.ExecutableSchema) error {
 }
 
 func (c Introspection) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
-	rc.DisableIntrospection = false
+	rc.DisableIntrospection = !introspectionAvailable
 	return nil
 }
@@ -0,0 +1,5 @@
+//go:build !gqlgen_nointrospection
+
+package extension
+
+const introspectionAvailable = true
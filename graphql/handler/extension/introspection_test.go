@@ -14,5 +14,5 @@ func TestIntrospection(t *testing.T) {
 		DisableIntrospection: true,
 	}
 	require.Nil(t, Introspection{}.MutateOperationContext(context.Background(), rc))
-	require.False(t, rc.DisableIntrospection)
+	require.Equal(t, !introspectionAvailable, rc.DisableIntrospection)
 }
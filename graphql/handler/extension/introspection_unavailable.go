@@ -0,0 +1,7 @@
+//go:build gqlgen_nointrospection
+
+package extension
+
+// introspectionAvailable is false in builds with the gqlgen_nointrospection tag, Introspection then leaves
+// introspection disabled.
+const introspectionAvailable = false
@@ -0,0 +1,170 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// loadSheddingBuckets is the number of buckets the window of LoadShedding is split in, older buckets are dropped as
+// the window moves.
+const loadSheddingBuckets = 10
+
+// LoadShedding rejects low priority operations with a SERVICE_OVERLOADED error while the server is overloaded: when
+// the ratio of operations with errors, or their average duration, over the last Window crosses a threshold. High
+// priority operations keep being executed, with the capacity freed by the others.
+//
+// Queries and mutations are measured from the time they are dispatched to their first response, subscriptions are
+// neither measured nor rejected.
+type LoadShedding struct {
+	// LowPriority reports whether an operation may be rejected, from its name, rc.ClientInfo set by ClientIdentity or
+	// the complexity returned by GetComplexityStats once ComplexityLimit ran. All operations may be rejected when nil.
+	LowPriority func(ctx context.Context, rc *graphql.OperationContext) bool
+
+	// ErrorRate is the ratio of operations with errors, between 0 and 1, from which the server is overloaded. Zero
+	// ignores errors.
+	ErrorRate float64
+
+	// Latency is the average duration of operations from which the server is overloaded. Zero ignores durations.
+	Latency time.Duration
+
+	// Window is the period rates are computed over, 10 seconds when zero.
+	Window time.Duration
+
+	// MinOperations is the number of operations in the window below which the server is never overloaded, so a few
+	// failures of an idle server do not shed its load. 20 when zero.
+	MinOperations int
+
+	state *loadSheddingState
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = &LoadShedding{}
+
+type loadSheddingBucket struct {
+	start    int64 // index of the bucket since the epoch
+	ops      int64
+	errors   int64
+	duration time.Duration
+}
+
+type loadSheddingState struct {
+	mu      sync.Mutex
+	buckets [loadSheddingBuckets]loadSheddingBucket
+	shed    atomic.Int64
+}
+
+func (l LoadShedding) ExtensionName() string {
+	return "LoadShedding"
+}
+
+func (l *LoadShedding) Validate(schema graphql.ExecutableSchema) error {
+	if l.ErrorRate == 0 && l.Latency == 0 {
+		return fmt.Errorf("LoadShedding needs an ErrorRate or a Latency")
+	}
+	if l.Window == 0 {
+		l.Window = 10 * time.Second
+	}
+	if l.MinOperations == 0 {
+		l.MinOperations = 20
+	}
+	l.state = &loadSheddingState{}
+	return nil
+}
+
+// Overloaded reports whether operations are currently rejected.
+func (l *LoadShedding) Overloaded() bool {
+	ops, errs, duration := l.totals(time.Now())
+	if ops == 0 || ops < int64(l.MinOperations) {
+		return false
+	}
+	if l.ErrorRate > 0 && float64(errs)/float64(ops) >= l.ErrorRate {
+		return true
+	}
+	return l.Latency > 0 && duration/time.Duration(ops) >= l.Latency
+}
+
+// Shed returns the number of operations rejected.
+func (l *LoadShedding) Shed() int64 {
+	return l.state.shed.Load()
+}
+
+func (l *LoadShedding) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	if rc.Operation == nil || rc.Operation.Operation == ast.Subscription || !l.Overloaded() {
+		return nil
+	}
+	if l.LowPriority != nil && !l.LowPriority(ctx, rc) {
+		return nil
+	}
+
+	l.state.shed.Add(1)
+	err := gqlerror.Errorf("the server is overloaded, try again later")
+	errcode.Set(err, errcode.ServiceOverloaded)
+	return err
+}
+
+func (l *LoadShedding) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	rc := graphql.GetOperationContext(ctx)
+	if rc.Operation.Operation == ast.Subscription {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	responses := next(ctx)
+	measured := false
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if !measured {
+			measured = true
+			l.record(start, resp != nil && len(resp.Errors) > 0)
+		}
+		return resp
+	}
+}
+
+func (l *LoadShedding) bucketSize() time.Duration {
+	return l.Window / loadSheddingBuckets
+}
+
+func (l *LoadShedding) record(start time.Time, failed bool) {
+	now := time.Now()
+	index := now.UnixNano() / int64(l.bucketSize())
+
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+	b := &l.state.buckets[index%loadSheddingBuckets]
+	if b.start != index {
+		*b = loadSheddingBucket{start: index}
+	}
+	b.ops++
+	if failed {
+		b.errors++
+	}
+	b.duration += now.Sub(start)
+}
+
+func (l *LoadShedding) totals(now time.Time) (ops, errs int64, duration time.Duration) {
+	index := now.UnixNano() / int64(l.bucketSize())
+
+	l.state.mu.Lock()
+	defer l.state.mu.Unlock()
+	for _, b := range l.state.buckets {
+		if index-b.start < loadSheddingBuckets {
+			ops += b.ops
+			errs += b.errors
+			duration += b.duration
+		}
+	}
+	return ops, errs, duration
+}
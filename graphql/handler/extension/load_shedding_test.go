@@ -0,0 +1,93 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+func TestLoadShedding(t *testing.T) {
+	operation := func(name string, op ast.Operation) (context.Context, *graphql.OperationContext) {
+		rc := &graphql.OperationContext{
+			OperationName: name,
+			Operation:     &ast.OperationDefinition{Name: name, Operation: op},
+		}
+		return graphql.WithOperationContext(context.Background(), rc), rc
+	}
+	// run executes an operation taking d and failing when failed is set
+	run := func(l *extension.LoadShedding, d time.Duration, failed bool) {
+		ctx, _ := operation("run", ast.Query)
+		responses := l.InterceptOperation(ctx, func(ctx context.Context) graphql.ResponseHandler {
+			return func(ctx context.Context) *graphql.Response {
+				time.Sleep(d)
+				if failed {
+					return &graphql.Response{Errors: gqlerror.List{{Message: "failed"}}}
+				}
+				return &graphql.Response{}
+			}
+		})
+		responses(ctx)
+	}
+	lowPriority := func(ctx context.Context, rc *graphql.OperationContext) bool {
+		return rc.OperationName != "Checkout"
+	}
+
+	t.Run("rejects low priority operations while errors are frequent", func(t *testing.T) {
+		l := &extension.LoadShedding{ErrorRate: 0.5, MinOperations: 4, LowPriority: lowPriority}
+		require.NoError(t, l.Validate(nil))
+
+		for i := 0; i < 3; i++ {
+			run(l, 0, true)
+		}
+		require.False(t, l.Overloaded(), "below MinOperations")
+		run(l, 0, false)
+		require.True(t, l.Overloaded())
+
+		ctx, rc := operation("Search", ast.Query)
+		err := l.MutateOperationContext(ctx, rc)
+		require.NotNil(t, err)
+		require.Equal(t, "SERVICE_OVERLOADED", err.Extensions["code"])
+		require.Equal(t, http.StatusServiceUnavailable, errcode.HTTPStatus(gqlerror.List{err}))
+		require.Equal(t, int64(1), l.Shed())
+
+		ctx, rc = operation("Checkout", ast.Mutation)
+		require.Nil(t, l.MutateOperationContext(ctx, rc))
+		ctx, rc = operation("Search", ast.Subscription)
+		require.Nil(t, l.MutateOperationContext(ctx, rc))
+	})
+
+	t.Run("rejects operations while they are slow", func(t *testing.T) {
+		l := &extension.LoadShedding{Latency: 2 * time.Millisecond, MinOperations: 2}
+		require.NoError(t, l.Validate(nil))
+
+		run(l, 0, false)
+		run(l, 0, false)
+		require.False(t, l.Overloaded())
+		run(l, 10*time.Millisecond, false)
+		run(l, 10*time.Millisecond, false)
+		require.True(t, l.Overloaded())
+	})
+
+	t.Run("forgets operations out of the window", func(t *testing.T) {
+		l := &extension.LoadShedding{ErrorRate: 0.5, MinOperations: 1, Window: 50 * time.Millisecond}
+		require.NoError(t, l.Validate(nil))
+
+		run(l, 0, true)
+		require.True(t, l.Overloaded())
+		time.Sleep(60 * time.Millisecond)
+		require.False(t, l.Overloaded())
+	})
+
+	t.Run("needs a threshold", func(t *testing.T) {
+		require.EqualError(t, (&extension.LoadShedding{}).Validate(nil), "LoadShedding needs an ErrorRate or a Latency")
+	})
+}
@@ -0,0 +1,39 @@
+package extension
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Masking sets who reads the values of fields with the @masked and @encrypted directives. The generated marshalers
+// replace the values of @masked fields with graphql.MaskedValue, and encrypt the values of @encrypted fields, for
+// viewers Allow doesn't let read them. Without the extension every value is masked.
+type Masking struct {
+	// Allow reports whether the viewer of ctx reads the values of fields masked for reason, or encrypted with the key
+	// named reason.
+	Allow func(ctx context.Context, reason string) bool
+
+	// Encrypt returns value encrypted with the key named key. The values of @encrypted fields are masked when nil.
+	Encrypt func(ctx context.Context, key string, value string) (string, error)
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = Masking{}
+
+func (m Masking) ExtensionName() string {
+	return "Masking"
+}
+
+func (m Masking) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (m Masking) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	rc.Masking = &graphql.FieldMasking{Allow: m.Allow, Encrypt: m.Encrypt}
+	return nil
+}
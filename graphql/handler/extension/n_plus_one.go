@@ -0,0 +1,86 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const (
+	// DefaultNPlusOneThreshold is the number of fetches NPlusOneDetection allows when Threshold is zero.
+	DefaultNPlusOneThreshold = 10
+
+	// NPlusOneWarningCode is the code of the warnings of NPlusOneDetection.
+	NPlusOneWarningCode = "N_PLUS_ONE"
+)
+
+// NPlusOneDetection points at fields fetching from the same downstream over and over in an operation, typically a field
+// of the items of a list loading its value one item at a time instead of through a dataloader. It counts the fetches
+// recorded with graphql.RecordFetch, and graphql.WithDependency, per parent type, field and downstream, and once the
+// operation is resolved adds a warning with the N_PLUS_ONE code for those running more than Threshold times. The
+// warning is located at the path of the first fetch, and logged with graphql.GetLogger.
+//
+// It is meant for development, deferred fragments and subscriptions are left aside.
+type NPlusOneDetection struct {
+	Threshold int // DefaultNPlusOneThreshold when zero
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = NPlusOneDetection{}
+
+func (n NPlusOneDetection) ExtensionName() string {
+	return "NPlusOneDetection"
+}
+
+func (n NPlusOneDetection) Validate(schema graphql.ExecutableSchema) error {
+	if n.Threshold < 0 {
+		return fmt.Errorf("NPlusOneDetection.Threshold can not be negative")
+	}
+	return nil
+}
+
+func (n NPlusOneDetection) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	rc.FetchTracker = &graphql.FetchTracker{}
+	return nil
+}
+
+func (n NPlusOneDetection) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil || resp.Path != nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	rc := graphql.GetOperationContext(ctx)
+	if rc.FetchTracker == nil || rc.Operation == nil || rc.Operation.Operation == ast.Subscription {
+		return resp
+	}
+
+	threshold := n.Threshold
+	if threshold == 0 {
+		threshold = DefaultNPlusOneThreshold
+	}
+	warned := false
+	for _, c := range rc.FetchTracker.Counts() {
+		if c.Count <= threshold {
+			break
+		}
+		message := fmt.Sprintf("%s.%s fetched %s %d times, batch it with a dataloader", c.Object, c.Field, c.Label, c.Count)
+		if c.Label == "" {
+			message = fmt.Sprintf("%s.%s fetched %d times, batch it with a dataloader", c.Object, c.Field, c.Count)
+		}
+		graphql.AddWarning(ctx, c.Path, message, NPlusOneWarningCode)
+		graphql.GetLogger(ctx).Warn("repeated fetch, missing dataloader",
+			"field", c.Object+"."+c.Field, "downstream", c.Label, "count", c.Count, "field_path", c.Path.String())
+		warned = true
+	}
+	if warned {
+		resp.Extensions = graphql.GetExtensions(ctx)
+	}
+	return resp
+}
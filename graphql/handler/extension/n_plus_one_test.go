@@ -0,0 +1,65 @@
+package extension_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestNPlusOneDetection(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { users: [User!]! }
+		type User { name: String! posts: [String!]! }
+	`})
+	users := 0
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			// resolves users { name posts }, fetching the posts of each user from the database
+			ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Object: "Query", Field: graphql.CollectedField{Field: &ast.Field{Alias: "users", Name: "users"}}})
+			for i := 0; i < users; i++ {
+				item := graphql.WithFieldContext(ctx, &graphql.FieldContext{Index: &i})
+				graphql.RecordFetch(graphql.WithFieldContext(item, &graphql.FieldContext{
+					Object: "User", Field: graphql.CollectedField{Field: &ast.Field{Alias: "posts", Name: "posts"}},
+				}), "db.posts")
+				graphql.RecordFetch(graphql.WithFieldContext(item, &graphql.FieldContext{
+					Object: "User", Field: graphql.CollectedField{Field: &ast.Field{Alias: "name", Name: "name"}},
+				}), "")
+			}
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{"users":[]}`)})
+		},
+	}
+	h := handler.New(es)
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.NPlusOneDetection{Threshold: 3})
+
+	t.Run("allows fetches up to the threshold", func(t *testing.T) {
+		users = 3
+		w := doRequest(h, "POST", "/graphql", `{"query":"{ users { name posts } }"}`)
+		require.Equal(t, `{"data":{"users":[]}}`, w.Body.String())
+	})
+
+	t.Run("warns about repeated fetches", func(t *testing.T) {
+		users = 5
+		w := doRequest(h, "POST", "/graphql", `{"query":"{ users { name posts } }"}`)
+		require.JSONEq(t, `{"data":{"users":[]},"extensions":{"warnings":[
+			{"message":"User.name fetched 5 times, batch it with a dataloader","path":["users",0,"name"],"code":"N_PLUS_ONE"},
+			{"message":"User.posts fetched db.posts 5 times, batch it with a dataloader","path":["users",0,"posts"],"code":"N_PLUS_ONE"}
+		]}}`, w.Body.String())
+	})
+
+	t.Run("rejects negative thresholds", func(t *testing.T) {
+		require.EqualError(t, extension.NPlusOneDetection{Threshold: -1}.Validate(es), "NPlusOneDetection.Threshold can not be negative")
+	})
+}
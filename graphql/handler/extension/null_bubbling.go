@@ -0,0 +1,159 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// NullBubbling explains the nulls caused by errors in non-null fields. When such a field errors its parent is set
+// to null instead, up to the nearest nullable ancestor, and clients only see a null ancestor with the error pointing
+// somewhere below it. NullBubbling adds a "bubbling" extension to these errors, listing the paths that were nulled
+// from the field that errored up to the ancestor seen as null in the data, or an empty path when the data itself is
+// null.
+//
+// Deferred payloads are left as is.
+type NullBubbling struct{}
+
+var _ interface {
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = NullBubbling{}
+
+func (n NullBubbling) ExtensionName() string {
+	return "NullBubbling"
+}
+
+func (n NullBubbling) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (n NullBubbling) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil || len(resp.Errors) == 0 || resp.Path != nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+	rc := graphql.GetOperationContext(ctx)
+	if rc.Operation == nil {
+		return resp
+	}
+
+	var data interface{}
+	decoded := false
+	for _, err := range resp.Errors {
+		chain := n.chain(rc, err.Path)
+		if len(chain) < 2 {
+			continue
+		}
+		if !decoded {
+			decoded = true
+			if json.Unmarshal(resp.Data, &data) != nil {
+				return resp
+			}
+		}
+		// the error was added without nulling the field
+		if !isNullAt(data, chain[len(chain)-1]) {
+			continue
+		}
+		if err.Extensions == nil {
+			err.Extensions = map[string]interface{}{}
+		}
+		err.Extensions["bubbling"] = chain
+	}
+	return resp
+}
+
+// chain returns the paths nulled by an error at path, starting with path itself and ending with its nearest nullable
+// ancestor.
+func (n NullBubbling) chain(rc *graphql.OperationContext, path ast.Path) []ast.Path {
+	types := n.pathTypes(rc, path)
+	if types == nil {
+		return nil
+	}
+
+	chain := []ast.Path{path}
+	for i := len(types) - 1; i >= 0 && types[i].NonNull; i-- {
+		chain = append(chain, path[:i])
+	}
+	return chain
+}
+
+// pathTypes returns the type of the value at each step of path, or nil if path isn't part of the operation.
+func (n NullBubbling) pathTypes(rc *graphql.OperationContext, path ast.Path) []*ast.Type {
+	if len(path) == 0 {
+		return nil
+	}
+
+	types := make([]*ast.Type, 0, len(path))
+	sets := []ast.SelectionSet{rc.Operation.SelectionSet}
+	var typ *ast.Type
+	for _, el := range path {
+		switch el := el.(type) {
+		case ast.PathName:
+			fields := collectResponseKey(rc.Doc, sets, string(el), map[string]bool{})
+			if len(fields) == 0 || fields[0].Definition == nil {
+				return nil
+			}
+			typ = fields[0].Definition.Type
+			sets = sets[:0]
+			for _, f := range fields {
+				sets = append(sets, f.SelectionSet)
+			}
+		case ast.PathIndex:
+			if typ == nil || typ.Elem == nil {
+				return nil
+			}
+			typ = typ.Elem
+		}
+		types = append(types, typ)
+	}
+	return types
+}
+
+// collectResponseKey returns the fields of sets answered under key. Validation makes sure they all have the same
+// type, whatever type condition they are selected under.
+func collectResponseKey(doc *ast.QueryDocument, sets []ast.SelectionSet, key string, fragments map[string]bool) []*ast.Field {
+	var fields []*ast.Field
+	for _, set := range sets {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				if sel.Alias == key {
+					fields = append(fields, sel)
+				}
+			case *ast.InlineFragment:
+				fields = append(fields, collectResponseKey(doc, []ast.SelectionSet{sel.SelectionSet}, key, fragments)...)
+			case *ast.FragmentSpread:
+				def := doc.Fragments.ForName(sel.Name)
+				if def != nil && !fragments[sel.Name] {
+					fragments[sel.Name] = true
+					fields = append(fields, collectResponseKey(doc, []ast.SelectionSet{def.SelectionSet}, key, fragments)...)
+				}
+			}
+		}
+	}
+	return fields
+}
+
+func isNullAt(data interface{}, path ast.Path) bool {
+	for _, el := range path {
+		switch el := el.(type) {
+		case ast.PathName:
+			obj, ok := data.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			data = obj[string(el)]
+		case ast.PathIndex:
+			list, ok := data.([]interface{})
+			if !ok || int(el) >= len(list) {
+				return false
+			}
+			data = list[el]
+		}
+	}
+	return data == nil
+}
@@ -0,0 +1,105 @@
+package extension_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestNullBubbling(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query {
+			user: User
+			me: User!
+		}
+		type User {
+			name: String!
+			nick: String
+			address: Address!
+			friends: [User!]
+		}
+		type Address { street: String! }
+	`})
+	var resp *graphql.Response
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(resp)
+		},
+	}
+	h := handler.New(es)
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.NullBubbling{})
+
+	errorAt := func(path ...interface{}) *gqlerror.Error {
+		p := ast.Path{}
+		for _, el := range path {
+			switch el := el.(type) {
+			case string:
+				p = append(p, ast.PathName(el))
+			case int:
+				p = append(p, ast.PathIndex(el))
+			}
+		}
+		return &gqlerror.Error{Message: "boom", Path: p}
+	}
+
+	t.Run("bubbles to the nearest nullable ancestor", func(t *testing.T) {
+		resp = &graphql.Response{
+			Data:   []byte(`{"u":null}`),
+			Errors: gqlerror.List{errorAt("u", "address", "street")},
+		}
+		w := doRequest(h, "POST", "/graphql", `{"query":"{ u: user { ... on User { address { street } } } }"}`)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, `{"errors":[{"message":"boom","path":["u","address","street"],"extensions":{"bubbling":[["u","address","street"],["u","address"],["u"]]}}],"data":{"u":null}}`, w.Body.String())
+	})
+
+	t.Run("through list items", func(t *testing.T) {
+		resp = &graphql.Response{
+			Data:   []byte(`{"user":{"friends":null}}`),
+			Errors: gqlerror.List{errorAt("user", "friends", 1, "name")},
+		}
+		w := doRequest(h, "POST", "/graphql", `{"query":"{ user { ...f } } fragment f on User { friends { name } }"}`)
+		require.Equal(t, `{"errors":[{"message":"boom","path":["user","friends",1,"name"],"extensions":{"bubbling":[["user","friends",1,"name"],["user","friends",1],["user","friends"]]}}],"data":{"user":{"friends":null}}}`, w.Body.String())
+	})
+
+	t.Run("up to the data", func(t *testing.T) {
+		resp = &graphql.Response{
+			Data:   []byte(`null`),
+			Errors: gqlerror.List{errorAt("me", "name")},
+		}
+		w := doRequest(h, "POST", "/graphql", `{"query":"{ me { name } }"}`)
+		require.Equal(t, `{"errors":[{"message":"boom","path":["me","name"],"extensions":{"bubbling":[["me","name"],["me"],[]]}}],"data":null}`, w.Body.String())
+	})
+
+	t.Run("nullable fields don't bubble", func(t *testing.T) {
+		resp = &graphql.Response{
+			Data:   []byte(`{"user":{"nick":null}}`),
+			Errors: gqlerror.List{errorAt("user", "nick")},
+		}
+		w := doRequest(h, "POST", "/graphql", `{"query":"{ user { nick } }"}`)
+		require.Equal(t, `{"errors":[{"message":"boom","path":["user","nick"]}],"data":{"user":{"nick":null}}}`, w.Body.String())
+	})
+
+	t.Run("errors that didn't null the field", func(t *testing.T) {
+		resp = &graphql.Response{
+			Data:   []byte(`{"user":{"name":"bob"}}`),
+			Errors: gqlerror.List{errorAt("user", "name")},
+		}
+		w := doRequest(h, "POST", "/graphql", `{"query":"{ user { name } }"}`)
+		require.Equal(t, `{"errors":[{"message":"boom","path":["user","name"]}],"data":{"user":{"name":"bob"}}}`, w.Body.String())
+	})
+}
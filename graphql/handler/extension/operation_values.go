@@ -0,0 +1,44 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// OperationValues gives every operation its own values, like dataloaders or caches, installed in its context by New.
+// HTTP middleware runs once per request, which is once per operation for most transports but once per connection for
+// websockets, where every operation would share the values set by the middleware. Values installed by OperationValues
+// are fresh for each operation whatever the transport, and work with any loader library:
+//
+//	srv.Use(extension.OperationValues{New: func(ctx context.Context) context.Context {
+//		return loaders.WithLoaders(ctx, loaders.NewLoaders(db))
+//	}})
+//
+// The events of a subscription share the values of their operation, use the same function with
+// handler.Server.AddContextCloner to give each event its own.
+type OperationValues struct {
+	// New returns the context of an operation with its values, it is called once before the operation executes.
+	New func(ctx context.Context) context.Context
+}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = OperationValues{}
+
+func (o OperationValues) ExtensionName() string {
+	return "OperationValues"
+}
+
+func (o OperationValues) Validate(schema graphql.ExecutableSchema) error {
+	if o.New == nil {
+		return fmt.Errorf("OperationValues.New can not be nil")
+	}
+	return nil
+}
+
+func (o OperationValues) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(o.New(ctx))
+}
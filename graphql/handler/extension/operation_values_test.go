@@ -0,0 +1,68 @@
+package extension_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestOperationValues(t *testing.T) {
+	type ctxKey struct{}
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{})
+	operations := 0
+	h.Use(extension.OperationValues{New: func(ctx context.Context) context.Context {
+		operations++
+		return context.WithValue(ctx, ctxKey{}, operations)
+	}})
+	var values []interface{}
+	h.AroundFields(func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+		values = append(values, ctx.Value(ctxKey{}))
+		return next(ctx)
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c, _, err := websocket.DefaultDialer.Dial(strings.Replace(srv.URL, "http://", "ws://", 1), nil)
+	require.NoError(t, err)
+	defer c.Close()
+
+	read := func(typ string) {
+		for {
+			var msg struct {
+				Type string `json:"type"`
+			}
+			require.NoError(t, c.ReadJSON(&msg))
+			if msg.Type == typ {
+				return
+			}
+		}
+	}
+
+	require.NoError(t, c.WriteJSON(map[string]interface{}{"type": "connection_init"}))
+	read("connection_ack")
+	for _, id := range []string{"1", "2"} {
+		require.NoError(t, c.WriteJSON(map[string]interface{}{
+			"type":    "start",
+			"id":      id,
+			"payload": map[string]interface{}{"query": "{ name }"},
+		}))
+		read("complete")
+	}
+
+	require.Equal(t, []interface{}{1, 2}, values)
+}
+
+func TestOperationValuesValidate(t *testing.T) {
+	require.EqualError(t, extension.OperationValues{}.Validate(nil), "OperationValues.New can not be nil")
+}
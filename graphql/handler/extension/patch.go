@@ -0,0 +1,169 @@
+package extension
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const patchExtension = "Patch"
+
+// Patch sends the events of subscriptions with the @patch directive as JSON Patch (RFC 6902) operations against the
+// previous event, cutting the bandwidth used by large objects changing a little at a time. The schema declares the
+// directive, which clients put on their subscriptions once they know how to apply patches:
+//
+//	directive @patch on SUBSCRIPTION
+//
+//	subscription Dashboard @patch { dashboard { ... } }
+//
+// The first event is sent as is. The following ones have no data, their "patch" extension holds the operations turning
+// the data of the previous event into theirs, an empty list when it did not change. Errors and other extensions are
+// sent with every event.
+type Patch struct{}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.HandlerExtension
+} = Patch{}
+
+// PatchOperation is a JSON Patch operation.
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+func (p Patch) ExtensionName() string {
+	return patchExtension
+}
+
+func (p Patch) Validate(schema graphql.ExecutableSchema) error {
+	d := schema.Schema().Directives["patch"]
+	if d == nil {
+		return fmt.Errorf("Patch requires the schema to declare directive @patch on SUBSCRIPTION")
+	}
+	for _, l := range d.Locations {
+		if l == ast.LocationSubscription {
+			return nil
+		}
+	}
+	return fmt.Errorf("the @patch directive must be allowed on SUBSCRIPTION")
+}
+
+func (p Patch) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	op := graphql.GetOperationContext(ctx).Operation
+	if op.Operation != ast.Subscription || op.Directives.ForName("patch") == nil {
+		return next(ctx)
+	}
+
+	responses := next(ctx)
+	var previous interface{}
+	first := true
+	return func(ctx context.Context) *graphql.Response {
+		resp := responses(ctx)
+		if resp == nil || resp.Path != nil {
+			return resp
+		}
+
+		data, err := decodePatchData(resp.Data)
+		if err != nil {
+			return resp
+		}
+		if first {
+			first = false
+			previous = data
+			return resp
+		}
+
+		ops := []PatchOperation{}
+		diffPatch(&ops, "", previous, data)
+		previous = data
+
+		patched := *resp
+		patched.Data = nil
+		patched.Extensions = make(map[string]interface{}, len(resp.Extensions)+1)
+		for k, v := range resp.Extensions {
+			patched.Extensions[k] = v
+		}
+		patched.Extensions["patch"] = ops
+		return &patched
+	}
+}
+
+func decodePatchData(data json.RawMessage) (interface{}, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// diffPatch appends the operations turning a into b, at path, to ops. Objects are compared key by key and lists of the
+// same length item by item, anything else that changed is replaced.
+func diffPatch(ops *[]PatchOperation, path string, a, b interface{}) {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		if b, ok := b.(map[string]interface{}); ok {
+			for _, k := range sortedPatchKeys(a) {
+				if bv, ok := b[k]; ok {
+					diffPatch(ops, path+"/"+escapePatchKey(k), a[k], bv)
+				} else {
+					*ops = append(*ops, PatchOperation{Op: "remove", Path: path + "/" + escapePatchKey(k)})
+				}
+			}
+			for _, k := range sortedPatchKeys(b) {
+				if _, ok := a[k]; !ok {
+					*ops = append(*ops, PatchOperation{Op: "add", Path: path + "/" + escapePatchKey(k), Value: patchValue(b[k])})
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if b, ok := b.([]interface{}); ok && len(a) == len(b) {
+			for i := range a {
+				diffPatch(ops, path+"/"+strconv.Itoa(i), a[i], b[i])
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		*ops = append(*ops, PatchOperation{Op: "replace", Path: path, Value: patchValue(b)})
+	}
+}
+
+// patchValue keeps nulls in add and replace operations, where omitting the value would make them invalid.
+func patchValue(v interface{}) interface{} {
+	if v == nil {
+		return json.RawMessage("null")
+	}
+	return v
+}
+
+func sortedPatchKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var patchKeyEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func escapePatchKey(k string) string {
+	return patchKeyEscaper.Replace(k)
+}
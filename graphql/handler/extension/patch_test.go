@@ -0,0 +1,92 @@
+package extension_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+func TestPatch(t *testing.T) {
+	p := extension.Patch{}
+
+	// intercept returns the events of an operation with the given directives sending the given data
+	intercept := func(operation ast.Operation, directives ast.DirectiveList, data ...string) []*graphql.Response {
+		ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+			Operation: &ast.OperationDefinition{Operation: operation, Directives: directives},
+		})
+		responses := p.InterceptOperation(ctx, func(ctx context.Context) graphql.ResponseHandler {
+			return func(ctx context.Context) *graphql.Response {
+				if len(data) == 0 {
+					return nil
+				}
+				resp := &graphql.Response{Data: json.RawMessage(data[0])}
+				data = data[1:]
+				return resp
+			}
+		})
+		var events []*graphql.Response
+		for resp := responses(ctx); resp != nil; resp = responses(ctx) {
+			events = append(events, resp)
+		}
+		return events
+	}
+	patch := ast.DirectiveList{{Name: "patch"}}
+
+	t.Run("sends patches after the first event", func(t *testing.T) {
+		events := intercept(ast.Subscription, patch,
+			`{"dashboard":{"a/b":1,"items":[{"n":1},{"n":2}],"old":true}}`,
+			`{"dashboard":{"a/b":2,"items":[{"n":1},{"n":3}],"new":null}}`,
+			`{"dashboard":{"a/b":2,"items":[{"n":1}],"new":null}}`,
+			`{"dashboard":{"a/b":2,"items":[{"n":1}],"new":null}}`,
+		)
+		require.Len(t, events, 4)
+		require.JSONEq(t, `{"dashboard":{"a/b":1,"items":[{"n":1},{"n":2}],"old":true}}`, string(events[0].Data))
+
+		for i, want := range []string{
+			`[
+				{"op":"replace","path":"/dashboard/a~1b","value":2},
+				{"op":"replace","path":"/dashboard/items/1/n","value":3},
+				{"op":"remove","path":"/dashboard/old"},
+				{"op":"add","path":"/dashboard/new","value":null}
+			]`,
+			`[{"op":"replace","path":"/dashboard/items","value":[{"n":1}]}]`,
+			`[]`,
+		} {
+			event := events[i+1]
+			require.Nil(t, event.Data)
+			ops, err := json.Marshal(event.Extensions["patch"])
+			require.NoError(t, err)
+			require.JSONEq(t, want, string(ops))
+		}
+	})
+
+	t.Run("leaves other operations alone", func(t *testing.T) {
+		events := intercept(ast.Subscription, nil, `{"a":1}`, `{"a":2}`)
+		require.Len(t, events, 2)
+		require.Equal(t, `{"a":2}`, string(events[1].Data))
+
+		events = intercept(ast.Query, patch, `{"a":1}`)
+		require.Len(t, events, 1)
+		require.Equal(t, `{"a":1}`, string(events[0].Data))
+	})
+
+	t.Run("validates the directive is declared", func(t *testing.T) {
+		schema := func(input string) graphql.ExecutableSchema {
+			return &graphql.ExecutableSchemaMock{SchemaFunc: func() *ast.Schema {
+				return gqlparser.MustLoadSchema(&ast.Source{Input: input})
+			}}
+		}
+		require.EqualError(t, p.Validate(schema(`type Query { name: String! }`)),
+			"Patch requires the schema to declare directive @patch on SUBSCRIPTION")
+		require.EqualError(t, p.Validate(schema(`directive @patch on QUERY type Query { name: String! }`)),
+			"the @patch directive must be allowed on SUBSCRIPTION")
+		require.NoError(t, p.Validate(schema(`directive @patch on SUBSCRIPTION type Query { name: String! }`)))
+	})
+}
@@ -0,0 +1,55 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+const errPersistedDocumentNotFoundCode = "PERSISTED_DOCUMENT_NOT_FOUND"
+
+// PersistedDocuments serves requests naming a trusted document with the documentId request member in place of a
+// query. Unlike AutomaticPersistedQuery, clients can't register documents: the store is filled by the server, usually
+// from the manifest of documents built with the clients.
+type PersistedDocuments struct {
+	// Store maps document ids to the query text of the documents.
+	Store graphql.Cache
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.HandlerExtension
+} = PersistedDocuments{}
+
+func (p PersistedDocuments) ExtensionName() string {
+	return "PersistedDocuments"
+}
+
+func (p PersistedDocuments) Validate(schema graphql.ExecutableSchema) error {
+	if p.Store == nil {
+		return fmt.Errorf("PersistedDocuments.Store can not be nil")
+	}
+	return nil
+}
+
+func (p PersistedDocuments) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	if rawParams.DocumentID == "" {
+		return nil
+	}
+	if rawParams.Query != "" {
+		return gqlerror.Errorf("documentId and query can not both be sent")
+	}
+
+	query, ok := p.Store.Get(ctx, rawParams.DocumentID)
+	if !ok {
+		err := gqlerror.Errorf("persisted document %s not found", rawParams.DocumentID)
+		errcode.Set(err, errPersistedDocumentNotFoundCode)
+		return err
+	}
+	rawParams.Query = query.(string)
+	return nil
+}
@@ -0,0 +1,40 @@
+package extension_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestPersistedDocuments(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.PersistedDocuments{Store: graphql.MapCache{"sha256:abc": "{ name }"}})
+
+	t.Run("serves known documents", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"documentId":"sha256:abc"}`)
+		require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("unknown documents", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"documentId":"sha256:def"}`)
+		require.Equal(t, `{"errors":[{"message":"persisted document sha256:def not found","extensions":{"code":"PERSISTED_DOCUMENT_NOT_FOUND"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("query and documentId", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"documentId":"sha256:abc","query":"{ name }"}`)
+		require.Equal(t, `{"errors":[{"message":"documentId and query can not both be sent"}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("plain queries", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+}
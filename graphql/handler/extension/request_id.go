@@ -0,0 +1,87 @@
+package extension
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const (
+	// DefaultRequestIDHeader is the header RequestID reads ids from when none is set.
+	DefaultRequestIDHeader = "X-Request-ID"
+
+	// maxRequestIDLength bounds ids taken from clients, longer ones are replaced by a generated id.
+	maxRequestIDLength = 128
+)
+
+// RequestID gives every operation an id, taken from a request header or generated, so errors and logs can be traced
+// back to the request. The id is stored on the operation context, where resolvers read it with graphql.RequestID, is
+// added to the records of graphql.GetLogger as request_id and to the extensions of every error as requestId.
+//
+// Add it before other extensions, so errors they return carry the id as well.
+type RequestID struct {
+	// Header holding the id of the request, DefaultRequestIDHeader when empty.
+	Header string
+	// Generate returns the id of requests without one, 16 random bytes in hex when nil.
+	Generate func() string
+}
+
+var _ interface {
+	graphql.OperationParameterMutator
+	graphql.ResponseInterceptor
+	graphql.HandlerExtension
+} = RequestID{}
+
+func (r RequestID) ExtensionName() string {
+	return "RequestID"
+}
+
+func (r RequestID) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (r RequestID) MutateOperationParameters(ctx context.Context, rawParams *graphql.RawParams) *gqlerror.Error {
+	header := r.Header
+	if header == "" {
+		header = DefaultRequestIDHeader
+	}
+
+	id := rawParams.Headers.Get(header)
+	if id == "" || len(id) > maxRequestIDLength {
+		id = r.generate()
+	}
+	graphql.GetOperationContext(ctx).RequestID = id
+	return nil
+}
+
+func (r RequestID) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	resp := next(ctx)
+	if resp == nil || !graphql.HasOperationContext(ctx) {
+		return resp
+	}
+
+	id := graphql.GetOperationContext(ctx).RequestID
+	if id == "" {
+		return resp
+	}
+	for _, err := range resp.Errors {
+		if err.Extensions == nil {
+			err.Extensions = map[string]interface{}{}
+		}
+		err.Extensions["requestId"] = id
+	}
+	return resp
+}
+
+func (r RequestID) generate() string {
+	if r.Generate != nil {
+		return r.Generate()
+	}
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
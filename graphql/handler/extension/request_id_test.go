@@ -0,0 +1,85 @@
+package extension_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestRequestID(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.RequestID{Generate: func() string { return "generated" }})
+	h.AddTransport(&transport.POST{})
+
+	var id string
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		id = graphql.RequestID(ctx)
+		return next(ctx)
+	})
+
+	t.Run("reads the header", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/graphql", strings.NewReader(`{"query":"{ name }"}`))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("X-Request-ID", "abc")
+		h.ServeHTTP(httptest.NewRecorder(), r)
+
+		require.Equal(t, "abc", id)
+	})
+
+	t.Run("generates missing ids", func(t *testing.T) {
+		doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+		require.Equal(t, "generated", id)
+	})
+
+	t.Run("adds the id to errors", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ nope }"}`)
+		require.Equal(t, `{"errors":[{"message":"Cannot query field \"nope\" on type \"Query\". Did you mean \"name\"?","locations":[{"line":1,"column":3}],"extensions":{"code":"GRAPHQL_VALIDATION_FAILED","requestId":"generated"}}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("adds the id to logs", func(t *testing.T) {
+		var log bytes.Buffer
+		h.SetLogger(slog.New(slog.NewJSONHandler(&log, nil)))
+		t.Cleanup(func() { h.SetLogger(nil) })
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			graphql.GetLogger(ctx).Info("responding")
+			return next(ctx)
+		})
+
+		doRequest(h, "POST", "/graphql", `{"query":"query Named { name }","operationName":"Named"}`)
+
+		var record map[string]interface{}
+		require.NoError(t, json.Unmarshal(log.Bytes(), &record))
+		require.Equal(t, "generated", record["request_id"])
+		require.Equal(t, "Named", record["operation"])
+	})
+}
+
+func TestRequestIDGenerate(t *testing.T) {
+	h := testserver.New()
+	h.Use(extension.RequestID{Header: "X-Trace"})
+	h.AddTransport(&transport.POST{})
+
+	var ids []string
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		ids = append(ids, graphql.RequestID(ctx))
+		return next(ctx)
+	})
+
+	doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+	doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`)
+
+	require.Len(t, ids, 2)
+	require.Len(t, ids[0], 32)
+	require.NotEqual(t, ids[0], ids[1])
+}
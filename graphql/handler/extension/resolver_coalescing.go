@@ -0,0 +1,105 @@
+package extension
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/internal/flight"
+)
+
+// ResolverCoalescing calls the resolver of a field once when an operation resolves it several times at the same time,
+// for the same parent object and with the same arguments and directives, like a field selected under two aliases or
+// through fragments reaching the same object twice. The other resolutions wait for it and share its result, errors
+// included.
+//
+// Parents are told apart by pointer, or by the address of their element for lists of values. Fields of other parents
+// that are not pointers or maps are always resolved, as are fields that are not methods or resolvers, and the root
+// fields of mutations and subscriptions. Resolutions running one after the other, like the fields of mutations, are
+// not coalesced.
+type ResolverCoalescing struct{}
+
+type coalescingCtx struct{}
+
+var _ interface {
+	graphql.OperationInterceptor
+	graphql.FieldInterceptor
+	graphql.HandlerExtension
+} = ResolverCoalescing{}
+
+func (c ResolverCoalescing) ExtensionName() string {
+	return "ResolverCoalescing"
+}
+
+func (c ResolverCoalescing) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (c ResolverCoalescing) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	return next(context.WithValue(ctx, coalescingCtx{}, &flight.Group{}))
+}
+
+func (c ResolverCoalescing) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	group, _ := ctx.Value(coalescingCtx{}).(*flight.Group)
+	fc := graphql.GetFieldContext(ctx)
+	if group == nil || fc == nil || !(fc.IsMethod || fc.IsResolver) {
+		return next(ctx)
+	}
+	key, ok := coalescingKey(ctx, fc)
+	if !ok {
+		return next(ctx)
+	}
+	return group.Do(key, func() (interface{}, error) {
+		return next(ctx)
+	})
+}
+
+// coalescingKey identifies the resolution of fc by the address and type of its parent, its field, its arguments and
+// the directives on the field, which run with the resolver and can change its result.
+func coalescingKey(ctx context.Context, fc *graphql.FieldContext) (string, bool) {
+	opCtx := graphql.GetOperationContext(ctx)
+	var parent uintptr
+	var parentType reflect.Type
+	if fc.Parent == nil {
+		if op := opCtx.Operation; op == nil || op.Operation != ast.Query {
+			return "", false
+		}
+	} else {
+		v := reflect.ValueOf(fc.Parent.Result)
+		if fc.Parent.Index != nil && v.Kind() == reflect.Pointer && !v.IsNil() {
+			// list items hold the address of the item in the list, items that are pointers or maps are told apart
+			// by their own address and items that are values by the address of their element in the list
+			if elem := v.Elem(); elem.Kind() == reflect.Pointer || elem.Kind() == reflect.Map {
+				v = elem
+			}
+		}
+		if v.Kind() != reflect.Pointer && v.Kind() != reflect.Map || v.IsNil() {
+			return "", false
+		}
+		parent = v.Pointer()
+		parentType = v.Type()
+	}
+	args, err := json.Marshal(fc.Args)
+	if err != nil {
+		return "", false
+	}
+	directives := make([]interface{}, 0, len(fc.Field.Directives))
+	for _, d := range fc.Field.Directives {
+		dirArgs := make(map[string]interface{}, len(d.Arguments))
+		for _, arg := range d.Arguments {
+			if dirArgs[arg.Name], err = arg.Value.Value(opCtx.Variables); err != nil {
+				return "", false
+			}
+		}
+		directives = append(directives, []interface{}{d.Name, dirArgs})
+	}
+	dirs, err := json.Marshal(directives)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%x/%v/%s.%s/%s/%s", parent, parentType, fc.Object, fc.Field.Name, args, dirs), true
+}
@@ -0,0 +1,131 @@
+package extension_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+)
+
+func TestResolverCoalescing(t *testing.T) {
+	type user struct{ id string }
+	c := extension.ResolverCoalescing{}
+
+	// resolve runs fields concurrently in one operation and returns how many times the resolver was called
+	resolve := func(t *testing.T, operation ast.Operation, fields ...*graphql.FieldContext) int32 {
+		var opCtx context.Context
+		c.InterceptOperation(graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+			Operation: &ast.OperationDefinition{Operation: operation},
+		}), func(ctx context.Context) graphql.ResponseHandler {
+			opCtx = ctx
+			return nil
+		})
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		var wg sync.WaitGroup
+		for _, fc := range fields {
+			wg.Add(1)
+			go func(fc *graphql.FieldContext) {
+				defer wg.Done()
+				ctx := opCtx
+				if fc.Parent != nil {
+					ctx = graphql.WithFieldContext(ctx, fc.Parent)
+				}
+				res, err := c.InterceptField(graphql.WithFieldContext(ctx, fc), func(ctx context.Context) (interface{}, error) {
+					calls.Add(1)
+					<-release
+					return "result", nil
+				})
+				require.NoError(t, err)
+				require.Equal(t, "result", res)
+			}(fc)
+		}
+		require.Eventually(t, func() bool { return calls.Load() > 0 }, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		return calls.Load()
+	}
+	field := func(parent interface{}, name string, args map[string]interface{}) *graphql.FieldContext {
+		fc := &graphql.FieldContext{
+			Object:     "User",
+			Field:      graphql.CollectedField{Field: &ast.Field{Name: name}},
+			Args:       args,
+			IsResolver: true,
+		}
+		if parent != nil {
+			fc.Parent = &graphql.FieldContext{Result: parent}
+		}
+		return fc
+	}
+
+	t.Run("coalesces the same field of the same parent", func(t *testing.T) {
+		u := &user{id: "1"}
+		list := []*user{u}
+		item := &graphql.FieldContext{Index: new(int), Result: &list[0]}
+		inList := field(nil, "friends", map[string]interface{}{"first": 2})
+		inList.Parent = item
+
+		require.EqualValues(t, 1, resolve(t, ast.Query,
+			field(u, "friends", map[string]interface{}{"first": 2}),
+			field(u, "friends", map[string]interface{}{"first": 2}),
+			inList,
+		))
+	})
+
+	t.Run("resolves different parents, fields and arguments", func(t *testing.T) {
+		u := &user{id: "1"}
+		require.EqualValues(t, 4, resolve(t, ast.Query,
+			field(u, "friends", map[string]interface{}{"first": 2}),
+			field(u, "friends", map[string]interface{}{"first": 3}),
+			field(u, "posts", map[string]interface{}{"first": 2}),
+			field(&user{id: "1"}, "friends", map[string]interface{}{"first": 2}),
+		))
+	})
+
+	t.Run("coalesces fields of the same item of a list of values", func(t *testing.T) {
+		list := []user{{id: "1"}, {id: "1"}}
+		item := func(i int) *graphql.FieldContext {
+			return &graphql.FieldContext{Index: &i, Result: &list[i]}
+		}
+		inList := func(i int) *graphql.FieldContext {
+			fc := field(nil, "friends", nil)
+			fc.Parent = item(i)
+			return fc
+		}
+		require.EqualValues(t, 1, resolve(t, ast.Query, inList(0), inList(0)))
+		require.EqualValues(t, 2, resolve(t, ast.Query, inList(0), inList(1)))
+	})
+
+	t.Run("resolves fields with different directives", func(t *testing.T) {
+		u := &user{id: "1"}
+		withDirective := func(value string) *graphql.FieldContext {
+			fc := field(u, "name", nil)
+			fc.Field.Directives = ast.DirectiveList{{
+				Name:      "format",
+				Arguments: ast.ArgumentList{{Name: "case", Value: &ast.Value{Kind: ast.EnumValue, Raw: value}}},
+			}}
+			return fc
+		}
+		require.EqualValues(t, 1, resolve(t, ast.Query, withDirective("UPPER"), withDirective("UPPER")))
+		require.EqualValues(t, 2, resolve(t, ast.Query, withDirective("UPPER"), withDirective("LOWER")))
+		require.EqualValues(t, 2, resolve(t, ast.Query, withDirective("UPPER"), field(u, "name", nil)))
+	})
+
+	t.Run("resolves fields of values", func(t *testing.T) {
+		require.EqualValues(t, 2, resolve(t, ast.Query, field(user{id: "1"}, "friends", nil), field(user{id: "1"}, "friends", nil)))
+	})
+
+	t.Run("coalesces root query fields only", func(t *testing.T) {
+		require.EqualValues(t, 1, resolve(t, ast.Query, field(nil, "me", nil), field(nil, "me", nil)))
+		require.EqualValues(t, 2, resolve(t, ast.Mutation, field(nil, "like", nil), field(nil, "like", nil)))
+	})
+}
@@ -0,0 +1,140 @@
+package extension
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// VariableDefaults fills in variables the client didn't send with values chosen by the server, so that clients
+// released before an operation gained a required variable, such as a locale or tenant, keep working.
+//
+// Only variables declared by the operation are filled in, so defaults can be shared by operations that don't use
+// them. The defaults are applied to the parsed and validated query, after the query cache and the limits of the
+// executor.
+type VariableDefaults struct {
+	// Defaults holds the values of variables by name, for every operation.
+	Defaults map[string]interface{}
+	// Operations holds the values of variables by operation name and variable name, taking precedence over Defaults
+	// and the schema.
+	Operations map[string]map[string]interface{}
+	// Directive names a directive of the schema declaring the value of the variables passed to an argument, taking
+	// precedence over Defaults:
+	//
+	//	directive @variableDefault(value: String!) on ARGUMENT_DEFINITION
+	//
+	//	type Query {
+	//		products(locale: String! @variableDefault(value: "en-US")): [Product!]!
+	//	}
+	//
+	// The value is written as a literal of the type of the argument.
+	Directive string
+}
+
+var _ interface {
+	graphql.OperationVariablesMutator
+	graphql.HandlerExtension
+} = VariableDefaults{}
+
+func (v VariableDefaults) ExtensionName() string {
+	return "VariableDefaults"
+}
+
+func (v VariableDefaults) Validate(schema graphql.ExecutableSchema) error {
+	if v.Directive == "" {
+		return nil
+	}
+	def := schema.Schema().Directives[v.Directive]
+	if def == nil {
+		return fmt.Errorf("VariableDefaults: the schema doesn't declare the directive @%s", v.Directive)
+	}
+	if def.Arguments.ForName("value") == nil {
+		return fmt.Errorf("VariableDefaults: the directive @%s has no value argument", v.Directive)
+	}
+	return nil
+}
+
+func (v VariableDefaults) MutateOperationVariables(ctx context.Context, op *ast.OperationDefinition, variables map[string]interface{}) (map[string]interface{}, *gqlerror.Error) {
+	if len(v.Defaults) == 0 && len(v.Operations) == 0 && v.Directive == "" {
+		return variables, nil
+	}
+
+	for _, def := range op.VariableDefinitions {
+		if _, ok := variables[def.Variable]; ok {
+			continue
+		}
+		value, ok := v.Operations[op.Name][def.Variable]
+		if !ok && v.Directive != "" {
+			value, ok = v.schemaDefault(op.SelectionSet, def.Variable, map[string]bool{})
+		}
+		if !ok {
+			value, ok = v.Defaults[def.Variable]
+		}
+		if !ok {
+			continue
+		}
+		if variables == nil {
+			variables = map[string]interface{}{}
+		}
+		variables[def.Variable] = value
+	}
+	return variables, nil
+}
+
+// schemaDefault returns the value the directive declares on the first argument the variable is passed to.
+func (v VariableDefaults) schemaDefault(set ast.SelectionSet, variable string, seen map[string]bool) (interface{}, bool) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			if sel.Definition != nil {
+				for _, arg := range sel.Arguments {
+					if arg.Value.Kind != ast.Variable || arg.Value.Raw != variable {
+						continue
+					}
+					if value, ok := v.argumentDefault(sel.Definition.Arguments.ForName(arg.Name)); ok {
+						return value, true
+					}
+				}
+			}
+			if value, ok := v.schemaDefault(sel.SelectionSet, variable, seen); ok {
+				return value, true
+			}
+		case *ast.InlineFragment:
+			if value, ok := v.schemaDefault(sel.SelectionSet, variable, seen); ok {
+				return value, true
+			}
+		case *ast.FragmentSpread:
+			if sel.Definition == nil || seen[sel.Name] {
+				continue
+			}
+			seen[sel.Name] = true
+			if value, ok := v.schemaDefault(sel.Definition.SelectionSet, variable, seen); ok {
+				return value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (v VariableDefaults) argumentDefault(def *ast.ArgumentDefinition) (interface{}, bool) {
+	if def == nil {
+		return nil, false
+	}
+	dir := def.Directives.ForName(v.Directive)
+	if dir == nil {
+		return nil, false
+	}
+	arg := dir.Arguments.ForName("value")
+	if arg == nil {
+		return nil, false
+	}
+	value, err := arg.Value.Value(nil)
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
@@ -0,0 +1,100 @@
+package extension_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestVariableDefaults(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(&transport.POST{})
+	h.Use(extension.VariableDefaults{
+		Defaults:   map[string]interface{}{"id": 1, "unused": "x"},
+		Operations: map[string]map[string]interface{}{"Other": {"id": 2}},
+	})
+
+	var variables map[string]interface{}
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		variables = graphql.GetOperationContext(ctx).Variables
+		return next(ctx)
+	})
+
+	t.Run("fills in missing variables", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"query Find($id: Int!) { find(id: $id) }"}`)
+		require.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+		require.Len(t, variables, 1)
+		require.EqualValues(t, 1, variables["id"])
+	})
+
+	t.Run("per operation", func(t *testing.T) {
+		doRequest(h, "POST", "/graphql", `{"query":"query Other($id: Int!) { find(id: $id) }"}`)
+		require.Len(t, variables, 1)
+		require.EqualValues(t, 2, variables["id"])
+	})
+
+	t.Run("keeps sent variables", func(t *testing.T) {
+		doRequest(h, "POST", "/graphql", `{"query":"query Find($id: Int!) { find(id: $id) }","variables":{"id":3}}`)
+		require.Len(t, variables, 1)
+		require.EqualValues(t, 3, variables["id"])
+	})
+}
+
+func TestVariableDefaultsDirective(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @variableDefault(value: String!) on ARGUMENT_DEFINITION
+		type Query {
+			products(locale: String! @variableDefault(value: "en-US"), first: Int): [String!]!
+		}
+	`})
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			return graphql.OneShot(&graphql.Response{Data: []byte(`{}`)})
+		},
+	}
+	h := handler.New(es)
+	h.AddTransport(&transport.POST{})
+	h.SetQueryCache(lru.New(10))
+	h.Use(extension.VariableDefaults{
+		Directive:  "variableDefault",
+		Defaults:   map[string]interface{}{"l": "fr-FR", "n": 5},
+		Operations: map[string]map[string]interface{}{"Other": {"locale": "de-DE"}},
+	})
+
+	var variables map[string]interface{}
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		variables = graphql.GetOperationContext(ctx).Variables
+		return next(ctx)
+	})
+
+	t.Run("takes precedence over the defaults", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			resp := doRequest(h, "POST", "/graphql", `{"query":"query Find($l: String!, $n: Int) { ...F } fragment F on Query { products(locale: $l, first: $n) }"}`)
+			require.Equal(t, `{"data":{}}`, resp.Body.String())
+			require.Equal(t, map[string]interface{}{"l": "en-US", "n": 5}, variables)
+		}
+	})
+
+	t.Run("the operations take precedence over the schema", func(t *testing.T) {
+		doRequest(h, "POST", "/graphql", `{"query":"query Other($locale: String!) { products(locale: $locale) }"}`)
+		require.Equal(t, map[string]interface{}{"locale": "de-DE"}, variables)
+	})
+
+	t.Run("the directive must be declared", func(t *testing.T) {
+		require.EqualError(t, extension.VariableDefaults{Directive: "missing"}.Validate(es), "VariableDefaults: the schema doesn't declare the directive @missing")
+	})
+}
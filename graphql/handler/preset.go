@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+type (
+	// Preset is a bundle of server configuration that can be shared between services and applied with
+	// NewFromPreset or Server.ApplyPreset. Presets are values: a copy can have members replaced with With* or
+	// removed with Without* methods, or fields reassigned, without changing the original. The copies still share the
+	// transports, caches and extensions they hold, so build presets with a function when servers must not share
+	// caches.
+	Preset struct {
+		Transports []graphql.Transport
+		Caches     Caches
+		Limits     Limits
+		// Extensions are used in order before Tracing.
+		Extensions []graphql.HandlerExtension
+		Tracing    []graphql.HandlerExtension
+	}
+
+	// Caches of a Preset. Nil caches are left unset.
+	Caches struct {
+		// Query caches parsed and validated queries, see Server.SetQueryCache.
+		Query graphql.Cache
+		// PersistedQuery turns on automatic persisted queries stored in it, see extension.AutomaticPersistedQuery.
+		PersistedQuery graphql.Cache
+	}
+
+	// Limits of a Preset. Zero limits are not enforced.
+	Limits struct {
+		// Complexity rejects operations more complex than it, see extension.FixedComplexityLimit.
+		Complexity int
+		// Selections rejects operations selecting more fields than it, see Server.SetSelectionLimit.
+		Selections int
+	}
+)
+
+// DefaultPreset returns the configuration of NewDefaultServer, with new caches on every call.
+func DefaultPreset() Preset {
+	return Preset{
+		Transports: []graphql.Transport{
+			transport.Websocket{
+				KeepAlivePingInterval: 10 * time.Second,
+			},
+			transport.Options{},
+			transport.GET{},
+			transport.POST{},
+			transport.MultipartForm{},
+		},
+		Caches: Caches{
+			Query:          lru.New(1000),
+			PersistedQuery: lru.New(100),
+		},
+		Extensions: []graphql.HandlerExtension{
+			extension.Introspection{},
+		},
+	}
+}
+
+// NewFromPreset creates a server configured by p.
+func NewFromPreset(es graphql.ExecutableSchema, p Preset) *Server {
+	srv := New(es)
+	srv.ApplyPreset(p)
+	return srv
+}
+
+// ApplyPreset adds the transports and extensions of p to s, and sets its caches and limits.
+func (s *Server) ApplyPreset(p Preset) {
+	for _, t := range p.Transports {
+		s.AddTransport(t)
+	}
+
+	if p.Caches.Query != nil {
+		s.SetQueryCache(p.Caches.Query)
+	}
+
+	if p.Limits.Selections > 0 {
+		s.SetSelectionLimit(p.Limits.Selections)
+	}
+	if p.Limits.Complexity > 0 {
+		s.Use(extension.FixedComplexityLimit(p.Limits.Complexity))
+	}
+
+	for _, ext := range p.Extensions {
+		s.Use(ext)
+	}
+	if p.Caches.PersistedQuery != nil {
+		s.Use(extension.AutomaticPersistedQuery{
+			Cache: p.Caches.PersistedQuery,
+		})
+	}
+	for _, ext := range p.Tracing {
+		s.Use(ext)
+	}
+}
+
+// WithTransport returns a copy of p with t in place of the transport of the same type, or added after the others if
+// p has none.
+func (p Preset) WithTransport(t graphql.Transport) Preset {
+	p.Transports = replaceOrAppend(p.Transports, t, func(other graphql.Transport) bool {
+		return reflect.TypeOf(other) == reflect.TypeOf(t)
+	})
+	return p
+}
+
+// WithoutTransport returns a copy of p without transports of the same type as t, so
+// p.WithoutTransport(transport.Websocket{}) drops websockets.
+func (p Preset) WithoutTransport(t graphql.Transport) Preset {
+	p.Transports = remove(p.Transports, func(other graphql.Transport) bool {
+		return reflect.TypeOf(other) == reflect.TypeOf(t)
+	})
+	return p
+}
+
+// WithExtension returns a copy of p with ext in place of the extension or tracer with the same ExtensionName, or added
+// after the other extensions if p has none.
+func (p Preset) WithExtension(ext graphql.HandlerExtension) Preset {
+	sameName := func(other graphql.HandlerExtension) bool {
+		return other.ExtensionName() == ext.ExtensionName()
+	}
+	for _, other := range p.Tracing {
+		if sameName(other) {
+			p.Tracing = replaceOrAppend(p.Tracing, ext, sameName)
+			return p
+		}
+	}
+	p.Extensions = replaceOrAppend(p.Extensions, ext, sameName)
+	return p
+}
+
+// WithoutExtension returns a copy of p without the extensions and tracers named name.
+func (p Preset) WithoutExtension(name string) Preset {
+	named := func(ext graphql.HandlerExtension) bool {
+		return ext.ExtensionName() == name
+	}
+	p.Extensions = remove(p.Extensions, named)
+	p.Tracing = remove(p.Tracing, named)
+	return p
+}
+
+func replaceOrAppend[T any](list []T, v T, match func(T) bool) []T {
+	out := make([]T, 0, len(list)+1)
+	replaced := false
+	for _, item := range list {
+		if match(item) {
+			if !replaced {
+				out = append(out, v)
+				replaced = true
+			}
+			continue
+		}
+		out = append(out, item)
+	}
+	if !replaced {
+		out = append(out, v)
+	}
+	return out
+}
+
+func remove[T any](list []T, match func(T) bool) []T {
+	out := make([]T, 0, len(list))
+	for _, item := range list {
+		if !match(item) {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/apollotracing"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestPreset(t *testing.T) {
+	t.Run("excluding and overriding members", func(t *testing.T) {
+		base := handler.DefaultPreset()
+		base.Tracing = []graphql.HandlerExtension{apollotracing.Tracer{}}
+
+		p := base.
+			WithoutTransport(transport.Websocket{}).
+			WithTransport(transport.POST{ResponseHeaders: map[string][]string{"X-Preset": {"hardened"}}}).
+			WithoutExtension("Introspection").
+			WithExtension(extension.RequestID{})
+
+		require.Len(t, p.Transports, 4)
+		assert.IsType(t, transport.Options{}, p.Transports[0])
+		assert.Equal(t, []string{"hardened"}, p.Transports[2].(transport.POST).ResponseHeaders["X-Preset"])
+		assert.Equal(t, []graphql.HandlerExtension{extension.RequestID{}}, p.Extensions)
+		assert.Equal(t, []graphql.HandlerExtension{apollotracing.Tracer{}}, p.Tracing)
+
+		require.Len(t, base.Transports, 5, "the original preset is unchanged")
+		assert.Equal(t, []graphql.HandlerExtension{extension.Introspection{}}, base.Extensions)
+	})
+
+	t.Run("applies limits", func(t *testing.T) {
+		srv := testserver.New()
+		srv.ApplyPreset(handler.Preset{
+			Transports: []graphql.Transport{transport.GET{}},
+			Limits:     handler.Limits{Selections: 1},
+		})
+
+		resp := get(srv, "/foo?query={name}")
+		assert.Equal(t, http.StatusOK, resp.Code)
+
+		resp = get(srv, "/foo?query={name,n2:name}")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.Contains(t, resp.Body.String(), "SELECTION_LIMIT_EXCEEDED")
+	})
+}
@@ -4,60 +4,76 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
-	"time"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/executor"
-	"github.com/99designs/gqlgen/graphql/handler/extension"
-	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 )
 
 type (
 	Server struct {
-		transports []graphql.Transport
-		exec       *executor.Executor
+		es           graphql.ExecutableSchema
+		transports   []graphql.Transport
+		encoders     map[string]transport.ResponseEncoder
+		extensions   []graphql.HandlerExtension
+		exec         *executor.Executor
+		logger       *slog.Logger
+		onWriteError transport.WriteErrorFunc
+		opHeader     string
 	}
 )
 
 func New(es graphql.ExecutableSchema) *Server {
 	return &Server{
+		es:   es,
 		exec: executor.New(es),
 	}
 }
 
+// NewDefaultServer creates a server configured by DefaultPreset.
 func NewDefaultServer(es graphql.ExecutableSchema) *Server {
-	srv := New(es)
-
-	srv.AddTransport(transport.Websocket{
-		KeepAlivePingInterval: 10 * time.Second,
-	})
-	srv.AddTransport(transport.Options{})
-	srv.AddTransport(transport.GET{})
-	srv.AddTransport(transport.POST{})
-	srv.AddTransport(transport.MultipartForm{})
+	return NewFromPreset(es, DefaultPreset())
+}
 
-	srv.SetQueryCache(lru.New(1000))
+func (s *Server) AddTransport(transport graphql.Transport) {
+	s.transports = append(s.transports, transport)
+}
 
-	srv.Use(extension.Introspection{})
-	srv.Use(extension.AutomaticPersistedQuery{
-		Cache: lru.New(100),
-	})
+// AddResponseEncoder registers an encoder used instead of json when mediaType is the type of the Accept header of a
+// request with the highest quality value. Only transports that write a single response per request, those
+// implementing transport.ResponseEncodingTransport, are given the encoder.
+func (s *Server) AddResponseEncoder(mediaType string, enc transport.ResponseEncoder) {
+	if s.encoders == nil {
+		s.encoders = map[string]transport.ResponseEncoder{}
+	}
+	s.encoders[mediaType] = enc
+}
 
-	return srv
+// OnWriteError sets a hook called when writing a response fails, such as when the client went away, to count these
+// apart from server errors and clean up after them. It gets the request context and the number of bytes written.
+func (s *Server) OnWriteError(f transport.WriteErrorFunc) {
+	s.onWriteError = f
 }
 
-func (s *Server) AddTransport(transport graphql.Transport) {
-	s.transports = append(s.transports, transport)
+// SetOperationHeader sets a response header to the type and name of the operation, like "query GetUser", for access
+// logs and proxies in front of the server. Middlewares running in the same process can install a
+// graphql.OperationInfo in the request context instead, see graphql.WithOperationInfo.
+func (s *Server) SetOperationHeader(header string) {
+	s.opHeader = header
 }
 
 func (s *Server) SetErrorPresenter(f graphql.ErrorPresenterFunc) {
 	s.exec.SetErrorPresenter(f)
 }
 
+func (s *Server) SetWarningPresenter(f graphql.WarningPresenterFunc) {
+	s.exec.SetWarningPresenter(f)
+}
+
 func (s *Server) SetRecoverFunc(f graphql.RecoverFunc) {
 	s.exec.SetRecoverFunc(f)
 }
@@ -66,8 +82,90 @@ func (s *Server) SetQueryCache(cache graphql.Cache) {
 	s.exec.SetQueryCache(cache)
 }
 
+// SetQueryParser replaces the parser and validator of queries, see executor.Executor.SetQueryParser.
+func (s *Server) SetQueryParser(p graphql.QueryParser) {
+	s.exec.SetQueryParser(p)
+}
+
+// SetTokenLimit caps the number of tokens of queries, see executor.Executor.SetTokenLimit.
+func (s *Server) SetTokenLimit(limit int) {
+	s.exec.SetTokenLimit(limit)
+}
+
+// SetNodeLimit caps the number of nodes of parsed documents, see executor.Executor.SetNodeLimit.
+func (s *Server) SetNodeLimit(limit int) {
+	s.exec.SetNodeLimit(limit)
+}
+
+// SetSelectionLimit rejects operations selecting more than limit fields once fragments are expanded, see
+// executor.Executor.SetSelectionLimit.
+func (s *Server) SetSelectionLimit(limit int) {
+	s.exec.SetSelectionLimit(limit)
+}
+
+// SetErrorPositions adds the line, column and token text of their locations to parse and validation errors, in a
+// "positions" extension, see executor.Executor.SetErrorPositions.
+func (s *Server) SetErrorPositions(enabled bool) {
+	s.exec.SetErrorPositions(enabled)
+}
+
+// SetReadOnly rejects mutations with a READ_ONLY error carrying message, and a 503 status over HTTP, while readOnly is
+// true. Queries are still served. It can be called while the server runs, see executor.Executor.SetReadOnly.
+func (s *Server) SetReadOnly(readOnly bool, message string) {
+	s.exec.SetReadOnly(readOnly, message)
+}
+
+// SetReadOnlySubscriptions sets whether new subscriptions are rejected as well while the server is read-only.
+func (s *Server) SetReadOnlySubscriptions(reject bool) {
+	s.exec.SetReadOnlySubscriptions(reject)
+}
+
+// AddContextCloner adds a cloner applied to the context of each subscription event and deferred fragment, to give them
+// their own copy of values like dataloaders. See graphql.ContextCloner.
+func (s *Server) AddContextCloner(f graphql.ContextCloner) {
+	s.exec.AddContextCloner(f)
+}
+
+// SetSubscriptionLimit caps the number of events each subscription delivers per second, dropping the extras or merging
+// them with the reducer of limit. See graphql.SubscriptionLimit.
+func (s *Server) SetSubscriptionLimit(limit graphql.SubscriptionLimit) {
+	s.exec.SetSubscriptionLimit(limit)
+}
+
+// SetHedging lets resolvers of queries hedge idempotent calls with graphql.Hedge, running maxInFlight hedged calls at
+// most across all operations. Zero disables hedging.
+func (s *Server) SetHedging(maxInFlight int) {
+	s.exec.SetHedging(maxInFlight)
+}
+
+// Hedging returns the scheduler of hedged calls, to export how many were started and won, nil unless SetHedging
+// enabled them.
+func (s *Server) Hedging() *graphql.HedgeScheduler {
+	return s.exec.Hedging()
+}
+
+// SetAdmissionFunc sets a function admitting operations before they are executed from the backlog of deferred
+// fragments and subscriptions of the server, see executor.AdmissionFunc.
+func (s *Server) SetAdmissionFunc(f executor.AdmissionFunc) {
+	s.exec.SetAdmissionFunc(f)
+}
+
+// Backlog returns the number of operations whose deferred fragments are still being resolved and of open
+// subscriptions, to export as metrics.
+func (s *Server) Backlog() executor.Backlog {
+	return s.exec.Backlog()
+}
+
+// SetLogger sets the logger for warnings raised while serving requests, such as recovered panics and transport
+// errors, in place of slog.Default(). Records carry the method and path of the request, and the operation name once
+// it is known. Resolvers and extensions get it with graphql.GetLogger.
+func (s *Server) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
+
 func (s *Server) Use(extension graphql.HandlerExtension) {
 	s.exec.Use(extension)
+	s.extensions = append(s.extensions, extension)
 }
 
 // AroundFields is a convenience method for creating an extension that only implements field middleware
@@ -111,15 +209,36 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
-	r = r.WithContext(graphql.StartOperationTrace(r.Context()))
+	logger := s.logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger = logger.With(slog.String("method", r.Method))
+	if r.URL != nil {
+		logger = logger.With(slog.String("path", r.URL.Path))
+	}
+	r = r.WithContext(graphql.WithLogger(graphql.StartOperationTrace(r.Context()), logger))
 
-	transport := s.getTransport(r)
-	if transport == nil {
+	if s.onWriteError != nil {
+		w = transport.WithWriteErrorFunc(r.Context(), w, s.onWriteError)
+	}
+	if s.opHeader != "" {
+		r = r.WithContext(graphql.WithOperationInfo(r.Context()))
+		w = transport.WithOperationHeader(w, s.opHeader, graphql.GetOperationInfo(r.Context()))
+	}
+
+	t := s.getTransport(r)
+	if t == nil {
 		sendErrorf(w, http.StatusBadRequest, "transport not supported")
 		return
 	}
+	if _, ok := t.(transport.ResponseEncodingTransport); ok {
+		if mediaType, enc := transport.NegotiateResponseEncoder(r.Header.Get("Accept"), s.encoders); enc != nil {
+			w = transport.WithResponseEncoder(w, mediaType, enc)
+		}
+	}
 
-	transport.Do(w, r, s.exec)
+	t.Do(w, r, s.exec)
 }
 
 func sendError(w http.ResponseWriter, code int, errors ...*gqlerror.Error) {
@@ -1,11 +1,17 @@
 package handler_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -164,6 +170,182 @@ func TestErrorServer(t *testing.T) {
 	})
 }
 
+func TestResponseEncoders(t *testing.T) {
+	srv := testserver.New()
+	srv.AddTransport(&transport.GET{})
+	srv.AddResponseEncoder("application/x-test", func(w io.Writer, response *graphql.Response) error {
+		_, err := fmt.Fprintf(w, "data=%s", response.Data)
+		return err
+	})
+
+	t.Run("uses encoder matching accept header", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r.Header.Set("Accept", "application/x-test, application/json")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/x-test", w.Header().Get("Content-Type"))
+		assert.Equal(t, `data={"name":"test"}`, w.Body.String())
+	})
+
+	t.Run("falls back to json", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r.Header.Set("Accept", "application/json, application/x-test")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Equal(t, `{"data":{"name":"test"}}`, w.Body.String())
+	})
+
+	t.Run("weighs quality values", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r.Header.Set("Accept", "application/json;q=0.5, application/x-test")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		assert.Equal(t, "application/x-test", w.Header().Get("Content-Type"))
+
+		r = httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r.Header.Set("Accept", "application/x-test;q=0.2, application/json;q=0.8")
+		w = httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		r = httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r.Header.Set("Accept", "application/x-test;q=0")
+		w = httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	})
+
+	t.Run("overrides the content type of response headers", func(t *testing.T) {
+		srv := testserver.New()
+		srv.AddTransport(transport.GET{ResponseHeaders: map[string][]string{
+			"Content-Type":  {"application/json"},
+			"Cache-Control": {"no-store"},
+		}})
+		srv.AddResponseEncoder("application/x-test", func(w io.Writer, response *graphql.Response) error {
+			_, err := fmt.Fprintf(w, "data=%s", response.Data)
+			return err
+		})
+
+		r := httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r.Header.Set("Accept", "application/x-test")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+
+		assert.Equal(t, []string{"application/x-test"}, w.Header().Values("Content-Type"))
+		assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+		assert.Equal(t, `data={"name":"test"}`, w.Body.String())
+	})
+
+	t.Run("does not panic when encoding fails", func(t *testing.T) {
+		srv := testserver.New()
+		srv.AddTransport(transport.GET{})
+		srv.AddResponseEncoder("application/x-test", func(w io.Writer, response *graphql.Response) error {
+			return errors.New("unsupported")
+		})
+
+		r := httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r.Header.Set("Accept", "application/x-test")
+		w := httptest.NewRecorder()
+		assert.NotPanics(t, func() { srv.ServeHTTP(w, r) })
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("streaming transports speak json", func(t *testing.T) {
+		srv := testserver.New()
+		srv.AddTransport(transport.SSE{})
+		srv.AddResponseEncoder("text/x-test", func(w io.Writer, response *graphql.Response) error {
+			_, err := fmt.Fprintf(w, "data=%s", response.Data)
+			return err
+		})
+
+		r := httptest.NewRequest("POST", "/foo", strings.NewReader("{"))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "text/x-test, text/event-stream")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, r)
+
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.True(t, json.Valid(w.Body.Bytes()), w.Body.String())
+	})
+}
+
+type failingWriter struct {
+	*httptest.ResponseRecorder
+	limit int
+}
+
+func (w *failingWriter) Write(b []byte) (int, error) {
+	if len(b) > w.limit {
+		n, _ := w.ResponseRecorder.Write(b[:w.limit])
+		return n, io.ErrClosedPipe
+	}
+	return w.ResponseRecorder.Write(b)
+}
+
+func TestOnWriteError(t *testing.T) {
+	type ctxKey struct{}
+	srv := testserver.New()
+	srv.AddTransport(&transport.GET{})
+	var calls []int64
+	srv.OnWriteError(func(ctx context.Context, err error, written int64) {
+		require.ErrorIs(t, err, io.ErrClosedPipe)
+		require.Equal(t, "value", ctx.Value(ctxKey{}))
+		calls = append(calls, written)
+	})
+	request := func(w http.ResponseWriter) {
+		r := httptest.NewRequest("GET", "/foo?query={name}", nil)
+		r = r.WithContext(context.WithValue(r.Context(), ctxKey{}, "value"))
+		srv.ServeHTTP(w, r)
+	}
+
+	request(httptest.NewRecorder())
+	require.Empty(t, calls)
+
+	request(&failingWriter{ResponseRecorder: httptest.NewRecorder(), limit: 5})
+	require.Equal(t, []int64{5}, calls)
+}
+
+func TestOperationInfo(t *testing.T) {
+	srv := testserver.New()
+	srv.AddTransport(&transport.GET{})
+	srv.SetOperationHeader("X-GraphQL-Operation")
+
+	t.Run("response header", func(t *testing.T) {
+		resp := get(srv, "/foo?query=query+Named{name}")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "query Named", resp.Header().Get("X-GraphQL-Operation"))
+
+		resp = get(srv, "/foo?query={name}")
+		assert.Equal(t, "query", resp.Header().Get("X-GraphQL-Operation"))
+
+		resp = get(srv, "/foo?query={")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.Empty(t, resp.Header().Get("X-GraphQL-Operation"))
+
+		resp = get(srv, "/foo?query=query+Invalid{unknown}")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+		assert.Equal(t, "query Invalid", resp.Header().Get("X-GraphQL-Operation"))
+	})
+
+	t.Run("request context", func(t *testing.T) {
+		var info *graphql.OperationInfo
+		middleware := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.WithContext(graphql.WithOperationInfo(r.Context()))
+			srv.ServeHTTP(w, r)
+			info = graphql.GetOperationInfo(r.Context())
+		})
+		get(middleware, "/foo?query=query+Named{name}")
+		assert.Equal(t, "Named", info.Name())
+		assert.Equal(t, ast.Query, info.Type())
+	})
+}
+
 type panicTransport struct{}
 
 func (t panicTransport) Supports(r *http.Request) bool {
@@ -183,6 +365,22 @@ func TestRecover(t *testing.T) {
 
 		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code, resp.Body.String())
 	})
+
+	t.Run("logs the panic with the request", func(t *testing.T) {
+		var log bytes.Buffer
+		srv.SetLogger(slog.New(slog.NewJSONHandler(&log, nil)))
+		t.Cleanup(func() { srv.SetLogger(nil) })
+
+		get(srv, "/foo?query={name}")
+
+		var record map[string]interface{}
+		require.NoError(t, json.Unmarshal(log.Bytes(), &record))
+		assert.Equal(t, "ERROR", record["level"])
+		assert.Equal(t, "recovered from panic", record["msg"])
+		assert.Equal(t, "panic in transport", record["panic"])
+		assert.Equal(t, "GET", record["method"])
+		assert.Equal(t, "/foo", record["path"])
+	})
 }
 
 func get(handler http.Handler, target string) *httptest.ResponseRecorder {
@@ -3,6 +3,7 @@ package testserver
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/vektah/gqlparser/v2"
@@ -157,7 +158,7 @@ func (s *TestServer) SendNextSubscriptionMessage() {
 	select {
 	case s.next <- struct{}{}:
 	case <-time.After(1 * time.Second):
-		fmt.Println("WARNING: no active subscription")
+		slog.Warn("no active subscription")
 	}
 }
 
@@ -165,7 +166,7 @@ func (s *TestServer) SendCompleteSubscriptionMessage() {
 	select {
 	case s.completeSubscription <- struct{}{}:
 	case <-time.After(1 * time.Second):
-		fmt.Println("WARNING: no active subscription")
+		slog.Warn("no active subscription")
 	}
 }
 
@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ResponseEncoder serializes a graphql response into a wire format other than json, eg msgpack or cbor.
+type ResponseEncoder func(w io.Writer, response *graphql.Response) error
+
+// ResponseEncodingTransport is implemented by the transports that write a single response per request (POST, GET,
+// the forms) and so can encode it with a negotiated ResponseEncoder. The server only negotiates an encoder for them,
+// streaming and callback transports always speak json.
+type ResponseEncodingTransport interface {
+	graphql.Transport
+	EncodesResponses()
+}
+
+// encodingResponseWriter carries the encoder negotiated for a request down into the transports. Transports that write
+// a single response per request (POST, GET, forms) use it in place of json when it is present.
+type encodingResponseWriter struct {
+	http.ResponseWriter
+	contentType string
+	encode      ResponseEncoder
+}
+
+// WithResponseEncoder wraps w so that transports writing single responses advertise contentType and encode with enc
+// instead of json. Streaming transports (websocket, sse, multipart mixed) are unaffected.
+func WithResponseEncoder(w http.ResponseWriter, contentType string, enc ResponseEncoder) http.ResponseWriter {
+	return &encodingResponseWriter{ResponseWriter: w, contentType: contentType, encode: enc}
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *encodingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *encodingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *encodingResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T does not implement http.Hijacker", w.ResponseWriter)
+	}
+	return h.Hijack()
+}
+
+// NegotiateResponseEncoder picks the encoder matching the media type of the Accept header with the highest quality
+// value, the first one listed wins a tie and types with a quality of 0 are not acceptable. It returns a nil encoder
+// when nothing matches or json is preferred, in which case the transports fall back to json.
+func NegotiateResponseEncoder(accept string, encoders map[string]ResponseEncoder) (string, ResponseEncoder) {
+	if accept == "" || len(encoders) == 0 {
+		return "", nil
+	}
+
+	type acceptable struct {
+		mediaType string
+		q         float64
+	}
+	var types []acceptable
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			q, err = strconv.ParseFloat(v, 64)
+			if err != nil || q < 0 || q > 1 {
+				continue
+			}
+		}
+		if q == 0 {
+			continue
+		}
+		types = append(types, acceptable{mediaType: mediaType, q: q})
+	}
+	sort.SliceStable(types, func(i, j int) bool {
+		return types[i].q > types[j].q
+	})
+
+	for _, t := range types {
+		mediaType := t.mediaType
+		if enc, ok := encoders[mediaType]; ok {
+			return mediaType, enc
+		}
+		if mediaType == "application/json" || mediaType == "application/graphql-response+json" {
+			return "", nil
+		}
+	}
+
+	return "", nil
+}
+
+func responseEncoderFor(w io.Writer) *encodingResponseWriter {
+	ew, _ := w.(*encodingResponseWriter)
+	return ew
+}
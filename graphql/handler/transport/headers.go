@@ -2,6 +2,8 @@ package transport
 
 import "net/http"
 
+// writeHeaders adds headers to the response, or a json Content-Type when there are none. The content type of a
+// negotiated ResponseEncoder replaces the one of headers, the body is written in its format.
 func writeHeaders(w http.ResponseWriter, headers map[string][]string) {
 	if len(headers) == 0 {
 		headers = map[string][]string{
@@ -9,9 +11,16 @@ func writeHeaders(w http.ResponseWriter, headers map[string][]string) {
 		}
 	}
 
+	ew := responseEncoderFor(w)
 	for key, values := range headers {
+		if ew != nil && http.CanonicalHeaderKey(key) == "Content-Type" {
+			continue
+		}
 		for _, value := range values {
 			w.Header().Add(key, value)
 		}
 	}
+	if ew != nil {
+		w.Header().Set("Content-Type", ew.contentType)
+	}
 }
@@ -0,0 +1,271 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Callback implements the HTTP callback protocol the Apollo router uses to run subscriptions against federated
+// subgraphs, see https://www.apollographql.com/docs/router/executing-operations/subscription-callback-protocol.
+//
+// The router sends the subscription as a regular POST with a callback url in its extensions. The subgraph checks
+// that it can reach the url, answers the request straight away and then delivers every event, a heartbeat and the
+// completion of the subscription to the router over separate requests, so no connection is held open between them.
+//
+// Add it before the POST transport, it only handles requests that ask for the callback protocol. The callback url
+// comes from the client, so it must be allowed by AllowedHosts or CheckCallbackURL, subscriptions with any other url
+// are rejected before a message is sent.
+type Callback struct {
+	// Client sends the messages to the router. When nil, a client giving up on messages after
+	// DefaultCallbackTimeout is used.
+	Client *http.Client
+	// AllowedHosts are the hosts of the routers the messages can be sent to. An entry with a port, like router:4000,
+	// only allows that port, one without allows the host on any port.
+	AllowedHosts []string
+	// CheckCallbackURL reports whether the messages can be sent to u, it is used instead of AllowedHosts when set.
+	CheckCallbackURL func(u *url.URL) bool
+	// Map of all headers that are added to the response to the subscription request. If not
+	// set, only one header: Content-Type: application/json will be set.
+	ResponseHeaders map[string][]string
+}
+
+var _ graphql.Transport = Callback{}
+
+// DefaultCallbackTimeout bounds each message sent to the router when Callback has no Client.
+const DefaultCallbackTimeout = 10 * time.Second
+
+var defaultCallbackClient = &http.Client{Timeout: DefaultCallbackTimeout}
+
+const (
+	callbackSpec     = "callbackSpec=1.0"
+	callbackProtocol = "callback/1.0"
+)
+
+func (h Callback) Supports(r *http.Request) bool {
+	if !strings.Contains(r.Header.Get("Accept"), callbackSpec) {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+	return r.Method == http.MethodPost && mediaType == "application/json"
+}
+
+// callbackExtension is the subscription extension the router sends along with the operation.
+type callbackExtension struct {
+	CallbackURL         string `json:"callbackUrl"`
+	SubscriptionID      string `json:"subscriptionId"`
+	Verifier            string `json:"verifier"`
+	HeartbeatIntervalMs int    `json:"heartbeatIntervalMs"`
+}
+
+type callbackMessage struct {
+	Kind     string            `json:"kind"`
+	Action   string            `json:"action"`
+	ID       string            `json:"id"`
+	IDs      []string          `json:"ids,omitempty"`
+	Verifier string            `json:"verifier"`
+	Payload  *graphql.Response `json:"payload,omitempty"`
+}
+
+func (h Callback) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	ctx := r.Context()
+	writeHeaders(w, h.ResponseHeaders)
+	params := &graphql.RawParams{}
+	start := graphql.Now()
+	params.Headers = r.Header
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
+	}
+
+	bodyString, err := getRequestBody(r)
+	if err != nil {
+		gqlErr := gqlerror.Errorf("could not get json request body: %+v", err)
+		writeResponse(ctx, w, exec.DispatchError(ctx, gqlerror.List{gqlErr}))
+		return
+	}
+
+	if err = jsonDecode(strings.NewReader(bodyString), &params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		gqlErr := gqlerror.Errorf("json request body could not be decoded: %+v body:%s", err, bodyString)
+		writeResponse(ctx, w, exec.DispatchError(ctx, gqlerror.List{gqlErr}))
+		return
+	}
+
+	rc, opErr := exec.CreateOperationContext(ctx, params)
+	if opErr != nil {
+		w.WriteHeader(statusFor(opErr))
+		writeResponse(ctx, w, exec.DispatchError(graphql.WithOperationContext(ctx, rc), opErr))
+		return
+	}
+
+	if rc.Operation.Operation != ast.Subscription {
+		var responses graphql.ResponseHandler
+		responses, ctx = exec.DispatchOperation(ctx, rc)
+		writeResponse(ctx, w, responses(ctx))
+		return
+	}
+
+	sub, err := h.newSubscription(params.Extensions)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		writeResponse(ctx, w, exec.DispatchError(graphql.WithOperationContext(ctx, rc), gqlerror.List{gqlerror.Errorf("%s", err)}))
+		return
+	}
+
+	// the router expects a check before the subscription is acknowledged, it proves the callback url is reachable
+	if status, err := sub.send(ctx, callbackMessage{Action: "check"}); err != nil || status != http.StatusNoContent {
+		if err == nil {
+			err = fmt.Errorf("router responded with %d", status)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		gqlErr := gqlerror.Errorf("subscription callback check failed: %s", err)
+		writeResponse(ctx, w, exec.DispatchError(graphql.WithOperationContext(ctx, rc), gqlerror.List{gqlErr}))
+		return
+	}
+
+	// the subscription outlives the request that started it, it runs until the stream ends or the router drops it
+	subCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	responses, subCtx := exec.DispatchOperation(subCtx, rc)
+	go sub.run(subCtx, cancel, responses)
+
+	w.Header().Set("Subscription-Protocol", callbackProtocol)
+	writeResponse(ctx, w, &graphql.Response{Data: []byte(`null`)})
+}
+
+func (h Callback) newSubscription(extensions map[string]interface{}) (*callbackSubscription, error) {
+	raw, ok := extensions["subscription"]
+	if !ok {
+		return nil, fmt.Errorf("subscription extension is required by the callback protocol")
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var ext callbackExtension
+	if err := json.Unmarshal(b, &ext); err != nil {
+		return nil, fmt.Errorf("invalid subscription extension: %w", err)
+	}
+	if ext.CallbackURL == "" || ext.SubscriptionID == "" {
+		return nil, fmt.Errorf("subscription extension must have a callbackUrl and subscriptionId")
+	}
+	u, err := url.Parse(ext.CallbackURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid callbackUrl")
+	}
+	if !h.allowed(u) {
+		return nil, fmt.Errorf("callbackUrl host %s is not allowed", u.Host)
+	}
+
+	client := h.Client
+	if client == nil {
+		client = defaultCallbackClient
+	}
+	return &callbackSubscription{client: client, ext: ext}, nil
+}
+
+func (h Callback) allowed(u *url.URL) bool {
+	if h.CheckCallbackURL != nil {
+		return h.CheckCallbackURL(u)
+	}
+	for _, host := range h.AllowedHosts {
+		if strings.EqualFold(host, u.Host) || strings.EqualFold(host, u.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+type callbackSubscription struct {
+	client *http.Client
+	ext    callbackExtension
+	// gone is set once the router responds with 404, the subscription must stop without a complete message
+	gone atomic.Bool
+}
+
+func (s *callbackSubscription) run(ctx context.Context, cancel context.CancelFunc, responses graphql.ResponseHandler) {
+	defer cancel()
+
+	if s.ext.HeartbeatIntervalMs > 0 {
+		go s.heartbeat(ctx, cancel, time.Duration(s.ext.HeartbeatIntervalMs)*time.Millisecond)
+	}
+
+	for {
+		response := responses(ctx)
+		if response == nil || s.gone.Load() {
+			break
+		}
+		_, _ = s.send(ctx, callbackMessage{Action: "next", Payload: response})
+		if s.gone.Load() {
+			return
+		}
+	}
+
+	if !s.gone.Load() {
+		_, _ = s.send(ctx, callbackMessage{Action: "complete"})
+	}
+}
+
+func (s *callbackSubscription) heartbeat(ctx context.Context, cancel context.CancelFunc, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = s.send(ctx, callbackMessage{Action: "heartbeat", IDs: []string{s.ext.SubscriptionID}})
+			if s.gone.Load() {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// send posts msg to the router and returns its status, giving up once ctx is done. Network errors are returned as is,
+// there is no retry, the router ends subscriptions that miss their heartbeats.
+func (s *callbackSubscription) send(ctx context.Context, msg callbackMessage) (int, error) {
+	msg.Kind = "subscription"
+	msg.ID = s.ext.SubscriptionID
+	msg.Verifier = s.ext.Verifier
+
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.ext.CallbackURL, bytes.NewReader(b))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Subscription-Protocol", callbackProtocol)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode == http.StatusNotFound {
+		s.gone.Store(true)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,202 @@
+package transport_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+type callbackMessage struct {
+	Kind     string          `json:"kind"`
+	Action   string          `json:"action"`
+	ID       string          `json:"id"`
+	IDs      []string        `json:"ids"`
+	Verifier string          `json:"verifier"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+func TestCallback(t *testing.T) {
+	// router plays the part of the Apollo router, recording every callback and answering with status
+	router := func(status func(msg callbackMessage) int) (*httptest.Server, chan callbackMessage) {
+		messages := make(chan callbackMessage, 10)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var msg callbackMessage
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&msg))
+			assert.Equal(t, "callback/1.0", r.Header.Get("Subscription-Protocol"))
+			messages <- msg
+			w.WriteHeader(status(msg))
+		}))
+		t.Cleanup(srv.Close)
+		return srv, messages
+	}
+
+	subscribe := func(h http.Handler, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		r.Header.Set("Accept", "application/json;callbackSpec=1.0")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	body := func(url string, heartbeat int) string {
+		b, _ := json.Marshal(map[string]interface{}{
+			"query": "subscription { name }",
+			"extensions": map[string]interface{}{
+				"subscription": map[string]interface{}{
+					"callbackUrl":         url,
+					"subscriptionId":      "sub-1",
+					"verifier":            "secret",
+					"heartbeatIntervalMs": heartbeat,
+				},
+			},
+		})
+		return string(b)
+	}
+
+	next := func(t *testing.T, messages chan callbackMessage) callbackMessage {
+		t.Helper()
+		select {
+		case msg := <-messages:
+			return msg
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for callback")
+			return callbackMessage{}
+		}
+	}
+
+	// allow lets the transport send messages to the router srv
+	allow := func(srv *httptest.Server) transport.Callback {
+		u, _ := url.Parse(srv.URL)
+		return transport.Callback{AllowedHosts: []string{u.Host}}
+	}
+
+	t.Run("supports", func(t *testing.T) {
+		r := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+		r.Header.Set("Content-Type", "application/json")
+		assert.False(t, transport.Callback{}.Supports(r))
+		r.Header.Set("Accept", "application/json;callbackSpec=1.0")
+		assert.True(t, transport.Callback{}.Supports(r))
+	})
+
+	t.Run("delivers events over the callback", func(t *testing.T) {
+		srv, messages := router(func(msg callbackMessage) int { return http.StatusNoContent })
+		h := testserver.New()
+		h.AddTransport(allow(srv))
+
+		w := subscribe(h, body(srv.URL, 0))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "callback/1.0", w.Header().Get("Subscription-Protocol"))
+		assert.Equal(t, `{"data":null}`, w.Body.String())
+
+		check := next(t, messages)
+		assert.Equal(t, callbackMessage{Kind: "subscription", Action: "check", ID: "sub-1", Verifier: "secret"}, check)
+
+		h.SendNextSubscriptionMessage()
+		msg := next(t, messages)
+		assert.Equal(t, "next", msg.Action)
+		assert.JSONEq(t, `{"data":{"name":"test"}}`, string(msg.Payload))
+
+		h.SendCompleteSubscriptionMessage()
+		assert.Equal(t, "complete", next(t, messages).Action)
+	})
+
+	t.Run("failed check", func(t *testing.T) {
+		srv, _ := router(func(msg callbackMessage) int { return http.StatusNotFound })
+		h := testserver.New()
+		h.AddTransport(allow(srv))
+
+		w := subscribe(h, body(srv.URL, 0))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "subscription callback check failed: router responded with 404")
+	})
+
+	t.Run("missing extension", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Callback{})
+
+		w := subscribe(h, `{"query":"subscription { name }"}`)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "subscription extension is required by the callback protocol")
+	})
+
+	t.Run("router drops subscription on heartbeat", func(t *testing.T) {
+		srv, messages := router(func(msg callbackMessage) int {
+			if msg.Action == "heartbeat" {
+				return http.StatusNotFound
+			}
+			return http.StatusNoContent
+		})
+		h := testserver.New()
+		h.AddTransport(allow(srv))
+
+		w := subscribe(h, body(srv.URL, 10))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		assert.Equal(t, "check", next(t, messages).Action)
+		heartbeat := next(t, messages)
+		assert.Equal(t, "heartbeat", heartbeat.Action)
+		assert.Equal(t, []string{"sub-1"}, heartbeat.IDs)
+
+		// the subscription is gone, nothing else is sent, not even complete
+		select {
+		case msg := <-messages:
+			t.Fatalf("unexpected %s after the router dropped the subscription", msg.Action)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("rejects callback urls that are not allowed", func(t *testing.T) {
+		srv, messages := router(func(msg callbackMessage) int { return http.StatusNoContent })
+		u, _ := url.Parse(srv.URL)
+
+		for name, tc := range map[string]struct {
+			callback transport.Callback
+			url      string
+		}{
+			"no allowed hosts": {transport.Callback{}, srv.URL},
+			"other host":       {transport.Callback{AllowedHosts: []string{"router:4000"}}, srv.URL},
+			"other port":       {transport.Callback{AllowedHosts: []string{u.Hostname() + ":1"}}, srv.URL},
+			"other scheme":     {transport.Callback{AllowedHosts: []string{u.Hostname()}}, "file:///etc/passwd"},
+			"check": {transport.Callback{
+				AllowedHosts:     []string{u.Host},
+				CheckCallbackURL: func(u *url.URL) bool { return u.Path == "/callback" },
+			}, srv.URL + "/other"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				h := testserver.New()
+				h.AddTransport(tc.callback)
+
+				w := subscribe(h, body(tc.url, 0))
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+				assert.Regexp(t, "invalid callbackUrl|callbackUrl host .* is not allowed", w.Body.String())
+			})
+		}
+		assert.Empty(t, messages)
+
+		h := testserver.New()
+		h.AddTransport(transport.Callback{AllowedHosts: []string{u.Hostname()}})
+		w := subscribe(h, body(srv.URL, 0))
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "check", next(t, messages).Action)
+	})
+
+	t.Run("queries", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.Callback{})
+
+		w := subscribe(h, `{"query":"{ name }"}`)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"data":{"name":"test"}}`, w.Body.String())
+	})
+}
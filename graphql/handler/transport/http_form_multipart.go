@@ -18,7 +18,8 @@ type MultipartForm struct {
 
 	// MaxMemory defines the maximum number of bytes used to parse a request body
 	// as multipart/form-data in memory, with the remainder stored on disk in
-	// temporary files.
+	// temporary files. Under GOOS=wasip1 and GOOS=js uploads always stay in
+	// memory.
 	MaxMemory int64
 
 	// Map of all headers that are added to graphql response. If not
@@ -26,7 +27,9 @@ type MultipartForm struct {
 	ResponseHeaders map[string][]string
 }
 
-var _ graphql.Transport = MultipartForm{}
+var _ ResponseEncodingTransport = MultipartForm{}
+
+func (f MultipartForm) EncodesResponses() {}
 
 func (f MultipartForm) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
@@ -62,7 +65,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 
 	var err error
 	if r.ContentLength > f.maxUploadSize() {
-		writeJsonError(w, "failed to parse multipart form, request body too large")
+		writeJsonError(r.Context(), w, "failed to parse multipart form, request body too large")
 		return
 	}
 	r.Body = http.MaxBytesReader(w, r.Body, f.maxUploadSize())
@@ -71,35 +74,35 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 	mr, err := r.MultipartReader()
 	if err != nil {
 		w.WriteHeader(http.StatusUnprocessableEntity)
-		writeJsonError(w, "failed to parse multipart form")
+		writeJsonError(r.Context(), w, "failed to parse multipart form")
 		return
 	}
 
 	part, err := mr.NextPart()
 	if err != nil || part.FormName() != "operations" {
 		w.WriteHeader(http.StatusUnprocessableEntity)
-		writeJsonError(w, "first part must be operations")
+		writeJsonError(r.Context(), w, "first part must be operations")
 		return
 	}
 
 	var params graphql.RawParams
 	if err = jsonDecode(part, &params); err != nil {
 		w.WriteHeader(http.StatusUnprocessableEntity)
-		writeJsonError(w, "operations form field could not be decoded")
+		writeJsonError(r.Context(), w, "operations form field could not be decoded")
 		return
 	}
 
 	part, err = mr.NextPart()
 	if err != nil || part.FormName() != "map" {
 		w.WriteHeader(http.StatusUnprocessableEntity)
-		writeJsonError(w, "second part must be map")
+		writeJsonError(r.Context(), w, "second part must be map")
 		return
 	}
 
 	uploadsMap := map[string][]string{}
 	if err = json.NewDecoder(part).Decode(&uploadsMap); err != nil {
 		w.WriteHeader(http.StatusUnprocessableEntity)
-		writeJsonError(w, "map form field could not be decoded")
+		writeJsonError(r.Context(), w, "map form field could not be decoded")
 		return
 	}
 
@@ -109,7 +112,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 			break
 		} else if err != nil {
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			writeJsonErrorf(w, "failed to parse part")
+			writeJsonErrorf(r.Context(), w, "failed to parse part")
 			return
 		}
 
@@ -120,17 +123,17 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 		paths := uploadsMap[key]
 		if len(paths) == 0 {
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			writeJsonErrorf(w, "invalid empty operations paths list for key %s", key)
+			writeJsonErrorf(r.Context(), w, "invalid empty operations paths list for key %s", key)
 			return
 		}
 		delete(uploadsMap, key)
 
 		var upload graphql.Upload
-		if r.ContentLength < f.maxMemory() {
+		if !tempFiles || r.ContentLength < f.maxMemory() {
 			fileBytes, err := io.ReadAll(part)
 			if err != nil {
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				writeJsonErrorf(w, "failed to read file for key %s", key)
+				writeJsonErrorf(r.Context(), w, "failed to read file for key %s", key)
 				return
 			}
 			for _, path := range paths {
@@ -143,7 +146,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 
 				if err := params.AddUpload(upload, key, path); err != nil {
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					writeJsonGraphqlError(w, err)
+					writeJsonGraphqlError(r.Context(), w, err)
 					return
 				}
 			}
@@ -151,7 +154,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 			tmpFile, err := os.CreateTemp(os.TempDir(), "gqlgen-")
 			if err != nil {
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				writeJsonErrorf(w, "failed to create temp file for key %s", key)
+				writeJsonErrorf(r.Context(), w, "failed to create temp file for key %s", key)
 				return
 			}
 			tmpName := tmpFile.Name()
@@ -162,22 +165,22 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 			if err != nil {
 				w.WriteHeader(http.StatusUnprocessableEntity)
 				if err := tmpFile.Close(); err != nil {
-					writeJsonErrorf(w, "failed to copy to temp file and close temp file for key %s", key)
+					writeJsonErrorf(r.Context(), w, "failed to copy to temp file and close temp file for key %s", key)
 					return
 				}
-				writeJsonErrorf(w, "failed to copy to temp file for key %s", key)
+				writeJsonErrorf(r.Context(), w, "failed to copy to temp file for key %s", key)
 				return
 			}
 			if err := tmpFile.Close(); err != nil {
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				writeJsonErrorf(w, "failed to close temp file for key %s", key)
+				writeJsonErrorf(r.Context(), w, "failed to close temp file for key %s", key)
 				return
 			}
 			for _, path := range paths {
 				pathTmpFile, err := os.Open(tmpName)
 				if err != nil {
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					writeJsonErrorf(w, "failed to open temp file for key %s", key)
+					writeJsonErrorf(r.Context(), w, "failed to open temp file for key %s", key)
 					return
 				}
 				defer pathTmpFile.Close()
@@ -190,7 +193,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 
 				if err := params.AddUpload(upload, key, path); err != nil {
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					writeJsonGraphqlError(w, err)
+					writeJsonGraphqlError(r.Context(), w, err)
 					return
 				}
 			}
@@ -199,7 +202,7 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 
 	for key := range uploadsMap {
 		w.WriteHeader(http.StatusUnprocessableEntity)
-		writeJsonErrorf(w, "failed to get key %s from form", key)
+		writeJsonErrorf(r.Context(), w, "failed to get key %s from form", key)
 		return
 	}
 
@@ -214,9 +217,9 @@ func (f MultipartForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.G
 	if gerr != nil {
 		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), rc), gerr)
 		w.WriteHeader(statusFor(gerr))
-		writeJson(w, resp)
+		writeResponse(r.Context(), w, resp)
 		return
 	}
 	responses, ctx := exec.DispatchOperation(r.Context(), rc)
-	writeJson(w, responses(ctx))
+	writeResponse(ctx, w, responses(ctx))
 }
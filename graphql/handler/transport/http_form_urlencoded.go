@@ -19,7 +19,9 @@ type UrlEncodedForm struct {
 	ResponseHeaders map[string][]string
 }
 
-var _ graphql.Transport = UrlEncodedForm{}
+var _ ResponseEncodingTransport = UrlEncodedForm{}
+
+func (h UrlEncodedForm) EncodesResponses() {}
 
 func (h UrlEncodedForm) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
@@ -50,7 +52,7 @@ func (h UrlEncodedForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 		w.WriteHeader(http.StatusBadRequest)
 		gqlErr := gqlerror.Errorf("could not get form body: %+v", err)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
@@ -59,7 +61,7 @@ func (h UrlEncodedForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 		w.WriteHeader(http.StatusUnprocessableEntity)
 		gqlErr := gqlerror.Errorf("could not cleanup body: %+v", err)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
@@ -67,13 +69,13 @@ func (h UrlEncodedForm) Do(w http.ResponseWriter, r *http.Request, exec graphql.
 	if OpErr != nil {
 		w.WriteHeader(statusFor(OpErr))
 		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), OpErr)
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
-	writeJson(w, responses(ctx))
+	writeResponse(ctx, w, responses(ctx))
 }
 
 func (h UrlEncodedForm) parseBody(bodyString string) (*graphql.RawParams, error) {
@@ -20,9 +20,15 @@ type GET struct {
 	// Map of all headers that are added to graphql response. If not
 	// set, only one header: Content-Type: application/json will be set.
 	ResponseHeaders map[string][]string
+
+	// DocumentIDParams lists the query params read as the id of a persisted document, in order, when the request
+	// has no documentId param.
+	DocumentIDParams []string
 }
 
-var _ graphql.Transport = GET{}
+var _ ResponseEncodingTransport = GET{}
+
+func (h GET) EncodesResponses() {}
 
 func (h GET) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
@@ -36,7 +42,7 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	query, err := url.ParseQuery(r.URL.RawQuery)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
-		writeJsonError(w, err.Error())
+		writeJsonError(r.Context(), w, err.Error())
 		return
 	}
 	writeHeaders(w, h.ResponseHeaders)
@@ -45,13 +51,20 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 		Query:         query.Get("query"),
 		OperationName: query.Get("operationName"),
 		Headers:       r.Header,
+		DocumentID:    query.Get("documentId"),
 	}
 	raw.ReadTime.Start = graphql.Now()
 
+	for _, param := range h.DocumentIDParams {
+		if raw.DocumentID == "" {
+			raw.DocumentID = query.Get(param)
+		}
+	}
+
 	if variables := query.Get("variables"); variables != "" {
 		if err := jsonDecode(strings.NewReader(variables), &raw.Variables); err != nil {
 			w.WriteHeader(http.StatusBadRequest)
-			writeJsonError(w, "variables could not be decoded")
+			writeJsonError(r.Context(), w, "variables could not be decoded")
 			return
 		}
 	}
@@ -59,7 +72,7 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	if extensions := query.Get("extensions"); extensions != "" {
 		if err := jsonDecode(strings.NewReader(extensions), &raw.Extensions); err != nil {
 			w.WriteHeader(http.StatusBadRequest)
-			writeJsonError(w, "extensions could not be decoded")
+			writeJsonError(r.Context(), w, "extensions could not be decoded")
 			return
 		}
 	}
@@ -70,18 +83,18 @@ func (h GET) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	if gqlError != nil {
 		w.WriteHeader(statusFor(gqlError))
 		resp := exec.DispatchError(graphql.WithOperationContext(r.Context(), rc), gqlError)
-		writeJson(w, resp)
+		writeResponse(r.Context(), w, resp)
 		return
 	}
 	op := rc.Doc.Operations.ForName(rc.OperationName)
 	if op.Operation != ast.Query {
 		w.WriteHeader(http.StatusNotAcceptable)
-		writeJsonError(w, "GET requests only allow query operations")
+		writeJsonError(r.Context(), w, "GET requests only allow query operations")
 		return
 	}
 
 	responses, ctx := exec.DispatchOperation(r.Context(), rc)
-	writeJson(w, responses(ctx))
+	writeResponse(ctx, w, responses(ctx))
 }
 
 func jsonDecode(r io.Reader, val interface{}) error {
@@ -91,10 +104,5 @@ func jsonDecode(r io.Reader, val interface{}) error {
 }
 
 func statusFor(errs gqlerror.List) int {
-	switch errcode.GetErrorKind(errs) {
-	case errcode.KindProtocol:
-		return http.StatusUnprocessableEntity
-	default:
-		return http.StatusOK
-	}
+	return errcode.HTTPStatus(errs)
 }
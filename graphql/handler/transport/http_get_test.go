@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 )
@@ -49,3 +51,21 @@ func TestGET(t *testing.T) {
 		assert.Equal(t, `{"errors":[{"message":"GET requests only allow query operations"}],"data":null}`, resp.Body.String())
 	})
 }
+
+func TestGETDocumentID(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.GET{DocumentIDParams: []string{"doc_id"}})
+	h.Use(extension.PersistedDocuments{Store: graphql.MapCache{"abc": "{name}"}})
+
+	t.Run("documentId", func(t *testing.T) {
+		resp := doRequest(h, "GET", "/graphql?documentId=abc", "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("configured param", func(t *testing.T) {
+		resp := doRequest(h, "GET", "/graphql?doc_id=abc", "", "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+}
@@ -21,7 +21,9 @@ type GRAPHQL struct {
 	ResponseHeaders map[string][]string
 }
 
-var _ graphql.Transport = GRAPHQL{}
+var _ ResponseEncodingTransport = GRAPHQL{}
+
+func (h GRAPHQL) EncodesResponses() {}
 
 func (h GRAPHQL) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
@@ -51,7 +53,7 @@ func (h GRAPHQL) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphEx
 	if err != nil {
 		gqlErr := gqlerror.Errorf("could not get request body: %+v", err)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
@@ -60,7 +62,7 @@ func (h GRAPHQL) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphEx
 		w.WriteHeader(http.StatusUnprocessableEntity)
 		gqlErr := gqlerror.Errorf("could not cleanup body: %+v", err)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
@@ -68,13 +70,13 @@ func (h GRAPHQL) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphEx
 	if OpErr != nil {
 		w.WriteHeader(statusFor(OpErr))
 		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), OpErr)
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
-	writeJson(w, responses(ctx))
+	writeResponse(ctx, w, responses(ctx))
 }
 
 // Makes sure we strip "query=" keyword from body and
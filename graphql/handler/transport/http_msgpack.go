@@ -0,0 +1,135 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// MsgPack implements a POST transport where both the request and the response bodies are MessagePack encoded.
+// It mirrors the POST transport and is intended for service to service calls where json serialization cost matters.
+type MsgPack struct {
+	// Map of all headers that are added to graphql response. If not
+	// set, only one header: Content-Type: application/msgpack will be set.
+	ResponseHeaders map[string][]string
+}
+
+var _ graphql.Transport = MsgPack{}
+
+var msgPackMediaTypes = map[string]bool{
+	"application/msgpack":     true,
+	"application/x-msgpack":   true,
+	"application/vnd.msgpack": true,
+}
+
+func (h MsgPack) Supports(r *http.Request) bool {
+	if r.Header.Get("Upgrade") != "" {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return false
+	}
+
+	return r.Method == "POST" && msgPackMediaTypes[mediaType]
+}
+
+func (h MsgPack) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
+	ctx := r.Context()
+	headers := h.ResponseHeaders
+	if len(headers) == 0 {
+		headers = map[string][]string{
+			"Content-Type": {"application/msgpack"},
+		}
+	}
+	writeHeaders(w, headers)
+
+	params := &graphql.RawParams{}
+	start := graphql.Now()
+	params.Headers = r.Header
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		gqlErr := gqlerror.Errorf("could not get msgpack request body: %+v", err)
+		writeMsgPack(w, exec.DispatchError(ctx, gqlerror.List{gqlErr}))
+		return
+	}
+
+	if err = decodeMsgPackParams(body, params); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		gqlErr := gqlerror.Errorf("msgpack request body could not be decoded: %+v", err)
+		writeMsgPack(w, exec.DispatchError(ctx, gqlerror.List{gqlErr}))
+		return
+	}
+	params.ReadTime = graphql.TraceTiming{
+		Start: start,
+		End:   graphql.Now(),
+	}
+
+	rc, opErr := exec.CreateOperationContext(ctx, params)
+	if opErr != nil {
+		w.WriteHeader(statusFor(opErr))
+		writeMsgPack(w, exec.DispatchError(graphql.WithOperationContext(ctx, rc), opErr))
+		return
+	}
+
+	var responses graphql.ResponseHandler
+	responses, ctx = exec.DispatchOperation(ctx, rc)
+	writeMsgPack(w, responses(ctx))
+}
+
+func decodeMsgPackParams(body []byte, params *graphql.RawParams) error {
+	v, rest, err := decodeMsgPack(body)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("unexpected trailing data")
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("expected a map, got %T", v)
+	}
+
+	if params.Query, ok = stringParam(m, "query"); !ok {
+		return fmt.Errorf("query must be a string")
+	}
+	if params.OperationName, ok = stringParam(m, "operationName"); !ok {
+		return fmt.Errorf("operationName must be a string")
+	}
+	if params.Variables, ok = mapParam(m, "variables"); !ok {
+		return fmt.Errorf("variables must be a map")
+	}
+	if params.Extensions, ok = mapParam(m, "extensions"); !ok {
+		return fmt.Errorf("extensions must be a map")
+	}
+	return nil
+}
+
+func stringParam(m map[string]interface{}, key string) (string, bool) {
+	if m[key] == nil {
+		return "", true
+	}
+	s, ok := m[key].(string)
+	return s, ok
+}
+
+func mapParam(m map[string]interface{}, key string) (map[string]interface{}, bool) {
+	if m[key] == nil {
+		return nil, true
+	}
+	v, ok := m[key].(map[string]interface{})
+	return v, ok
+}
+
+func writeMsgPack(w io.Writer, response *graphql.Response) {
+	if err := EncodeMsgPack(w, response); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,94 @@
+package transport_test
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/testserver"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// msgpack encodes {"query": query} for queries shorter than 32 bytes
+func msgpackQuery(query string) string {
+	return "\x81\xa5query" + string([]byte{0xa0 | byte(len(query))}) + query
+}
+
+func TestMsgPack(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.MsgPack{})
+
+	t.Run("success", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", msgpackQuery("{ name }"), "application/msgpack")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/msgpack", resp.Header().Get("Content-Type"))
+		assert.Equal(t, "\x81\xa4data\x81\xa4name\xa4test", resp.Body.String())
+	})
+
+	t.Run("decode failure", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", "\x81\xa5query", "application/msgpack")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, "\x82\xa6errors\x91\x81\xa7message\xd9\x4bmsgpack request body could not be decoded: msgpack: unexpected end of input\xa4data\xc0", resp.Body.String())
+	})
+
+	t.Run("too deep", func(t *testing.T) {
+		body := "\x81\xa9variables\x81\xa1v" + strings.Repeat("\x91", 20000) + "\xc0"
+		resp := doRequest(h, "POST", "/graphql", body, "application/msgpack")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, resp.Body.String(), "msgpack: exceeded max depth of 10000")
+	})
+
+	t.Run("validation failure", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", msgpackQuery("{ title }"), "application/x-msgpack")
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.Code)
+	})
+
+	t.Run("json is not supported", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`, "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
+func TestEncodeMsgPack(t *testing.T) {
+	hasNext := true
+	var buf bytes.Buffer
+	err := transport.EncodeMsgPack(&buf, &graphql.Response{
+		Data:    []byte(`{"a":[1,-1,1.5,null,true,"x"]}`),
+		HasNext: &hasNext,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "\x82\xa4data\x81\xa1a\x96\x01\xff\xcb\x3f\xf8\x00\x00\x00\x00\x00\x00\xc0\xc3\xa1x\xa7hasNext\xc3", buf.String())
+}
+
+func TestEncodeMsgPackErrors(t *testing.T) {
+	var buf bytes.Buffer
+	err := transport.EncodeMsgPack(&buf, &graphql.Response{
+		Errors: gqlerror.List{{
+			Message:    "x",
+			Path:       ast.Path{ast.PathName("a"), ast.PathIndex(1)},
+			Locations:  []gqlerror.Location{{Line: 1, Column: 2}},
+			Extensions: map[string]interface{}{"code": "C"},
+		}},
+		Path: ast.Path{ast.PathName("a")},
+		Extensions: map[string]interface{}{
+			"n": 1,
+			"b": true,
+			"s": struct {
+				X int `json:"x"`
+			}{X: 1},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "\x84"+
+		"\xa6errors\x91\x84\xa7message\xa1x\xa4path\x92\xa1a\x01"+
+		"\xa9locations\x91\x82\xa4line\x01\xa6column\x02\xaaextensions\x81\xa4code\xa1C"+
+		"\xa4data\xc0"+
+		"\xa4path\x91\xa1a"+
+		"\xaaextensions\x83\xa1b\xc3\xa1n\x01\xa1s\x81\xa1x\x01", buf.String())
+}
@@ -1,11 +1,15 @@
 package transport
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime"
 	"net/http"
 	"strings"
+	"unicode/utf8"
+	"unsafe"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
@@ -18,9 +22,20 @@ type POST struct {
 	// Map of all headers that are added to graphql response. If not
 	// set, only one header: Content-Type: application/json will be set.
 	ResponseHeaders map[string][]string
+
+	// ZeroCopy avoids copying the request body into a string before decoding it and the query out of the body, which
+	// is aliased when it has no escape sequences. The response data is validated and written straight to the client
+	// instead of being compacted and html escaped into a new buffer by encoding/json.
+	ZeroCopy bool
+
+	// DocumentIDParams lists the members of the request body read as the id of a persisted document, in order, when
+	// the request has no documentId member.
+	DocumentIDParams []string
 }
 
-var _ graphql.Transport = POST{}
+var _ ResponseEncodingTransport = POST{}
+
+func (h POST) EncodesResponses() {}
 
 func (h POST) Supports(r *http.Request) bool {
 	if r.Header.Get("Upgrade") != "" {
@@ -46,6 +61,76 @@ func getRequestBody(r *http.Request) (string, error) {
 	return string(body), nil
 }
 
+// getRequestBodyNoCopy reads the body into a buffer owned by this request and never written to again, which is what
+// makes aliasing it as a string safe.
+func getRequestBodyNoCopy(r *http.Request) ([]byte, error) {
+	if r == nil || r.Body == nil {
+		return nil, nil
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get Request Body %w", err)
+	}
+	return body, nil
+}
+
+// noCopyParams decodes a request body read by getRequestBodyNoCopy into RawParams, aliasing the query instead of
+// copying it and decoding numbers as json.Number like jsonDecode.
+type noCopyParams struct {
+	*graphql.RawParams
+	Query      noCopyString `json:"query"`
+	Variables  numberMap    `json:"variables"`
+	Extensions numberMap    `json:"extensions"`
+}
+
+// noCopyString is a json string aliased into body when encoding/json gives it a slice of body without escape
+// sequences, and copied otherwise.
+type noCopyString struct {
+	body  []byte
+	value string
+}
+
+func (s *noCopyString) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && bytes.IndexByte(data, '\\') < 0 && utf8.Valid(data) && within(s.body, data) {
+		s.value = graphql.UnsafeString(data[1 : len(data)-1])
+		return nil
+	}
+	return json.Unmarshal(data, &s.value)
+}
+
+// within reports whether sub is a slice of the memory of b.
+func within(b, sub []byte) bool {
+	if len(b) == 0 || len(sub) == 0 {
+		return false
+	}
+	start := uintptr(unsafe.Pointer(unsafe.SliceData(b)))
+	p := uintptr(unsafe.Pointer(unsafe.SliceData(sub)))
+	return p >= start && p+uintptr(len(sub)) <= start+uintptr(len(b))
+}
+
+type numberMap map[string]interface{}
+
+func (m *numberMap) UnmarshalJSON(data []byte) error {
+	var v map[string]interface{}
+	if err := jsonDecode(bytes.NewReader(data), &v); err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// jsonDecodeNoCopy decodes body into params like jsonDecode, without copying the query.
+func jsonDecodeNoCopy(body []byte, params *graphql.RawParams) error {
+	p := noCopyParams{RawParams: params, Query: noCopyString{body: body}}
+	if err := json.Unmarshal(body, &p); err != nil {
+		return err
+	}
+	params.Query = p.Query.value
+	params.Variables = p.Variables
+	params.Extensions = p.Extensions
+	return nil
+}
+
 func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecutor) {
 	ctx := r.Context()
 	writeHeaders(w, h.ResponseHeaders)
@@ -57,16 +142,28 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 		End:   graphql.Now(),
 	}
 
-	bodyString, err := getRequestBody(r)
+	var body []byte
+	var bodyString string
+	var err error
+	if h.ZeroCopy {
+		body, err = getRequestBodyNoCopy(r)
+		bodyString = graphql.UnsafeString(body)
+	} else {
+		bodyString, err = getRequestBody(r)
+	}
 	if err != nil {
 		gqlErr := gqlerror.Errorf("could not get json request body: %+v", err)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
-	bodyReader := io.NopCloser(strings.NewReader(bodyString))
-	if err = jsonDecode(bodyReader, &params); err != nil {
+	if h.ZeroCopy {
+		err = jsonDecodeNoCopy(body, params)
+	} else {
+		err = jsonDecode(io.NopCloser(strings.NewReader(bodyString)), &params)
+	}
+	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		gqlErr := gqlerror.Errorf(
 			"json request body could not be decoded: %+v body:%s",
@@ -74,19 +171,42 @@ func (h POST) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecu
 			bodyString,
 		)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
+	if params.DocumentID == "" && len(h.DocumentIDParams) > 0 {
+		params.DocumentID = documentIDMember(bodyString, h.DocumentIDParams)
+	}
+
 	rc, OpErr := exec.CreateOperationContext(ctx, params)
 	if OpErr != nil {
 		w.WriteHeader(statusFor(OpErr))
 		resp := exec.DispatchError(graphql.WithOperationContext(ctx, rc), OpErr)
-		writeJson(w, resp)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
 	var responses graphql.ResponseHandler
 	responses, ctx = exec.DispatchOperation(ctx, rc)
-	writeJson(w, responses(ctx))
+	if h.ZeroCopy {
+		writeResponseNoCopy(ctx, w, responses(ctx))
+		return
+	}
+	writeResponse(ctx, w, responses(ctx))
+}
+
+// documentIDMember returns the first of the string members names of the json object body.
+func documentIDMember(body string, names []string) string {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(body), &members); err != nil {
+		return ""
+	}
+	for _, name := range names {
+		var id string
+		if raw, ok := members[name]; ok && json.Unmarshal(raw, &id) == nil && id != "" {
+			return id
+		}
+	}
+	return ""
 }
@@ -0,0 +1,49 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func TestJsonDecodeNoCopy(t *testing.T) {
+	t.Run("aliases the query", func(t *testing.T) {
+		body := []byte(`{"query":"{ name }","operationName":"Q","variables":{"n":12345678901234567890},"extensions":{"a":1}}`)
+		params := &graphql.RawParams{}
+		require.NoError(t, jsonDecodeNoCopy(body, params))
+
+		require.Equal(t, "{ name }", params.Query)
+		require.True(t, within(body, graphql.UnsafeBytes(params.Query)))
+		require.Equal(t, "Q", params.OperationName)
+		require.Equal(t, map[string]interface{}{"n": json.Number("12345678901234567890")}, params.Variables)
+		require.Equal(t, map[string]interface{}{"a": json.Number("1")}, params.Extensions)
+	})
+
+	t.Run("copies queries with escape sequences", func(t *testing.T) {
+		body := []byte(`{"query":"{ name(v: \"a\") }"}`)
+		params := &graphql.RawParams{}
+		require.NoError(t, jsonDecodeNoCopy(body, params))
+
+		require.Equal(t, `{ name(v: "a") }`, params.Query)
+		require.False(t, within(body, graphql.UnsafeBytes(params.Query)))
+	})
+
+	t.Run("fails like jsonDecode", func(t *testing.T) {
+		require.EqualError(t, jsonDecodeNoCopy([]byte("notjson"), &graphql.RawParams{}), "invalid character 'o' in literal null (expecting 'u')")
+		require.Error(t, jsonDecodeNoCopy([]byte(`{"query":1}`), &graphql.RawParams{}))
+	})
+}
+
+func TestWriteJsonNoCopy(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeJsonNoCopy(&buf, &graphql.Response{Data: []byte(`{"name":"<b>"}`)}))
+	require.Equal(t, `{"data":{"name":"<b>"}}`, buf.String())
+
+	buf.Reset()
+	require.Error(t, writeJsonNoCopy(&buf, &graphql.Response{Data: []byte(`{"name":`)}))
+	require.Empty(t, buf.String(), "invalid data is not written")
+}
@@ -1,6 +1,7 @@
 package transport_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/testserver"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 )
@@ -98,6 +101,67 @@ func TestPOST(t *testing.T) {
 	})
 }
 
+func TestPOSTDocumentID(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.POST{DocumentIDParams: []string{"doc_id", "id"}})
+	h.Use(extension.PersistedDocuments{Store: graphql.MapCache{"abc": "{name}"}})
+
+	t.Run("documentId", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"documentId":"abc"}`, "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("configured member", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"id":"abc"}`, "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("members are read in order", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"id":"unknown","doc_id":"abc"}`, "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+}
+
+func TestPOSTZeroCopy(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.POST{ZeroCopy: true})
+
+	t.Run("success", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`, "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, `{"data":{"name":"test"}}`, resp.Body.String())
+	})
+
+	t.Run("decode failure", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", "notjson", "application/json")
+		assert.Equal(t, http.StatusBadRequest, resp.Code, resp.Body.String())
+		assert.Equal(t, `{"errors":[{"message":"json request body could not be decoded: invalid character 'o' in literal null (expecting 'u') body:notjson"}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("execution failure", func(t *testing.T) {
+		resp := doRequest(h, "POST", "/graphql", `{"query": "mutation { name }"}`, "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+		assert.Equal(t, `{"errors":[{"message":"mutations are not supported"}],"data":null}`, resp.Body.String())
+	})
+
+	t.Run("extensions", func(t *testing.T) {
+		h := testserver.New()
+		h.AddTransport(transport.POST{ZeroCopy: true})
+		h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+			resp := next(ctx)
+			resp.Extensions = map[string]interface{}{"cost": 1}
+			return resp
+		})
+
+		resp := doRequest(h, "POST", "/graphql", `{"query":"{ name }"}`, "application/json")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, `{"data":{"name":"test"},"extensions":{"cost":1}}`, resp.Body.String())
+	})
+}
+
 func doRequest(handler http.Handler, method string, target string, body string, contentType string) *httptest.ResponseRecorder {
 	r := httptest.NewRequest(method, target, strings.NewReader(body))
 	r.Header.Set("Content-Type", contentType)
@@ -0,0 +1,502 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// This file contains the small subset of MessagePack (https://msgpack.org/) needed to exchange graphql requests and
+// responses: nil, bool, integers, floats, strings, binary (decoded as strings), arrays and string keyed maps.
+// Extension types are rejected.
+
+// EncodeMsgPack writes response as MessagePack. It can be registered with the server as a response encoder for
+// clients that prefer MessagePack over json on the standard transports.
+func EncodeMsgPack(w io.Writer, response *graphql.Response) error {
+	b, err := appendMsgPackResponse(nil, response)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func appendMsgPackResponse(b []byte, response *graphql.Response) ([]byte, error) {
+	n := 1
+	if len(response.Errors) > 0 {
+		n++
+	}
+	if response.Label != "" {
+		n++
+	}
+	if len(response.Path) > 0 {
+		n++
+	}
+	if response.HasNext != nil {
+		n++
+	}
+	if len(response.Extensions) > 0 {
+		n++
+	}
+
+	var err error
+	b = appendMsgPackMapHeader(b, n)
+	if len(response.Errors) > 0 {
+		b = appendMsgPackString(b, "errors")
+		if b, err = appendMsgPackErrors(b, response.Errors); err != nil {
+			return nil, err
+		}
+	}
+	b = appendMsgPackString(b, "data")
+	if len(response.Data) == 0 {
+		b = appendMsgPackNil(b)
+	} else if b, err = appendMsgPackJSON(b, response.Data); err != nil {
+		return nil, err
+	}
+	if response.Label != "" {
+		b = appendMsgPackString(appendMsgPackString(b, "label"), response.Label)
+	}
+	if len(response.Path) > 0 {
+		b = appendMsgPackPath(appendMsgPackString(b, "path"), response.Path)
+	}
+	if response.HasNext != nil {
+		b = appendMsgPackBool(appendMsgPackString(b, "hasNext"), *response.HasNext)
+	}
+	if len(response.Extensions) > 0 {
+		if b, err = appendMsgPackValue(appendMsgPackString(b, "extensions"), response.Extensions); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// appendMsgPackErrors encodes errs with the keys and omitted fields of their json encoding.
+func appendMsgPackErrors(b []byte, errs gqlerror.List) ([]byte, error) {
+	b = appendMsgPackArrayHeader(b, len(errs))
+	for _, e := range errs {
+		if e == nil {
+			b = appendMsgPackNil(b)
+			continue
+		}
+		n := 1
+		if len(e.Path) > 0 {
+			n++
+		}
+		if len(e.Locations) > 0 {
+			n++
+		}
+		if len(e.Extensions) > 0 {
+			n++
+		}
+		b = appendMsgPackMapHeader(b, n)
+		b = appendMsgPackString(appendMsgPackString(b, "message"), e.Message)
+		if len(e.Path) > 0 {
+			b = appendMsgPackPath(appendMsgPackString(b, "path"), e.Path)
+		}
+		if len(e.Locations) > 0 {
+			b = appendMsgPackArrayHeader(appendMsgPackString(b, "locations"), len(e.Locations))
+			for _, l := range e.Locations {
+				b = appendMsgPackMapHeader(b, 2)
+				b = appendMsgPackInt(appendMsgPackString(b, "line"), int64(l.Line))
+				b = appendMsgPackInt(appendMsgPackString(b, "column"), int64(l.Column))
+			}
+		}
+		if len(e.Extensions) > 0 {
+			var err error
+			if b, err = appendMsgPackValue(appendMsgPackString(b, "extensions"), e.Extensions); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return b, nil
+}
+
+func appendMsgPackPath(b []byte, path ast.Path) []byte {
+	b = appendMsgPackArrayHeader(b, len(path))
+	for _, p := range path {
+		switch p := p.(type) {
+		case ast.PathIndex:
+			b = appendMsgPackInt(b, int64(p))
+		case ast.PathName:
+			b = appendMsgPackString(b, string(p))
+		}
+	}
+	return b
+}
+
+// appendMsgPackValue encodes the values extensions are usually made of directly, maps with their keys sorted like
+// encoding/json does. Other values, like structs, are transcoded from their json encoding.
+func appendMsgPackValue(b []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case nil:
+		return appendMsgPackNil(b), nil
+	case bool:
+		return appendMsgPackBool(b, v), nil
+	case string:
+		return appendMsgPackString(b, v), nil
+	case int:
+		return appendMsgPackInt(b, int64(v)), nil
+	case int32:
+		return appendMsgPackInt(b, int64(v)), nil
+	case int64:
+		return appendMsgPackInt(b, v), nil
+	case float64:
+		return appendMsgPackFloat(b, v), nil
+	case json.RawMessage:
+		return appendMsgPackJSON(b, v)
+	case ast.Path:
+		return appendMsgPackPath(b, v), nil
+	case gqlerror.List:
+		return appendMsgPackErrors(b, v)
+	case []interface{}:
+		b = appendMsgPackArrayHeader(b, len(v))
+		for _, e := range v {
+			var err error
+			if b, err = appendMsgPackValue(b, e); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b = appendMsgPackMapHeader(b, len(v))
+		for _, k := range keys {
+			var err error
+			if b, err = appendMsgPackValue(appendMsgPackString(b, k), v[k]); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	default:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return appendMsgPackJSON(b, raw)
+	}
+}
+
+// appendMsgPackJSON transcodes a single json document to MessagePack, preserving object key order.
+func appendMsgPackJSON(b []byte, raw []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	return appendMsgPackJSONValue(b, dec)
+}
+
+func appendMsgPackJSONValue(b []byte, dec *json.Decoder) ([]byte, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok := tok.(type) {
+	case nil:
+		return appendMsgPackNil(b), nil
+	case bool:
+		return appendMsgPackBool(b, tok), nil
+	case string:
+		return appendMsgPackString(b, tok), nil
+	case json.Number:
+		if i, err := tok.Int64(); err == nil {
+			return appendMsgPackInt(b, i), nil
+		}
+		f, err := tok.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return appendMsgPackFloat(b, f), nil
+	case json.Delim:
+		var (
+			body  []byte
+			count int
+		)
+		for dec.More() {
+			if tok == '{' {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				body = appendMsgPackString(body, key.(string))
+			}
+			if body, err = appendMsgPackJSONValue(body, dec); err != nil {
+				return nil, err
+			}
+			count++
+		}
+		// consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		if tok == '{' {
+			b = appendMsgPackMapHeader(b, count)
+		} else {
+			b = appendMsgPackArrayHeader(b, count)
+		}
+		return append(b, body...), nil
+	default:
+		return nil, fmt.Errorf("unexpected json token %v", tok)
+	}
+}
+
+func appendMsgPackNil(b []byte) []byte {
+	return append(b, 0xc0)
+}
+
+func appendMsgPackBool(b []byte, v bool) []byte {
+	if v {
+		return append(b, 0xc3)
+	}
+	return append(b, 0xc2)
+}
+
+func appendMsgPackInt(b []byte, v int64) []byte {
+	switch {
+	case v >= 0 && v <= math.MaxInt8:
+		return append(b, byte(v))
+	case v < 0 && v >= -32:
+		return append(b, byte(v))
+	case v >= math.MinInt8 && v <= math.MaxInt8:
+		return append(b, 0xd0, byte(v))
+	case v >= math.MinInt16 && v <= math.MaxInt16:
+		return binary.BigEndian.AppendUint16(append(b, 0xd1), uint16(v))
+	case v >= math.MinInt32 && v <= math.MaxInt32:
+		return binary.BigEndian.AppendUint32(append(b, 0xd2), uint32(v))
+	default:
+		return binary.BigEndian.AppendUint64(append(b, 0xd3), uint64(v))
+	}
+}
+
+func appendMsgPackFloat(b []byte, v float64) []byte {
+	return binary.BigEndian.AppendUint64(append(b, 0xcb), math.Float64bits(v))
+}
+
+func appendMsgPackString(b []byte, v string) []byte {
+	switch n := len(v); {
+	case n < 32:
+		b = append(b, 0xa0|byte(n))
+	case n <= math.MaxUint8:
+		b = append(b, 0xd9, byte(n))
+	case n <= math.MaxUint16:
+		b = binary.BigEndian.AppendUint16(append(b, 0xda), uint16(n))
+	default:
+		b = binary.BigEndian.AppendUint32(append(b, 0xdb), uint32(n))
+	}
+	return append(b, v...)
+}
+
+func appendMsgPackArrayHeader(b []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, 0x90|byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xdc), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(b, 0xdd), uint32(n))
+	}
+}
+
+func appendMsgPackMapHeader(b []byte, n int) []byte {
+	switch {
+	case n < 16:
+		return append(b, 0x80|byte(n))
+	case n <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(b, 0xde), uint16(n))
+	default:
+		return binary.BigEndian.AppendUint32(append(b, 0xdf), uint32(n))
+	}
+}
+
+var (
+	errMsgPackShort = fmt.Errorf("msgpack: unexpected end of input")
+	errMsgPackDepth = fmt.Errorf("msgpack: exceeded max depth of %d", msgPackMaxDepth)
+)
+
+// msgPackMaxDepth is the deepest nesting of arrays and maps decoded, the same as encoding/json, so hostile payloads
+// fail to decode instead of overflowing the stack.
+const msgPackMaxDepth = 10000
+
+// decodeMsgPack decodes a single MessagePack value from b, returning the remaining bytes. Maps decode to
+// map[string]interface{}, arrays to []interface{}, integers to int64 and binary data to string so the result can be
+// fed into the same input coercion as decoded json.
+func decodeMsgPack(b []byte) (interface{}, []byte, error) {
+	return decodeMsgPackValue(b, 0)
+}
+
+func decodeMsgPackValue(b []byte, depth int) (interface{}, []byte, error) {
+	if len(b) == 0 {
+		return nil, nil, errMsgPackShort
+	}
+
+	c, b := b[0], b[1:]
+	switch {
+	case c <= 0x7f:
+		return int64(c), b, nil
+	case c >= 0xe0:
+		return int64(int8(c)), b, nil
+	case c&0xf0 == 0x80:
+		return decodeMsgPackMap(b, int(c&0x0f), depth+1)
+	case c&0xf0 == 0x90:
+		return decodeMsgPackArray(b, int(c&0x0f), depth+1)
+	case c&0xe0 == 0xa0:
+		return decodeMsgPackString(b, int(c&0x1f))
+	}
+
+	switch c {
+	case 0xc0:
+		return nil, b, nil
+	case 0xc2:
+		return false, b, nil
+	case 0xc3:
+		return true, b, nil
+	case 0xc4, 0xd9:
+		n, b, err := msgPackUint(b, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgPackString(b, int(n))
+	case 0xc5, 0xda:
+		n, b, err := msgPackUint(b, 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgPackString(b, int(n))
+	case 0xc6, 0xdb:
+		n, b, err := msgPackUint(b, 4)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgPackString(b, int(n))
+	case 0xca:
+		n, b, err := msgPackUint(b, 4)
+		if err != nil {
+			return nil, nil, err
+		}
+		return float64(math.Float32frombits(uint32(n))), b, nil
+	case 0xcb:
+		n, b, err := msgPackUint(b, 8)
+		if err != nil {
+			return nil, nil, err
+		}
+		return math.Float64frombits(n), b, nil
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		n, b, err := msgPackUint(b, 1<<(c-0xcc))
+		if err != nil {
+			return nil, nil, err
+		}
+		if n > math.MaxInt64 {
+			return float64(n), b, nil
+		}
+		return int64(n), b, nil
+	case 0xd0:
+		n, b, err := msgPackUint(b, 1)
+		return int64(int8(n)), b, err
+	case 0xd1:
+		n, b, err := msgPackUint(b, 2)
+		return int64(int16(n)), b, err
+	case 0xd2:
+		n, b, err := msgPackUint(b, 4)
+		return int64(int32(n)), b, err
+	case 0xd3:
+		n, b, err := msgPackUint(b, 8)
+		return int64(n), b, err
+	case 0xdc:
+		n, b, err := msgPackUint(b, 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgPackArray(b, int(n), depth+1)
+	case 0xdd:
+		n, b, err := msgPackUint(b, 4)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgPackArray(b, int(n), depth+1)
+	case 0xde:
+		n, b, err := msgPackUint(b, 2)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgPackMap(b, int(n), depth+1)
+	case 0xdf:
+		n, b, err := msgPackUint(b, 4)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgPackMap(b, int(n), depth+1)
+	}
+
+	return nil, nil, fmt.Errorf("msgpack: unsupported type 0x%x", c)
+}
+
+func msgPackUint(b []byte, size int) (uint64, []byte, error) {
+	if len(b) < size {
+		return 0, nil, errMsgPackShort
+	}
+	var n uint64
+	for _, c := range b[:size] {
+		n = n<<8 | uint64(c)
+	}
+	return n, b[size:], nil
+}
+
+func decodeMsgPackString(b []byte, n int) (interface{}, []byte, error) {
+	if len(b) < n {
+		return nil, nil, errMsgPackShort
+	}
+	return string(b[:n]), b[n:], nil
+}
+
+func decodeMsgPackArray(b []byte, n, depth int) (interface{}, []byte, error) {
+	if depth > msgPackMaxDepth {
+		return nil, nil, errMsgPackDepth
+	}
+	// every element takes at least one byte, so this bounds allocations for hostile lengths
+	if len(b) < n {
+		return nil, nil, errMsgPackShort
+	}
+	arr := make([]interface{}, n)
+	for i := range arr {
+		var err error
+		if arr[i], b, err = decodeMsgPackValue(b, depth); err != nil {
+			return nil, nil, err
+		}
+	}
+	return arr, b, nil
+}
+
+func decodeMsgPackMap(b []byte, n, depth int) (interface{}, []byte, error) {
+	if depth > msgPackMaxDepth {
+		return nil, nil, errMsgPackDepth
+	}
+	if len(b) < n*2 {
+		return nil, nil, errMsgPackShort
+	}
+	m := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		k, rest, err := decodeMsgPackValue(b, depth)
+		if err != nil {
+			return nil, nil, err
+		}
+		key, ok := k.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("msgpack: map keys must be strings, got %T", k)
+		}
+		if m[key], b, err = decodeMsgPackValue(rest, depth); err != nil {
+			return nil, nil, err
+		}
+	}
+	return m, b, nil
+}
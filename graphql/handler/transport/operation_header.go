@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// operationHeaderResponseWriter sets a header naming the operation of the request before the response is written.
+type operationHeaderResponseWriter struct {
+	http.ResponseWriter
+	header      string
+	info        *graphql.OperationInfo
+	wroteHeader bool
+}
+
+// WithOperationHeader wraps w so that the header is set to the type and name of the operation held by info, like
+// "query GetUser", when the response starts. Nothing is set for requests failing before the operation is known.
+func WithOperationHeader(w http.ResponseWriter, header string, info *graphql.OperationInfo) http.ResponseWriter {
+	return &operationHeaderResponseWriter{ResponseWriter: w, header: header, info: info}
+}
+
+func (w *operationHeaderResponseWriter) setHeader() {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	typ := w.info.Type()
+	if typ == "" {
+		return
+	}
+	value := string(typ)
+	if name := w.info.Name(); name != "" {
+		value += " " + name
+	}
+	w.ResponseWriter.Header().Set(w.header, value)
+}
+
+func (w *operationHeaderResponseWriter) WriteHeader(statusCode int) {
+	w.setHeader()
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *operationHeaderResponseWriter) Write(b []byte) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.Write(b)
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *operationHeaderResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *operationHeaderResponseWriter) Flush() {
+	w.setHeader()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *operationHeaderResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T does not implement http.Hijacker", w.ResponseWriter)
+	}
+	return h.Hijack()
+}
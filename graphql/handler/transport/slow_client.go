@@ -0,0 +1,87 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+)
+
+// stallDetector expires the write deadline of a connection when writes have been pending for longer than timeout
+// without the client accepting any of them. A nil stallDetector never trips.
+type stallDetector struct {
+	timeout time.Duration
+	expire  func()
+
+	mu      sync.Mutex
+	pending int
+	since   time.Time
+	tripped bool
+}
+
+func newStallDetector(timeout time.Duration, expire func()) *stallDetector {
+	if timeout == 0 {
+		return nil
+	}
+	return &stallDetector{timeout: timeout, expire: expire}
+}
+
+// begin marks a write as pending.
+func (d *stallDetector) begin() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.pending == 0 {
+		d.since = time.Now()
+	}
+	d.pending++
+}
+
+// end marks a pending write as done, the client accepted data.
+func (d *stallDetector) end() {
+	if d == nil {
+		return
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.pending--
+	d.since = time.Now()
+}
+
+func (d *stallDetector) isTripped() bool {
+	if d == nil {
+		return false
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.tripped
+}
+
+// watch trips the detector once writes stall, until ctx is done.
+func (d *stallDetector) watch(ctx context.Context) {
+	ticker := time.NewTicker(d.timeout / 4)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.mu.Lock()
+			d.tripped = d.pending > 0 && time.Since(d.since) > d.timeout
+			tripped := d.tripped
+			d.mu.Unlock()
+			if tripped {
+				d.expire()
+				return
+			}
+		}
+	}
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,120 @@
+package transport_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// newFloodingServer returns a server whose subscription sends large responses until its context is cancelled, which
+// is reported on released.
+func newFloodingServer(released chan<- struct{}) *handler.Server {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		type Query { name: String! }
+		type Subscription { name: String! }
+	`})
+	data := []byte(`{"name":"` + strings.Repeat("x", 1<<16) + `"}`)
+	return handler.New(&graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+		ExecFunc: func(ctx context.Context) graphql.ResponseHandler {
+			go func() {
+				<-ctx.Done()
+				close(released)
+			}()
+			return func(ctx context.Context) *graphql.Response {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return &graphql.Response{Data: data}
+			}
+		},
+	})
+}
+
+func TestSlowClients(t *testing.T) {
+	t.Run("sse", func(t *testing.T) {
+		released := make(chan struct{})
+		h := newFloodingServer(released)
+		h.AddTransport(transport.SSE{StallTimeout: 100 * time.Millisecond})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		req, err := http.NewRequest("POST", srv.URL, strings.NewReader(`{"query":"subscription { name }"}`))
+		require.NoError(t, err)
+		req.Header.Set("Accept", "text/event-stream")
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		// the body is never read
+		defer resp.Body.Close()
+
+		select {
+		case <-released:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the operation of the stalled client was not cancelled")
+		}
+
+		read := make(chan struct{})
+		go func() {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			close(read)
+		}()
+		select {
+		case <-read:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the connection of the stalled client was not closed")
+		}
+	})
+
+	t.Run("websocket", func(t *testing.T) {
+		released := make(chan struct{})
+		closed := make(chan int, 1)
+		h := newFloodingServer(released)
+		h.AddTransport(transport.Websocket{
+			StallTimeout: 100 * time.Millisecond,
+			CloseFunc: func(ctx context.Context, closeCode int) {
+				closed <- closeCode
+			},
+		})
+		srv := httptest.NewServer(h)
+		defer srv.Close()
+
+		c := wsConnect(srv.URL)
+		defer c.Close()
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		require.NoError(t, c.WriteJSON(&operationMessage{
+			Type:    startMsg,
+			ID:      "test_1",
+			Payload: []byte(`{"query":"subscription { name }"}`),
+		}))
+		// no message is read
+
+		select {
+		case code := <-closed:
+			require.Equal(t, websocket.CloseAbnormalClosure, code)
+		case <-time.After(5 * time.Second):
+			t.Fatal("the stalled client was not disconnected")
+		}
+		select {
+		case <-released:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the subscription of the stalled client was not cancelled")
+		}
+	})
+}
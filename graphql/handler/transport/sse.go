@@ -1,20 +1,28 @@
 package transport
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"mime"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
 
 	"github.com/99designs/gqlgen/graphql"
 )
 
-type SSE struct{}
+type SSE struct {
+	// WriteTimeout limits the time each event may take to be written, for response writers supporting write
+	// deadlines. StallTimeout ends the stream when an event has been waiting that long for the client to read it,
+	// checked in the background. Both cancel the operation, releasing its resolvers, and end the stream without a
+	// last event: the connection can't be written to once its write deadline expired, so the server closes it.
+	WriteTimeout time.Duration
+	StallTimeout time.Duration
+}
 
 var _ graphql.Transport = SSE{}
 
@@ -54,8 +62,8 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	if err != nil {
 		gqlErr := gqlerror.Errorf("could not get json request body: %+v", err)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		log.Printf("could not get json request body: %+v", err.Error())
-		writeJson(w, resp)
+		graphql.GetLogger(ctx).Warn("could not get json request body", "error", err)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
@@ -68,8 +76,8 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 			bodyString,
 		)
 		resp := exec.DispatchError(ctx, gqlerror.List{gqlErr})
-		log.Printf("decoding error: %+v body:%s", err.Error(), bodyString)
-		writeJson(w, resp)
+		graphql.GetLogger(ctx).Warn("could not decode json request body", "error", err, "body", bodyString)
+		writeResponse(ctx, w, resp)
 		return
 	}
 
@@ -83,25 +91,56 @@ func (t SSE) Do(w http.ResponseWriter, r *http.Request, exec graphql.GraphExecut
 	if opErr != nil {
 		resp := exec.DispatchError(ctx, opErr)
 		writeJsonWithSSE(w, resp)
-	} else {
-		responses, ctx := exec.DispatchOperation(ctx, rc)
-		for {
-			response := responses(ctx)
-			if response == nil {
-				break
-			}
-			writeJsonWithSSE(w, response)
-			flusher.Flush()
-		}
+	} else if !t.stream(ctx, w, exec, rc) {
+		return
 	}
 
 	fmt.Fprint(w, "event: complete\n\n")
 }
 
-func writeJsonWithSSE(w io.Writer, response *graphql.Response) {
+// stream writes the responses of the operation, reporting false when the client was too slow to read them.
+func (t SSE) stream(ctx context.Context, w http.ResponseWriter, exec graphql.GraphExecutor, rc *graphql.OperationContext) bool {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	controller := http.NewResponseController(w)
+	stall := newStallDetector(t.StallTimeout, func() {
+		_ = controller.SetWriteDeadline(time.Now())
+	})
+	if stall != nil {
+		go stall.watch(ctx)
+	}
+
+	responses, ctx := exec.DispatchOperation(ctx, rc)
+	for {
+		response := responses(ctx)
+		if response == nil {
+			return true
+		}
+
+		if t.WriteTimeout != 0 {
+			_ = controller.SetWriteDeadline(time.Now().Add(t.WriteTimeout))
+		}
+		stall.begin()
+		err := writeJsonWithSSE(w, response)
+		if err == nil {
+			err = controller.Flush()
+		}
+		stall.end()
+
+		if stall.isTripped() || isTimeout(err) {
+			cancel()
+			graphql.GetLogger(ctx).Warn("client too slow to read the stream", "error", err)
+			return false
+		}
+	}
+}
+
+func writeJsonWithSSE(w io.Writer, response *graphql.Response) error {
 	b, err := json.Marshal(response)
 	if err != nil {
 		panic(err)
 	}
-	fmt.Fprintf(w, "event: next\ndata: %s\n\n", b)
+	_, err = fmt.Fprintf(w, "event: next\ndata: %s\n\n", b)
+	return err
 }
@@ -0,0 +1,6 @@
+//go:build !wasip1 && !js
+
+package transport
+
+// tempFiles reports whether uploads larger than MaxMemory are written to temporary files.
+const tempFiles = true
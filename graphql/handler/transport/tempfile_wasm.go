@@ -0,0 +1,7 @@
+//go:build wasip1 || js
+
+package transport
+
+// tempFiles reports whether uploads larger than MaxMemory are written to temporary files. WebAssembly runtimes
+// usually have no writable file system, uploads are kept in memory there and only limited by MaxUploadSize.
+const tempFiles = false
@@ -1,7 +1,9 @@
 package transport
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 
@@ -10,22 +12,85 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 )
 
-func writeJson(w io.Writer, response *graphql.Response) {
+// writeResponse writes response with writeJson, logging the failure when it can't be encoded. Failures of the
+// connection itself are reported by the WriteErrorFunc of the server.
+func writeResponse(ctx context.Context, w io.Writer, response *graphql.Response) {
+	if err := writeJson(w, response); err != nil {
+		graphql.GetLogger(ctx).Error("could not write response", "error", err)
+	}
+}
+
+// writeResponseNoCopy is writeResponse for writeJsonNoCopy.
+func writeResponseNoCopy(ctx context.Context, w io.Writer, response *graphql.Response) {
+	if err := writeJsonNoCopy(w, response); err != nil {
+		graphql.GetLogger(ctx).Error("could not write response", "error", err)
+	}
+}
+
+func writeJson(w io.Writer, response *graphql.Response) error {
+	if ew := responseEncoderFor(w); ew != nil {
+		return ew.encode(w, response)
+	}
+
 	b, err := json.Marshal(response)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// writeJsonNoCopy writes the same document as writeJson, but validates response.Data and copies it to w verbatim
+// instead of having encoding/json compact and html escape it into a new buffer. Nothing is written when it is invalid.
+func writeJsonNoCopy(w io.Writer, response *graphql.Response) error {
+	if responseEncoderFor(w) != nil {
+		return writeJson(w, response)
+	}
+	if len(response.Data) > 0 && !json.Valid(response.Data) {
+		return errors.New("response data is not valid json")
+	}
+
+	io.WriteString(w, "{")
+	if len(response.Errors) > 0 {
+		b, err := json.Marshal(response.Errors)
+		if err != nil {
+			return err
+		}
+		io.WriteString(w, `"errors":`)
+		w.Write(b)
+		io.WriteString(w, ",")
+	}
+
+	io.WriteString(w, `"data":`)
+	if len(response.Data) == 0 {
+		io.WriteString(w, "null")
+	} else {
+		w.Write(response.Data)
+	}
+
+	rest, err := json.Marshal(&graphql.Response{
+		Label:      response.Label,
+		Path:       response.Path,
+		HasNext:    response.HasNext,
+		Extensions: response.Extensions,
+	})
+	if err != nil {
+		return err
 	}
-	w.Write(b)
+	// rest always starts with the errors-less `{"data":null`, anything after that belongs to the trailing fields
+	rest = rest[len(`{"data":null`):]
+	_, err = w.Write(rest)
+	return err
 }
 
-func writeJsonError(w io.Writer, msg string) {
-	writeJson(w, &graphql.Response{Errors: gqlerror.List{{Message: msg}}})
+func writeJsonError(ctx context.Context, w io.Writer, msg string) {
+	writeResponse(ctx, w, &graphql.Response{Errors: gqlerror.List{{Message: msg}}})
 }
 
-func writeJsonErrorf(w io.Writer, format string, args ...interface{}) {
-	writeJson(w, &graphql.Response{Errors: gqlerror.List{{Message: fmt.Sprintf(format, args...)}}})
+func writeJsonErrorf(ctx context.Context, w io.Writer, format string, args ...interface{}) {
+	writeResponse(ctx, w, &graphql.Response{Errors: gqlerror.List{{Message: fmt.Sprintf(format, args...)}}})
 }
 
-func writeJsonGraphqlError(w io.Writer, err ...*gqlerror.Error) {
-	writeJson(w, &graphql.Response{Errors: err})
+func writeJsonGraphqlError(ctx context.Context, w io.Writer, err ...*gqlerror.Error) {
+	writeResponse(ctx, w, &graphql.Response{Errors: err})
 }
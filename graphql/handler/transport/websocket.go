@@ -6,7 +6,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"net/http"
 	"sync"
@@ -39,6 +38,27 @@ type (
 		 */
 		MissingPongOk bool
 
+		// KeepAlivePayload is the payload of the "ka" messages sent to graphql-ws clients every KeepAlivePingInterval
+		// and after connection_ack, for legacy clients expecting one. They have none by default.
+		KeepAlivePayload json.RawMessage
+
+		// WriteTimeout limits the time each message may take to be written. StallTimeout closes the connection when
+		// messages have been waiting that long to be written without the client reading any, including the time
+		// spent queued behind the messages of other subscriptions. Both close the underlying connection without a
+		// close message, which a client that stopped reading can't receive, and release the resolvers of its
+		// subscriptions. CloseFunc is given websocket.CloseAbnormalClosure.
+		WriteTimeout time.Duration
+		StallTimeout time.Duration
+
+		// StatsFunc is called with the stats of each connection once it is closed, see GetWebsocketStats for the stats
+		// of open connections.
+		StatsFunc WebsocketStatsFunc
+
+		// ProtocolCounter counts the subprotocols negotiated by clients. LegacyProtocolFunc is called for the clients
+		// of the legacy graphql-ws protocol, to warn about them or close their connections ahead of its removal.
+		ProtocolCounter    WebsocketProtocolCounter
+		LegacyProtocolFunc LegacyProtocolFunc
+
 		didInjectSubprotocols bool
 	}
 	wsConnection struct {
@@ -54,6 +74,8 @@ type (
 		receivedPong    bool
 		exec            graphql.GraphExecutor
 		closed          bool
+		stall           *stallDetector
+		stats           wsStats
 
 		initPayload InitPayload
 	}
@@ -94,11 +116,15 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 	t.injectGraphQLWSSubprotocols()
 	ws, err := t.Upgrader.Upgrade(w, r, http.Header{})
 	if err != nil {
-		log.Printf("unable to upgrade %T to websocket %s: ", w, err.Error())
+		graphql.GetLogger(r.Context()).Warn("unable to upgrade to websocket", "error", err)
 		SendErrorf(w, http.StatusBadRequest, "unable to upgrade")
 		return
 	}
 
+	if t.ProtocolCounter != nil {
+		t.ProtocolCounter.IncWebsocketProtocol(ws.Subprotocol())
+	}
+
 	var me messageExchanger
 	switch ws.Subprotocol() {
 	default:
@@ -108,6 +134,14 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 	case graphqlwsSubprotocol, "":
 		// clients are required to send a subprotocol, to be backward compatible with the previous implementation we select
 		// "graphql-ws" by default
+		if t.LegacyProtocolFunc != nil {
+			if err := t.LegacyProtocolFunc(r.Context()); err != nil {
+				msg := websocket.FormatCloseMessage(LegacyProtocolCloseCode, err.Error())
+				_ = ws.WriteMessage(websocket.CloseMessage, msg)
+				_ = ws.Close()
+				return
+			}
+		}
 		me = graphqlwsMessageExchanger{c: ws}
 	case graphqltransportwsSubprotocol:
 		me = graphqltransportwsMessageExchanger{c: ws}
@@ -116,11 +150,12 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 	conn := wsConnection{
 		active:    map[string]context.CancelFunc{},
 		conn:      ws,
-		ctx:       r.Context(),
 		exec:      exec,
 		me:        me,
 		Websocket: t,
 	}
+	conn.ctx = withWebsocketConnection(r.Context(), &conn)
+	conn.stats.connectedAt = time.Now()
 
 	if !conn.init() {
 		return
@@ -130,12 +165,17 @@ func (t Websocket) Do(w http.ResponseWriter, r *http.Request, exec graphql.Graph
 }
 
 func (c *wsConnection) handlePossibleError(err error, isReadError bool) {
-	if c.ErrorFunc != nil && err != nil {
-		c.ErrorFunc(c.ctx, WebsocketError{
-			Err:         err,
-			IsReadError: isReadError,
-		})
+	if err == nil {
+		return
+	}
+	if c.ErrorFunc == nil {
+		graphql.GetLogger(c.ctx).Debug("websocket error", "error", err, "read", isReadError)
+		return
 	}
+	c.ErrorFunc(c.ctx, WebsocketError{
+		Err:         err,
+		IsReadError: isReadError,
+	})
 }
 
 func (c *wsConnection) nextMessageWithTimeout(timeout time.Duration) (message, error) {
@@ -182,6 +222,7 @@ func (c *wsConnection) init() bool {
 		c.close(websocket.CloseProtocolError, "decoding error")
 		return false
 	}
+	c.stats.messagesRead.Add(1)
 
 	switch m.t {
 	case initMessageType:
@@ -214,7 +255,7 @@ func (c *wsConnection) init() bool {
 		} else {
 			c.write(&message{t: connectionAckMessageType})
 		}
-		c.write(&message{t: keepAliveMessageType})
+		c.writeKeepAlive(&message{t: keepAliveMessageType, payload: c.KeepAlivePayload})
 	case connectionCloseMessageType:
 		c.close(websocket.CloseNormalClosure, "terminated")
 		return false
@@ -228,9 +269,29 @@ func (c *wsConnection) init() bool {
 }
 
 func (c *wsConnection) write(msg *message) {
+	c.stall.begin()
 	c.mu.Lock()
-	c.handlePossibleError(c.me.Send(msg), false)
+	if c.WriteTimeout != 0 {
+		_ = c.conn.SetWriteDeadline(time.Now().Add(c.WriteTimeout))
+	}
+	err := c.me.Send(msg)
+	c.handlePossibleError(err, false)
 	c.mu.Unlock()
+	c.stall.end()
+	if err == nil {
+		c.stats.messagesWritten.Add(1)
+	}
+
+	if c.stall.isTripped() || isTimeout(err) {
+		// the websocket can't be written to after a write timed out
+		c.closeConn(websocket.CloseAbnormalClosure, nil)
+	}
+}
+
+// writeKeepAlive writes a message the server sends on its own to keep the connection alive.
+func (c *wsConnection) writeKeepAlive(msg *message) {
+	c.stats.keepAlivesSent.Add(1)
+	c.write(msg)
 }
 
 func (c *wsConnection) run() {
@@ -242,6 +303,15 @@ func (c *wsConnection) run() {
 		c.close(websocket.CloseAbnormalClosure, "unexpected closure")
 	}()
 
+	// Expire the pending writes of clients that stopped reading, the write that fails closes the connection. It is
+	// set before any goroutine writes.
+	c.stall = newStallDetector(c.StallTimeout, func() {
+		_ = c.conn.UnderlyingConn().SetWriteDeadline(time.Now())
+	})
+	if c.stall != nil {
+		go c.stall.watch(ctx)
+	}
+
 	// If we're running in graphql-ws mode, create a timer that will trigger a
 	// keep alive message every interval
 	if (c.conn.Subprotocol() == "" || c.conn.Subprotocol() == graphqlwsSubprotocol) && c.KeepAlivePingInterval != 0 {
@@ -291,6 +361,7 @@ func (c *wsConnection) run() {
 			}
 			return
 		}
+		c.stats.messagesRead.Add(1)
 
 		switch m.t {
 		case startMessageType:
@@ -328,7 +399,7 @@ func (c *wsConnection) keepAlivePongOnly(ctx context.Context) {
 			c.pongOnlyTicker.Stop()
 			return
 		case <-c.pongOnlyTicker.C:
-			c.write(&message{t: pongMessageType, payload: json.RawMessage{}})
+			c.writeKeepAlive(&message{t: pongMessageType, payload: json.RawMessage{}})
 		}
 	}
 }
@@ -340,7 +411,7 @@ func (c *wsConnection) keepAlive(ctx context.Context) {
 			c.keepAliveTicker.Stop()
 			return
 		case <-c.keepAliveTicker.C:
-			c.write(&message{t: keepAliveMessageType})
+			c.writeKeepAlive(&message{t: keepAliveMessageType, payload: c.KeepAlivePayload})
 		}
 	}
 }
@@ -352,7 +423,7 @@ func (c *wsConnection) ping(ctx context.Context) {
 			c.pingPongTicker.Stop()
 			return
 		case <-c.pingPongTicker.C:
-			c.write(&message{t: pingMessageType, payload: json.RawMessage{}})
+			c.writeKeepAlive(&message{t: pingMessageType, payload: json.RawMessage{}})
 			// The initial deadline for this method is set in run()
 			// if we have not yet received a pong, don't reset the deadline.
 			c.mu.Lock()
@@ -412,6 +483,7 @@ func (c *wsConnection) subscribe(start time.Time, msg *message) {
 	c.mu.Lock()
 	c.active[msg.id] = cancel
 	c.mu.Unlock()
+	c.stats.subscriptions.Add(1)
 
 	go func() {
 		ctx = withSubscriptionErrorContext(ctx)
@@ -490,12 +562,19 @@ func (c *wsConnection) sendConnectionError(format string, args ...interface{}) {
 }
 
 func (c *wsConnection) close(closeCode int, message string) {
+	c.closeConn(closeCode, websocket.FormatCloseMessage(closeCode, message))
+}
+
+// closeConn closes the connection, after writing closeMessage to the client when it is not nil.
+func (c *wsConnection) closeConn(closeCode int, closeMessage []byte) {
 	c.mu.Lock()
 	if c.closed {
 		c.mu.Unlock()
 		return
 	}
-	_ = c.conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(closeCode, message))
+	if closeMessage != nil {
+		_ = c.conn.WriteMessage(websocket.CloseMessage, closeMessage)
+	}
 	for _, closer := range c.active {
 		closer()
 	}
@@ -506,4 +585,7 @@ func (c *wsConnection) close(closeCode int, message string) {
 	if c.CloseFunc != nil {
 		c.CloseFunc(c.ctx, closeCode)
 	}
+	if c.StatsFunc != nil {
+		c.StatsFunc(c.ctx, c.statsSnapshot())
+	}
 }
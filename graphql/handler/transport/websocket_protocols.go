@@ -0,0 +1,49 @@
+package transport
+
+import (
+	"context"
+	"sync"
+)
+
+// LegacyProtocolCloseCode closes websockets rejected by Websocket.LegacyProtocolFunc, telling clients to upgrade to
+// graphql-transport-ws.
+const LegacyProtocolCloseCode = 4426
+
+// WebsocketProtocolCounter counts the subprotocols negotiated by websocket clients, to tell when the legacy graphql-ws
+// protocol is no longer used.
+type WebsocketProtocolCounter interface {
+	// IncWebsocketProtocol is called once per connection, subprotocol is empty for clients that did not ask for one,
+	// which speak graphql-ws.
+	IncWebsocketProtocol(subprotocol string)
+}
+
+// WebsocketProtocolCounts keeps the counts of a WebsocketProtocolCounter in memory.
+type WebsocketProtocolCounts struct {
+	mu     sync.Mutex
+	counts map[string]int64
+}
+
+func (c *WebsocketProtocolCounts) IncWebsocketProtocol(subprotocol string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.counts == nil {
+		c.counts = map[string]int64{}
+	}
+	c.counts[subprotocol]++
+}
+
+// Counts returns a copy of the counts.
+func (c *WebsocketProtocolCounts) Counts() map[string]int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := make(map[string]int64, len(c.counts))
+	for k, v := range c.counts {
+		counts[k] = v
+	}
+	return counts
+}
+
+// LegacyProtocolFunc is called when a client connects with the legacy graphql-ws protocol, before it is initialised.
+// It can log a warning and return nil to keep the connection, or return an error to close it with
+// LegacyProtocolCloseCode and the message of the error.
+type LegacyProtocolFunc func(ctx context.Context) error
@@ -0,0 +1,65 @@
+package transport
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// WebsocketStats are the counters of a websocket connection, to tune keepalives and spot misbehaving clients, like
+// long-lived devices speaking the legacy graphql-ws protocol.
+type WebsocketStats struct {
+	// Subprotocol is the negotiated subprotocol, empty for clients that did not ask for one and speak graphql-ws.
+	Subprotocol string
+	ConnectedAt time.Time
+
+	MessagesRead    int64
+	MessagesWritten int64
+	// KeepAlivesSent counts the "ka" messages of graphql-ws and the pings and pongs of graphql-transport-ws sent by the
+	// server on its own.
+	KeepAlivesSent      int64
+	Subscriptions       int64 // started since the connection was opened
+	ActiveSubscriptions int
+}
+
+// WebsocketStatsFunc is called with the stats of a websocket connection once it is closed.
+type WebsocketStatsFunc func(ctx context.Context, stats WebsocketStats)
+
+type wsStats struct {
+	connectedAt     time.Time
+	messagesRead    atomic.Int64
+	messagesWritten atomic.Int64
+	keepAlivesSent  atomic.Int64
+	subscriptions   atomic.Int64
+}
+
+var wsConnectionCtxKey = &wsCloseReasonContextKey{"connection"}
+
+func withWebsocketConnection(ctx context.Context, c *wsConnection) context.Context {
+	return context.WithValue(ctx, wsConnectionCtxKey, c)
+}
+
+// GetWebsocketStats returns the current stats of the websocket connection of ctx, and false outside of websocket
+// connections.
+func GetWebsocketStats(ctx context.Context) (WebsocketStats, bool) {
+	c, ok := ctx.Value(wsConnectionCtxKey).(*wsConnection)
+	if !ok {
+		return WebsocketStats{}, false
+	}
+	return c.statsSnapshot(), true
+}
+
+func (c *wsConnection) statsSnapshot() WebsocketStats {
+	c.mu.Lock()
+	active := len(c.active)
+	c.mu.Unlock()
+	return WebsocketStats{
+		Subprotocol:         c.conn.Subprotocol(),
+		ConnectedAt:         c.stats.connectedAt,
+		MessagesRead:        c.stats.messagesRead.Load(),
+		MessagesWritten:     c.stats.messagesWritten.Load(),
+		KeepAlivesSent:      c.stats.keepAlivesSent.Load(),
+		Subscriptions:       c.stats.subscriptions.Load(),
+		ActiveSubscriptions: active,
+	}
+}
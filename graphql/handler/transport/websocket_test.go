@@ -189,6 +189,129 @@ func TestWebsocketWithKeepAlive(t *testing.T) {
 	assert.Equal(t, connectionKeepAliveMsg, msg.Type)
 }
 
+func TestWebsocketKeepAlivePayload(t *testing.T) {
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Millisecond,
+		KeepAlivePayload:      json.RawMessage(`{"ts":1}`),
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnect(srv.URL)
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+	assert.Equal(t, connectionAckMsg, readOp(c).Type)
+	for i := 0; i < 2; i++ {
+		msg := readOp(c)
+		assert.Equal(t, connectionKeepAliveMsg, msg.Type)
+		assert.JSONEq(t, `{"ts":1}`, string(msg.Payload))
+	}
+}
+
+func TestWebsocketStats(t *testing.T) {
+	h := testserver.New()
+	closed := make(chan transport.WebsocketStats, 1)
+	h.AddTransport(transport.Websocket{
+		StatsFunc: func(ctx context.Context, stats transport.WebsocketStats) {
+			closed <- stats
+		},
+	})
+	live := make(chan transport.WebsocketStats, 1)
+	h.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+		stats, ok := transport.GetWebsocketStats(ctx)
+		require.True(t, ok)
+		live <- stats
+		return next(ctx)
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	c := wsConnectWithSubprocotol(srv.URL, "graphql-ws")
+	defer c.Close()
+
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+	assert.Equal(t, connectionAckMsg, readOp(c).Type)
+	assert.Equal(t, connectionKeepAliveMsg, readOp(c).Type)
+	require.NoError(t, c.WriteJSON(&operationMessage{
+		Type:    startMsg,
+		ID:      "test_1",
+		Payload: json.RawMessage(`{"query": "subscription { name }"}`),
+	}))
+
+	stats := <-live
+	assert.Equal(t, "graphql-ws", stats.Subprotocol)
+	assert.Equal(t, int64(2), stats.MessagesRead)
+	assert.Equal(t, int64(2), stats.MessagesWritten)
+	assert.Equal(t, int64(1), stats.KeepAlivesSent)
+	assert.Equal(t, int64(1), stats.Subscriptions)
+	assert.Equal(t, 1, stats.ActiveSubscriptions)
+
+	h.SendNextSubscriptionMessage()
+	assert.Equal(t, dataMsg, readOp(c).Type)
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: stopMsg, ID: "test_1"}))
+	assert.Equal(t, completeMsg, readOp(c).Type)
+	require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionTerminateMsg}))
+
+	select {
+	case stats := <-closed:
+		assert.Equal(t, int64(4), stats.MessagesRead)
+		assert.Equal(t, int64(4), stats.MessagesWritten)
+		assert.False(t, stats.ConnectedAt.IsZero())
+	case <-time.After(time.Second):
+		assert.Fail(t, "the stats func was not called in time")
+	}
+}
+
+func TestWebsocketProtocols(t *testing.T) {
+	counts := &transport.WebsocketProtocolCounts{}
+	var legacy []string
+	h := testserver.New()
+	h.AddTransport(transport.Websocket{
+		ProtocolCounter: counts,
+		LegacyProtocolFunc: func(ctx context.Context) error {
+			legacy = append(legacy, "connected")
+			if len(legacy) > 1 {
+				return errors.New("graphql-ws is no longer supported")
+			}
+			return nil
+		},
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+
+	t.Run("legacy clients are told apart", func(t *testing.T) {
+		c := wsConnect(srv.URL)
+		defer c.Close()
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: connectionInitMsg}))
+		assert.Equal(t, connectionAckMsg, readOp(c).Type)
+
+		c = wsConnectWithSubprocotol(srv.URL, graphqltransportwsSubprotocol)
+		defer c.Close()
+		require.NoError(t, c.WriteJSON(&operationMessage{Type: graphqltransportwsConnectionInitMsg}))
+		assert.Equal(t, graphqltransportwsConnectionAckMsg, readOp(c).Type)
+
+		assert.Equal(t, map[string]int64{"": 1, graphqltransportwsSubprotocol: 1}, counts.Counts())
+		assert.Len(t, legacy, 1)
+	})
+
+	t.Run("legacy clients can be closed", func(t *testing.T) {
+		c := wsConnectWithSubprocotol(srv.URL, "graphql-ws")
+		defer c.Close()
+
+		_, _, err := c.ReadMessage()
+		var closeErr *websocket.CloseError
+		require.ErrorAs(t, err, &closeErr)
+		assert.Equal(t, transport.LegacyProtocolCloseCode, closeErr.Code)
+		assert.Equal(t, "graphql-ws is no longer supported", closeErr.Text)
+		assert.Equal(t, int64(1), counts.Counts()["graphql-ws"])
+	})
+}
+
 func TestWebsocketInitFunc(t *testing.T) {
 	t.Run("accept connection if WebsocketInitFunc is NOT provided", func(t *testing.T) {
 		h := testserver.New()
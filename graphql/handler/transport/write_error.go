@@ -0,0 +1,57 @@
+package transport
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// WriteErrorFunc is called once per request when writing the response fails, usually because the client went away.
+// written is the number of bytes of the response written before the failure.
+type WriteErrorFunc func(ctx context.Context, err error, written int64)
+
+// writeErrorResponseWriter reports the first failed write of a response to its WriteErrorFunc.
+type writeErrorResponseWriter struct {
+	http.ResponseWriter
+	ctx     context.Context
+	onError WriteErrorFunc
+	written int64
+	failed  bool
+}
+
+// WithWriteErrorFunc wraps w so that f is called with ctx when writing the response fails. Websocket writes don't go
+// through w, their failures are reported to Websocket.ErrorFunc instead.
+func WithWriteErrorFunc(ctx context.Context, w http.ResponseWriter, f WriteErrorFunc) http.ResponseWriter {
+	return &writeErrorResponseWriter{ResponseWriter: w, ctx: ctx, onError: f}
+}
+
+func (w *writeErrorResponseWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.written += int64(n)
+	if err != nil && !w.failed {
+		w.failed = true
+		w.onError(w.ctx, err, w.written)
+	}
+	return n, err
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *writeErrorResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *writeErrorResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *writeErrorResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("%T does not implement http.Hijacker", w.ResponseWriter)
+	}
+	return h.Hijack()
+}
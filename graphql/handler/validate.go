@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+// Validate reports configuration that is accepted but does not serve requests the way it looks like it would. Call it
+// at startup, after adding transports and extensions, to fail before the first request:
+//   - no transport is added, so every request fails;
+//   - a transport type or a named extension is added more than once, so only one of them is effective;
+//   - the schema declares @defer but no transport streams responses, so deferred fragments are dropped;
+//   - a complexity limit is used but the schema has no complexity functions, so every field costs 1.
+//
+// Extensions with invalid settings, such as AutomaticPersistedQuery without a Cache, already panic in Use.
+func (s *Server) Validate() error {
+	var errs []error
+
+	if len(s.transports) == 0 {
+		errs = append(errs, errors.New("no transports are added"))
+	}
+
+	transports := map[reflect.Type]bool{}
+	streaming := false
+	for _, t := range s.transports {
+		typ := reflect.TypeOf(t)
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if transports[typ] {
+			errs = append(errs, fmt.Errorf("transport %s is added more than once, only the first one serves requests", typ))
+		}
+		transports[typ] = true
+
+		switch typ {
+		case reflect.TypeOf(transport.SSE{}), reflect.TypeOf(transport.Websocket{}):
+			streaming = true
+		}
+	}
+
+	schema := s.es.Schema()
+	if schema.Directives["defer"] != nil && !streaming {
+		errs = append(errs, errors.New("the schema declares @defer but no transport streams responses, add transport.SSE or transport.Websocket"))
+	}
+
+	extensions := map[string]bool{}
+	for _, ext := range s.extensions {
+		name := ext.ExtensionName()
+		if extensions[name] {
+			errs = append(errs, fmt.Errorf("extension %s is used more than once", name))
+		}
+		extensions[name] = true
+
+		if _, ok := ext.(*extension.ComplexityLimit); ok && !hasComplexityFuncs(s.es, schema) {
+			errs = append(errs, errors.New("ComplexityLimit is used but no field has a complexity function, set them in the ComplexityRoot of the generated Config"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// hasComplexityFuncs reports whether es computes the complexity of any field of schema itself.
+func hasComplexityFuncs(es graphql.ExecutableSchema, schema *ast.Schema) bool {
+	for _, def := range schema.Types {
+		if def.BuiltIn || (def.Kind != ast.Object && def.Kind != ast.Interface) {
+			continue
+		}
+		for _, field := range def.Fields {
+			if complexityFuncSet(es, def.Name, field.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func complexityFuncSet(es graphql.ExecutableSchema, typeName, fieldName string) (set bool) {
+	defer func() {
+		// A complexity function failing on the missing arguments is still set.
+		if r := recover(); r != nil {
+			set = true
+		}
+	}()
+	_, set = es.Complexity(typeName, fieldName, 1, map[string]interface{}{})
+	return set
+}
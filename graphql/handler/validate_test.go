@@ -0,0 +1,51 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+)
+
+func TestValidate(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @defer(if: Boolean = true, label: String) on FRAGMENT_SPREAD | INLINE_FRAGMENT
+		type Query { name: String! }
+	`})
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(typeName, fieldName string, childComplexity int, args map[string]interface{}) (int, bool) {
+			return 0, false
+		},
+	}
+
+	t.Run("default server", func(t *testing.T) {
+		require.NoError(t, handler.NewDefaultServer(es).Validate())
+	})
+
+	t.Run("no transports", func(t *testing.T) {
+		require.EqualError(t, handler.New(es).Validate(), "no transports are added\n"+
+			"the schema declares @defer but no transport streams responses, add transport.SSE or transport.Websocket")
+	})
+
+	t.Run("conflicting setup", func(t *testing.T) {
+		srv := handler.New(es)
+		srv.AddTransport(transport.SSE{})
+		srv.AddTransport(&transport.POST{})
+		srv.AddTransport(transport.POST{})
+		srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New(10)})
+		srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New(10)})
+		srv.Use(extension.FixedComplexityLimit(10))
+
+		require.EqualError(t, srv.Validate(), "transport transport.POST is added more than once, only the first one serves requests\n"+
+			"extension AutomaticPersistedQuery is used more than once\n"+
+			"ComplexityLimit is used but no field has a complexity function, set them in the ComplexityRoot of the generated Config")
+	})
+}
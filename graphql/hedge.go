@@ -0,0 +1,114 @@
+package graphql
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// HedgeScheduler caps the number of hedged calls in flight across all operations, so hedging trims the tail latency
+// of slow calls without doubling the load of a dependency once it slows down as a whole. See Hedge.
+type HedgeScheduler struct {
+	slots chan struct{}
+
+	hedged atomic.Int64
+	won    atomic.Int64
+}
+
+// NewHedgeScheduler returns a HedgeScheduler running maxInFlight hedged calls at most.
+func NewHedgeScheduler(maxInFlight int) *HedgeScheduler {
+	return &HedgeScheduler{slots: make(chan struct{}, maxInFlight)}
+}
+
+// Hedged returns the number of hedged calls started.
+func (s *HedgeScheduler) Hedged() int64 {
+	return s.hedged.Load()
+}
+
+// Won returns the number of hedged calls that returned before the call they hedged.
+func (s *HedgeScheduler) Won() int64 {
+	return s.won.Load()
+}
+
+func (s *HedgeScheduler) acquire() bool {
+	select {
+	case s.slots <- struct{}{}:
+		s.hedged.Add(1)
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *HedgeScheduler) release() {
+	<-s.slots
+}
+
+type hedgeResult[T any] struct {
+	v        T
+	err      error
+	panicked interface{}
+	hedged   bool
+}
+
+// Hedge calls fn, and calls it a second time if it has not returned after delay, returning the first successful
+// result and cancelling the context of the other call. Hedged calls are only made for queries with a HedgeScheduler,
+// set by handler.Server.SetHedging, and only while it has a free slot, otherwise fn is called once. Only use it for
+// idempotent reads.
+func Hedge[T any](ctx context.Context, delay time.Duration, fn func(ctx context.Context) (T, error)) (T, error) {
+	var scheduler *HedgeScheduler
+	if HasOperationContext(ctx) {
+		if oc := GetOperationContext(ctx); oc.Hedging != nil && oc.Operation != nil && oc.Operation.Operation == ast.Query {
+			scheduler = oc.Hedging
+		}
+	}
+	if scheduler == nil {
+		return fn(ctx)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult[T], 2)
+	call := func(hedged bool) {
+		var r hedgeResult[T]
+		defer func() {
+			if p := recover(); p != nil {
+				r.panicked = p
+			}
+			r.hedged = hedged
+			results <- r
+		}()
+		r.v, r.err = fn(ctx)
+	}
+	go call(false)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	pending := 1
+	for {
+		select {
+		case r := <-results:
+			pending--
+			if r.panicked != nil {
+				panic(r.panicked)
+			}
+			if r.err == nil || pending == 0 {
+				if r.hedged && r.err == nil {
+					scheduler.won.Add(1)
+				}
+				return r.v, r.err
+			}
+		case <-timer.C:
+			if scheduler.acquire() {
+				pending++
+				go func() {
+					defer scheduler.release()
+					call(true)
+				}()
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestHedge(t *testing.T) {
+	hedgeContext := func(s *HedgeScheduler, op ast.Operation) context.Context {
+		return WithOperationContext(context.Background(), &OperationContext{
+			Operation: &ast.OperationDefinition{Operation: op},
+			Hedging:   s,
+		})
+	}
+
+	t.Run("returns the hedged call when the first one is slow", func(t *testing.T) {
+		s := NewHedgeScheduler(1)
+		var calls atomic.Int32
+		cancelled := make(chan struct{})
+		v, err := Hedge(hedgeContext(s, ast.Query), 5*time.Millisecond, func(ctx context.Context) (string, error) {
+			if calls.Add(1) == 1 {
+				<-ctx.Done()
+				close(cancelled)
+				return "", ctx.Err()
+			}
+			return "hedged", nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, "hedged", v)
+		<-cancelled
+		require.Equal(t, int64(1), s.Hedged())
+		require.Equal(t, int64(1), s.Won())
+	})
+
+	t.Run("does not hedge fast calls", func(t *testing.T) {
+		s := NewHedgeScheduler(1)
+		v, err := Hedge(hedgeContext(s, ast.Query), time.Second, func(ctx context.Context) (int, error) {
+			return 1, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, v)
+		require.Equal(t, int64(0), s.Hedged())
+	})
+
+	t.Run("waits for the other call when one fails", func(t *testing.T) {
+		s := NewHedgeScheduler(1)
+		var calls atomic.Int32
+		v, err := Hedge(hedgeContext(s, ast.Query), time.Millisecond, func(ctx context.Context) (int, error) {
+			if calls.Add(1) == 1 {
+				time.Sleep(10 * time.Millisecond)
+				return 0, errors.New("failed")
+			}
+			time.Sleep(20 * time.Millisecond)
+			return 2, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, v)
+	})
+
+	t.Run("calls once without a free slot", func(t *testing.T) {
+		s := NewHedgeScheduler(1)
+		require.True(t, s.acquire())
+		defer s.release()
+
+		var calls atomic.Int32
+		_, err := Hedge(hedgeContext(s, ast.Query), time.Millisecond, func(ctx context.Context) (int, error) {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return 0, nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("calls once outside of queries", func(t *testing.T) {
+		s := NewHedgeScheduler(1)
+		for _, ctx := range []context.Context{context.Background(), hedgeContext(s, ast.Mutation)} {
+			var calls atomic.Int32
+			_, err := Hedge(ctx, time.Millisecond, func(ctx context.Context) (int, error) {
+				calls.Add(1)
+				time.Sleep(5 * time.Millisecond)
+				return 0, nil
+			})
+			require.NoError(t, err)
+			require.Equal(t, int32(1), calls.Load())
+		}
+	})
+
+	t.Run("panics on the calling goroutine", func(t *testing.T) {
+		s := NewHedgeScheduler(1)
+		require.PanicsWithValue(t, "boom", func() {
+			_, _ = Hedge(hedgeContext(s, ast.Query), time.Second, func(ctx context.Context) (int, error) {
+				panic("boom")
+			})
+		})
+	})
+}
@@ -0,0 +1,34 @@
+package graphql
+
+import "context"
+
+// InitialPayload is returned by the resolvers of subscription fields with @initialPayload: a snapshot of the current
+// state sent as the first event, followed by the updates received from Updates. Resolvers read the state and subscribe
+// to the updates together, under the lock guarding the state, so no update is lost or sent twice between them, without
+// having to load the snapshot into the channel first.
+type InitialPayload[T any] struct {
+	Initial T
+	// Updates are sent after Initial until the channel is closed, a nil channel ends the subscription after Initial.
+	Updates <-chan T
+
+	sent bool
+}
+
+// Next returns Initial the first time it is called, then the values received from Updates. It returns false once
+// Updates is closed or ctx is done.
+func (p *InitialPayload[T]) Next(ctx context.Context) (T, bool) {
+	var zero T
+	if !p.sent {
+		p.sent = true
+		return p.Initial, true
+	}
+	if p.Updates == nil {
+		return zero, false
+	}
+	select {
+	case v, ok := <-p.Updates:
+		return v, ok
+	case <-ctx.Done():
+		return zero, false
+	}
+}
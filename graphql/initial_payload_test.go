@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitialPayload(t *testing.T) {
+	t.Run("sends the initial value before the updates", func(t *testing.T) {
+		updates := make(chan int, 2)
+		updates <- 2
+		updates <- 3
+		close(updates)
+		p := InitialPayload[int]{Initial: 1, Updates: updates}
+
+		for _, want := range []int{1, 2, 3} {
+			v, ok := p.Next(context.Background())
+			require.True(t, ok)
+			require.Equal(t, want, v)
+		}
+		_, ok := p.Next(context.Background())
+		require.False(t, ok)
+	})
+
+	t.Run("ends after the initial value without updates", func(t *testing.T) {
+		p := InitialPayload[string]{Initial: "snapshot"}
+		v, ok := p.Next(context.Background())
+		require.True(t, ok)
+		require.Equal(t, "snapshot", v)
+		_, ok = p.Next(context.Background())
+		require.False(t, ok)
+	})
+
+	t.Run("stops waiting for updates when the context is done", func(t *testing.T) {
+		p := InitialPayload[int]{Updates: make(chan int)}
+		ctx, cancel := context.WithCancel(context.Background())
+		_, ok := p.Next(ctx)
+		require.True(t, ok)
+		cancel()
+		_, ok = p.Next(ctx)
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,25 @@
+//go:build tinygo
+
+package graphql
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// BuildUnmarshalerMap returns an empty map: TinyGo can't call the unmarshal functions through reflection, so
+// UnmarshalInputFromContext isn't available in TinyGo builds.
+func BuildUnmarshalerMap(unmarshaler ...interface{}) map[reflect.Type]reflect.Value {
+	return nil
+}
+
+// WithUnmarshalerMap returns ctx unchanged in TinyGo builds.
+func WithUnmarshalerMap(ctx context.Context, maps map[reflect.Type]reflect.Value) context.Context {
+	return ctx
+}
+
+// UnmarshalInputFromContext always fails in TinyGo builds.
+func UnmarshalInputFromContext(ctx context.Context, raw, v interface{}) error {
+	return errors.New("graphql: unmarshaling input from the context is not supported by TinyGo builds")
+}
@@ -0,0 +1,33 @@
+package graphql
+
+import (
+	"context"
+	"log/slog"
+)
+
+type loggerCtxKey struct{}
+
+// WithLogger returns a context carrying the logger used for warnings raised while serving a request, such as recovered
+// panics and transport errors.
+func WithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, logger)
+}
+
+// GetLogger returns the logger of the request, or slog.Default() outside of one. Once the operation is known, records
+// carry its name in the operation attribute, and its id in request_id.
+func GetLogger(ctx context.Context) *slog.Logger {
+	logger, _ := ctx.Value(loggerCtxKey{}).(*slog.Logger)
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if HasOperationContext(ctx) {
+		rc := GetOperationContext(ctx)
+		if rc.RequestID != "" {
+			logger = logger.With(slog.String("request_id", rc.RequestID))
+		}
+		if rc.OperationName != "" {
+			logger = logger.With(slog.String("operation", rc.OperationName))
+		}
+	}
+	return logger
+}
@@ -1,9 +1,13 @@
 package graphql
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
+
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
 func MarshalMap(val map[string]interface{}) Marshaler {
@@ -22,3 +26,50 @@ func UnmarshalMap(v interface{}) (map[string]interface{}, error) {
 
 	return nil, fmt.Errorf("%T is not a map", v)
 }
+
+// MarshalMapOf writes m as a JSON object, marshaling its values with marshal. Keys are written in sorted order so the
+// output is stable.
+func MarshalMapOf[T any](ctx context.Context, sel ast.SelectionSet, m map[string]T, marshal func(context.Context, ast.SelectionSet, T) Marshaler) Marshaler {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	values := make([]Marshaler, len(keys))
+	for i, k := range keys {
+		values[i] = marshal(WithPathContext(ctx, NewPathWithField(k)), sel, m[k])
+	}
+
+	return WriterFunc(func(w io.Writer) {
+		w.Write(openBrace)
+		for i, k := range keys {
+			if i != 0 {
+				w.Write(comma)
+			}
+			writeQuotedString(w, k)
+			w.Write(colon)
+			values[i].MarshalGQL(w)
+		}
+		w.Write(closeBrace)
+	})
+}
+
+// UnmarshalMapOf coerces an input object to a map, unmarshaling its values with unmarshal. Errors are reported on the
+// path of the key holding the invalid value.
+func UnmarshalMapOf[T any](ctx context.Context, v interface{}, unmarshal func(context.Context, interface{}) (T, error)) (map[string]T, error) {
+	in, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%T is not a map", v)
+	}
+
+	res := make(map[string]T, len(in))
+	for k, value := range in {
+		ctx := WithPathContext(ctx, NewPathWithField(k))
+		var err error
+		if res[k], err = unmarshal(ctx, value); err != nil {
+			return nil, ErrorOnPath(ctx, err)
+		}
+	}
+	return res, nil
+}
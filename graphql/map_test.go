@@ -0,0 +1,41 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestMarshalMapOf(t *testing.T) {
+	marshalInt := func(ctx context.Context, sel ast.SelectionSet, v int) Marshaler {
+		return MarshalInt(v)
+	}
+
+	var buf bytes.Buffer
+	MarshalMapOf(context.Background(), nil, map[string]int{"b": 2, "a": 1, "c\"": 3}, marshalInt).MarshalGQL(&buf)
+	require.Equal(t, `{"a":1,"b":2,"c\"":3}`, buf.String())
+
+	buf.Reset()
+	MarshalMapOf(context.Background(), nil, map[string]int{}, marshalInt).MarshalGQL(&buf)
+	require.Equal(t, `{}`, buf.String())
+}
+
+func TestUnmarshalMapOf(t *testing.T) {
+	unmarshalInt := func(ctx context.Context, v interface{}) (int, error) {
+		return UnmarshalInt(v)
+	}
+	ctx := WithPathContext(context.Background(), NewPathWithField("stock"))
+
+	res, err := UnmarshalMapOf(ctx, map[string]interface{}{"a": 1, "b": "2"}, unmarshalInt)
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"a": 1, "b": 2}, res)
+
+	_, err = UnmarshalMapOf(ctx, map[string]interface{}{"a": true}, unmarshalInt)
+	require.EqualError(t, err, "input: stock.a bool is not an int")
+
+	_, err = UnmarshalMapOf(ctx, []interface{}{1}, unmarshalInt)
+	require.EqualError(t, err, "[]interface {} is not a map")
+}
@@ -0,0 +1,69 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// MaskedValue replaces the values of @masked fields, and of @encrypted fields that can't be encrypted.
+const MaskedValue = "****"
+
+// FieldMasking decides which viewers read the values of fields with the @masked and @encrypted directives, see
+// extension.Masking. Every value is masked for operations without one.
+type FieldMasking struct {
+	// Allow reports whether the viewer of ctx reads the values of fields masked for reason, or encrypted with the key
+	// named reason. Nobody does when nil.
+	Allow func(ctx context.Context, reason string) bool
+
+	// Encrypt returns value encrypted with the key named key, for viewers not allowed to read @encrypted fields. Values
+	// are masked instead when nil.
+	Encrypt func(ctx context.Context, key string, value string) (string, error)
+}
+
+func (m *FieldMasking) allows(ctx context.Context, reason string) bool {
+	return m != nil && m.Allow != nil && m.Allow(ctx, reason)
+}
+
+func getFieldMasking(ctx context.Context) *FieldMasking {
+	if !HasOperationContext(ctx) {
+		return nil
+	}
+	return GetOperationContext(ctx).Masking
+}
+
+// MaskField returns value, or MaskedValue when the viewer of ctx isn't allowed to read values masked for reason. Null
+// values are not masked. Generated code calls it for fields with @masked.
+func MaskField(ctx context.Context, reason string, value Marshaler) Marshaler {
+	if value == Null || getFieldMasking(ctx).allows(ctx, reason) {
+		return value
+	}
+	return MarshalString(MaskedValue)
+}
+
+// EncryptField returns value, or value encrypted with the key named key when the viewer of ctx isn't allowed to read
+// it. Values that can't be encrypted are masked, with the error of the encryption added to the response. Null values
+// are not encrypted. Generated code calls it for fields with @encrypted.
+func EncryptField(ctx context.Context, key string, value Marshaler) Marshaler {
+	masking := getFieldMasking(ctx)
+	if value == Null || masking.allows(ctx, key) {
+		return value
+	}
+	if masking == nil || masking.Encrypt == nil {
+		return MarshalString(MaskedValue)
+	}
+
+	var buf bytes.Buffer
+	value.MarshalGQL(&buf)
+	var plain string
+	if err := json.Unmarshal(buf.Bytes(), &plain); err != nil {
+		AddError(ctx, err)
+		return MarshalString(MaskedValue)
+	}
+	encrypted, err := masking.Encrypt(ctx, key, plain)
+	if err != nil {
+		AddError(ctx, err)
+		return MarshalString(MaskedValue)
+	}
+	return MarshalString(encrypted)
+}
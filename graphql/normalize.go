@@ -0,0 +1,222 @@
+package graphql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// NormalizeOperation returns the operation named operationName in doc, or its only operation when operationName is
+// empty, in a canonical form on a single line, for features that need to know whether two documents are the same
+// query:
+//   - literals are hidden: strings become "", numbers 0, lists [] and objects {}, while booleans, enums, nulls and
+//     variables are kept
+//   - aliases are dropped
+//   - fields, then fragment spreads, then inline fragments are sorted in each selection set, arguments and variables
+//     are sorted by name
+//   - only the fragments used by the operation are kept, sorted by name, after the operation
+//
+// An empty string is returned when the operation is not found.
+func NormalizeOperation(doc *ast.QueryDocument, operationName string) string {
+	op := doc.Operations.ForName(operationName)
+	if op == nil {
+		return ""
+	}
+
+	n := &normalizer{doc: doc, fragments: map[string]bool{}}
+	n.operation(op)
+
+	names := make([]string, 0, len(n.fragments))
+	for name := range n.fragments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		def := doc.Fragments.ForName(name)
+		n.buf.WriteString(" fragment ")
+		n.buf.WriteString(name)
+		n.buf.WriteString(" on ")
+		n.buf.WriteString(def.TypeCondition)
+		n.directives(def.Directives)
+		n.selectionSet(def.SelectionSet)
+	}
+	return n.buf.String()
+}
+
+// OperationSignature returns the sha256 of the normalized operation, in hex, see NormalizeOperation.
+func OperationSignature(doc *ast.QueryDocument, operationName string) string {
+	b := sha256.Sum256([]byte(NormalizeOperation(doc, operationName)))
+	return hex.EncodeToString(b[:])
+}
+
+type normalizer struct {
+	doc       *ast.QueryDocument
+	buf       strings.Builder
+	fragments map[string]bool
+}
+
+func (n *normalizer) operation(op *ast.OperationDefinition) {
+	n.buf.WriteString(string(op.Operation))
+	if op.Name != "" {
+		n.buf.WriteString(" ")
+		n.buf.WriteString(op.Name)
+	}
+	if len(op.VariableDefinitions) > 0 {
+		vars := make(ast.VariableDefinitionList, len(op.VariableDefinitions))
+		copy(vars, op.VariableDefinitions)
+		sort.SliceStable(vars, func(i, j int) bool {
+			return vars[i].Variable < vars[j].Variable
+		})
+		n.buf.WriteString("(")
+		for i, v := range vars {
+			if i > 0 {
+				n.buf.WriteString(",")
+			}
+			n.buf.WriteString("$")
+			n.buf.WriteString(v.Variable)
+			n.buf.WriteString(":")
+			n.buf.WriteString(v.Type.String())
+			if v.DefaultValue != nil {
+				n.buf.WriteString("=")
+				n.value(v.DefaultValue)
+			}
+			n.directives(v.Directives)
+		}
+		n.buf.WriteString(")")
+	}
+	n.directives(op.Directives)
+	n.selectionSet(op.SelectionSet)
+}
+
+// selectionOrder sorts fields before fragment spreads before inline fragments.
+func selectionOrder(sel ast.Selection) (int, string) {
+	switch sel := sel.(type) {
+	case *ast.Field:
+		return 0, sel.Name
+	case *ast.FragmentSpread:
+		return 1, sel.Name
+	case *ast.InlineFragment:
+		return 2, sel.TypeCondition
+	}
+	return 3, ""
+}
+
+func (n *normalizer) selectionSet(set ast.SelectionSet) {
+	if len(set) == 0 {
+		return
+	}
+	sorted := make(ast.SelectionSet, len(set))
+	copy(sorted, set)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		oi, ni := selectionOrder(sorted[i])
+		oj, nj := selectionOrder(sorted[j])
+		if oi != oj {
+			return oi < oj
+		}
+		return ni < nj
+	})
+
+	n.buf.WriteString("{")
+	for i, sel := range sorted {
+		if i > 0 {
+			n.buf.WriteString(" ")
+		}
+		switch sel := sel.(type) {
+		case *ast.Field:
+			n.buf.WriteString(sel.Name)
+			n.arguments(sel.Arguments)
+			n.directives(sel.Directives)
+			n.selectionSet(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			n.buf.WriteString("...")
+			n.buf.WriteString(sel.Name)
+			n.directives(sel.Directives)
+			n.spread(sel.Name)
+		case *ast.InlineFragment:
+			n.buf.WriteString("...")
+			if sel.TypeCondition != "" {
+				n.buf.WriteString("on ")
+				n.buf.WriteString(sel.TypeCondition)
+			}
+			n.directives(sel.Directives)
+			n.selectionSet(sel.SelectionSet)
+		}
+	}
+	n.buf.WriteString("}")
+}
+
+// spread marks the fragment name and the fragments it spreads as used.
+func (n *normalizer) spread(name string) {
+	if n.fragments[name] {
+		return
+	}
+	def := n.doc.Fragments.ForName(name)
+	if def == nil {
+		return
+	}
+	n.fragments[name] = true
+	var walk func(set ast.SelectionSet)
+	walk = func(set ast.SelectionSet) {
+		for _, sel := range set {
+			switch sel := sel.(type) {
+			case *ast.Field:
+				walk(sel.SelectionSet)
+			case *ast.FragmentSpread:
+				n.spread(sel.Name)
+			case *ast.InlineFragment:
+				walk(sel.SelectionSet)
+			}
+		}
+	}
+	walk(def.SelectionSet)
+}
+
+func (n *normalizer) arguments(args ast.ArgumentList) {
+	if len(args) == 0 {
+		return
+	}
+	sorted := make(ast.ArgumentList, len(args))
+	copy(sorted, args)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	n.buf.WriteString("(")
+	for i, arg := range sorted {
+		if i > 0 {
+			n.buf.WriteString(",")
+		}
+		n.buf.WriteString(arg.Name)
+		n.buf.WriteString(":")
+		n.value(arg.Value)
+	}
+	n.buf.WriteString(")")
+}
+
+func (n *normalizer) directives(list ast.DirectiveList) {
+	for _, d := range list {
+		n.buf.WriteString("@")
+		n.buf.WriteString(d.Name)
+		n.arguments(d.Arguments)
+	}
+}
+
+func (n *normalizer) value(v *ast.Value) {
+	switch v.Kind {
+	case ast.Variable:
+		n.buf.WriteString("$")
+		n.buf.WriteString(v.Raw)
+	case ast.IntValue, ast.FloatValue:
+		n.buf.WriteString("0")
+	case ast.StringValue, ast.BlockValue:
+		n.buf.WriteString(`""`)
+	case ast.ListValue:
+		n.buf.WriteString("[]")
+	case ast.ObjectValue:
+		n.buf.WriteString("{}")
+	default:
+		n.buf.WriteString(v.String())
+	}
+}
@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestNormalizeOperation(t *testing.T) {
+	normalize := func(query, operationName string) string {
+		doc, err := parser.ParseQuery(&ast.Source{Input: query})
+		require.NoError(t, err)
+		return NormalizeOperation(doc, operationName)
+	}
+
+	require.Equal(t,
+		`query Users($first:Int=0,$role:Role!){users(filter:{},first:$first,names:[],role:$role,tag:""){id ...UserFields ...on Admin{level}}} fragment Avatar on User{avatar(size:0)} fragment UserFields on User{name ...Avatar}`,
+		normalize(`
+			query Unused { other }
+			query Users($role: Role!, $first: Int = 10) {
+				users(tag: "a", role: $role, first: $first, names: ["a", "b"], filter: {active: true}) {
+					... on Admin { level }
+					...UserFields
+					userId: id
+				}
+			}
+			fragment UserFields on User { ...Avatar name }
+			fragment Avatar on User { avatar(size: 64) }
+			fragment NotUsed on User { id }
+		`, "Users"),
+	)
+
+	require.Equal(t,
+		`query{a(flag:true,kind:BIG,none:null)@include(if:$x) b}`,
+		normalize(`{ b a(none: null, kind: BIG, flag: true) @include(if: $x) }`, ""),
+	)
+
+	require.Equal(t, "", normalize(`query A { a }`, "B"))
+}
+
+func TestOperationSignature(t *testing.T) {
+	signature := func(query string) string {
+		doc, err := parser.ParseQuery(&ast.Source{Input: query})
+		require.NoError(t, err)
+		return OperationSignature(doc, "")
+	}
+
+	require.Equal(t, signature(`{ user(id: 1) { name id } }`), signature(`query {
+		user(id: 2) {
+			id
+			n: name
+		}
+	}`))
+	require.NotEqual(t, signature(`{ user(id: 1) { name id } }`), signature(`{ user(id: 1) { name } }`))
+	require.Len(t, signature(`{ a }`), 64)
+}
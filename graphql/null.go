@@ -0,0 +1,87 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// AddNullFieldError reports that the current field resolved to value, a nil the schema doesn't allow, unless an error
+// was already added at or below the field, explaining why it is null. The error has the path and location of the
+// field. With strict set, the resolver and the go type of value are also logged as a warning.
+//
+// It is called by generated code when nil_safety is enabled.
+func AddNullFieldError(ctx context.Context, value interface{}, strict bool) {
+	fc := GetFieldContext(ctx)
+	if fc != nil && hasErrorBelow(ctx, fc.Path()) {
+		return
+	}
+	if strict {
+		GetLogger(ctx).Warn("resolver returned null for a non-null field",
+			"resolver", resolverName(fc), "type", fmt.Sprintf("%T", value), "path", pathString(fc))
+	}
+	AddError(ctx, fieldError(fc, "must not be null"))
+}
+
+// AddUnexpectedTypeError reports that the current field resolved to value, which isn't of the expected go type. The
+// error has the path and location of the field. With strict set, the resolver is also logged as a warning.
+//
+// It is called by generated code when nil_safety is enabled, in place of panicking.
+func AddUnexpectedTypeError(ctx context.Context, value interface{}, expected string, strict bool) {
+	fc := GetFieldContext(ctx)
+	if strict {
+		GetLogger(ctx).Warn("resolver returned an unexpected type",
+			"resolver", resolverName(fc), "type", fmt.Sprintf("%T", value), "path", pathString(fc), "expected", expected)
+	}
+	AddError(ctx, fieldError(fc, fmt.Sprintf("unexpected type %T, expected %s", value, expected)))
+}
+
+func fieldError(fc *FieldContext, message string) *gqlerror.Error {
+	err := &gqlerror.Error{Message: message}
+	if f := namedField(fc); f != nil && f.Field.Field != nil && f.Field.Position != nil {
+		err.Locations = []gqlerror.Location{{Line: f.Field.Position.Line, Column: f.Field.Position.Column}}
+	}
+	return err
+}
+
+// namedField is the closest field context that belongs to a field rather than a list element.
+func namedField(fc *FieldContext) *FieldContext {
+	for ; fc != nil; fc = fc.Parent {
+		if fc.Index == nil {
+			return fc
+		}
+	}
+	return nil
+}
+
+func resolverName(fc *FieldContext) string {
+	f := namedField(fc)
+	if f == nil || f.Field.Field == nil {
+		return "resolver"
+	}
+	return f.Object + "." + f.Field.Name
+}
+
+func pathString(fc *FieldContext) string {
+	if fc == nil {
+		return ""
+	}
+	return fc.Path().String()
+}
+
+// hasErrorBelow reports whether an error was added at path or one of its children.
+func hasErrorBelow(ctx context.Context, path ast.Path) bool {
+	c := getResponseContext(ctx)
+
+	c.errorsMu.Lock()
+	defer c.errorsMu.Unlock()
+
+	for _, err := range c.errors {
+		if len(err.Path) >= len(path) && equalPath(err.Path[:len(path)], path) {
+			return true
+		}
+	}
+	return false
+}
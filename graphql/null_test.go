@@ -0,0 +1,82 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type nullTestUser struct{}
+
+func TestAddNullFieldError(t *testing.T) {
+	var log bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&log, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	newCtx := func() (context.Context, *FieldContext) {
+		ctx := WithResponseContext(WithLogger(context.Background(), logger), DefaultErrorPresenter, nil)
+		field := &FieldContext{
+			Object: "Query",
+			Field: CollectedField{Field: &ast.Field{
+				Alias:    "users",
+				Name:     "users",
+				Position: &ast.Position{Line: 2, Column: 3},
+			}},
+		}
+		index := 1
+		elem := &FieldContext{Parent: field, Index: &index}
+		return WithFieldContext(WithFieldContext(ctx, field), elem), elem
+	}
+
+	t.Run("positioned error", func(t *testing.T) {
+		log.Reset()
+		ctx, _ := newCtx()
+		AddNullFieldError(ctx, (*nullTestUser)(nil), false)
+
+		errs := GetErrors(ctx)
+		require.Len(t, errs, 1)
+		require.Equal(t, "must not be null", errs[0].Message)
+		require.Equal(t, ast.Path{ast.PathName("users"), ast.PathIndex(1)}, errs[0].Path)
+		require.Equal(t, []gqlerror.Location{{Line: 2, Column: 3}}, errs[0].Locations)
+		require.Empty(t, log.String())
+	})
+
+	t.Run("strict logs the resolver", func(t *testing.T) {
+		log.Reset()
+		ctx, _ := newCtx()
+		AddNullFieldError(ctx, (*nullTestUser)(nil), true)
+		require.Equal(t, `level=WARN msg="resolver returned null for a non-null field" resolver=Query.users type=*graphql.nullTestUser path=users[1]`+"\n", log.String())
+	})
+
+	t.Run("child errors explain the null", func(t *testing.T) {
+		ctx, elem := newCtx()
+		child := &FieldContext{Parent: elem, Field: CollectedField{Field: &ast.Field{Alias: "name"}}}
+		AddError(WithFieldContext(ctx, child), errors.New("boom"))
+		AddNullFieldError(ctx, nil, false)
+		require.Len(t, GetErrors(ctx), 1)
+	})
+
+	t.Run("unexpected type", func(t *testing.T) {
+		log.Reset()
+		ctx, _ := newCtx()
+		AddUnexpectedTypeError(ctx, "x", "*model.User", true)
+
+		errs := GetErrors(ctx)
+		require.Len(t, errs, 1)
+		require.Equal(t, "unexpected type string, expected *model.User", errs[0].Message)
+		require.Equal(t, []gqlerror.Location{{Line: 2, Column: 3}}, errs[0].Locations)
+		require.Equal(t, `level=WARN msg="resolver returned an unexpected type" resolver=Query.users type=string path=users[1] expected=*model.User`+"\n", log.String())
+	})
+}
@@ -0,0 +1,56 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// OperationInfo holds the name and type of the operation of a request as soon as it is parsed, for HTTP middlewares
+// that log, filter or rate limit per operation without parsing GraphQL themselves. Install it in the request context
+// with WithOperationInfo before calling the handler, and read it once the handler returns or from a hook running while
+// the response is written. For websockets it holds the last operation started.
+type OperationInfo struct {
+	mu   sync.Mutex
+	name string
+	typ  ast.Operation
+}
+
+type operationInfoCtxKey struct{}
+
+// WithOperationInfo returns a context carrying an empty OperationInfo, unless ctx already carries one.
+func WithOperationInfo(ctx context.Context) context.Context {
+	if GetOperationInfo(ctx) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, operationInfoCtxKey{}, &OperationInfo{})
+}
+
+// GetOperationInfo returns the OperationInfo of the request, or nil if none was installed.
+func GetOperationInfo(ctx context.Context) *OperationInfo {
+	info, _ := ctx.Value(operationInfoCtxKey{}).(*OperationInfo)
+	return info
+}
+
+// Set records the operation, it is called by the executor once the document is parsed.
+func (o *OperationInfo) Set(name string, typ ast.Operation) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.name = name
+	o.typ = typ
+}
+
+// Name returns the name of the operation, which is empty for anonymous operations.
+func (o *OperationInfo) Name() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.name
+}
+
+// Type returns the type of the operation, or an empty string while it isn't known.
+func (o *OperationInfo) Type() ast.Operation {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.typ
+}
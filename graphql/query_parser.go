@@ -0,0 +1,18 @@
+package graphql
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// QueryParser parses and validates the queries of operations, executor.DefaultQueryParser does it with gqlparser.
+// Replacing it allows experimental syntax or a hardened parser without changing the executor, which still sets the
+// error codes, caches the documents and checks variables.
+type QueryParser interface {
+	// ParseQuery parses query into a document, errors should have the locations of the query they are found at.
+	ParseQuery(query string) (*ast.QueryDocument, gqlerror.List)
+
+	// ValidateQuery validates a document parsed by ParseQuery against schema, filling in the definitions of its
+	// fields, directives and variables the executor relies on.
+	ValidateQuery(schema *ast.Schema, doc *ast.QueryDocument) gqlerror.List
+}
@@ -2,8 +2,6 @@ package graphql
 
 import (
 	"context"
-	"fmt"
-	"os"
 	"runtime/debug"
 
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -12,9 +10,7 @@ import (
 type RecoverFunc func(ctx context.Context, err interface{}) (userMessage error)
 
 func DefaultRecover(ctx context.Context, err interface{}) error {
-	fmt.Fprintln(os.Stderr, err)
-	fmt.Fprintln(os.Stderr)
-	debug.PrintStack()
+	GetLogger(ctx).Error("recovered from panic", "panic", err, "stack", string(debug.Stack()))
 
 	return gqlerror.Errorf("internal system error")
 }
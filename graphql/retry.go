@@ -0,0 +1,93 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+// RetryPolicy tells WithRetry how many times to call a function and how long to wait between calls.
+type RetryPolicy struct {
+	// Attempts is the number of calls at most, the first one included. Less than 2 disables retries.
+	Attempts int
+
+	// Backoff is the wait before the second call, doubled before each of the following ones.
+	Backoff time.Duration
+
+	// MaxBackoff caps the wait between calls, zero leaves it uncapped.
+	MaxBackoff time.Duration
+
+	// Retryable reports whether a call failing with err should be retried, DefaultRetryable when nil.
+	Retryable func(err error) bool
+}
+
+// WithRetry calls fn until it succeeds, returns an error the policy doesn't retry or has been called
+// policy.Attempts times, and returns its last result. The context being done stops the waits between calls, and the
+// last error is returned then. Generated code calls it for fields with @retry, only use it for idempotent work.
+func WithRetry[T any](ctx context.Context, policy RetryPolicy, fn func(ctx context.Context) (T, error)) (T, error) {
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = DefaultRetryable
+	}
+
+	backoff := policy.Backoff
+	for attempt := 1; ; attempt++ {
+		v, err := fn(ctx)
+		if err == nil || attempt >= policy.Attempts || !retryable(err) {
+			if p, ok := err.(permanentError); ok {
+				err = p.err
+			}
+			return v, err
+		}
+
+		if policy.MaxBackoff > 0 && backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+		if backoff > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return v, err
+			case <-timer.C:
+			}
+			backoff *= 2
+		} else if ctx.Err() != nil {
+			return v, err
+		}
+	}
+}
+
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying for DefaultRetryable. WithRetry returns err without the mark.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+// DefaultRetryable is the classification of errors of retry policies without one: errors are retried, unless they are
+// marked with Permanent, come from the context being canceled or timing out, or are *gqlerror.Error with a code
+// registered in errcode as not retryable.
+func DefaultRetryable(err error) bool {
+	if err == nil || errors.As(err, new(permanentError)) ||
+		errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		if _, ok := errcode.Lookup(errcode.Get(gqlErr)); ok {
+			return errcode.IsRetryable(gqlErr)
+		}
+	}
+	return true
+}
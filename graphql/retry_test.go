@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+)
+
+func TestWithRetry(t *testing.T) {
+	failing := func(failures int, err error) (func(ctx context.Context) (int, error), *int) {
+		calls := 0
+		return func(ctx context.Context) (int, error) {
+			calls++
+			if calls <= failures {
+				return 0, err
+			}
+			return calls, nil
+		}, &calls
+	}
+	unavailable := errors.New("unavailable")
+
+	t.Run("retries until success", func(t *testing.T) {
+		fn, calls := failing(2, unavailable)
+		v, err := WithRetry(context.Background(), RetryPolicy{Attempts: 3}, fn)
+		require.NoError(t, err)
+		require.Equal(t, 3, v)
+		require.Equal(t, 3, *calls)
+	})
+
+	t.Run("returns the last error after the attempts", func(t *testing.T) {
+		fn, calls := failing(5, unavailable)
+		_, err := WithRetry(context.Background(), RetryPolicy{Attempts: 3}, fn)
+		require.ErrorIs(t, err, unavailable)
+		require.Equal(t, 3, *calls)
+
+		fn, calls = failing(5, unavailable)
+		_, err = WithRetry(context.Background(), RetryPolicy{}, fn)
+		require.ErrorIs(t, err, unavailable)
+		require.Equal(t, 1, *calls)
+	})
+
+	t.Run("doubles the backoff up to the max", func(t *testing.T) {
+		var waits []time.Duration
+		last := time.Now()
+		calls := 0
+		_, err := WithRetry(context.Background(), RetryPolicy{Attempts: 5, Backoff: 10 * time.Millisecond, MaxBackoff: 20 * time.Millisecond}, func(ctx context.Context) (int, error) {
+			if calls++; calls > 1 {
+				waits = append(waits, time.Since(last))
+			}
+			last = time.Now()
+			return 0, unavailable
+		})
+		require.ErrorIs(t, err, unavailable)
+		require.Len(t, waits, 4)
+		for i, min := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 20 * time.Millisecond, 20 * time.Millisecond} {
+			require.GreaterOrEqual(t, waits[i], min)
+		}
+		// uncapped, the last wait would be 80ms
+		require.Less(t, waits[3], 70*time.Millisecond)
+	})
+
+	t.Run("stops waiting when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		fn, calls := failing(5, unavailable)
+		start := time.Now()
+		_, err := WithRetry(ctx, RetryPolicy{Attempts: 3, Backoff: time.Minute}, fn)
+		require.ErrorIs(t, err, unavailable)
+		require.Equal(t, 1, *calls)
+		require.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("returns permanent errors without the mark", func(t *testing.T) {
+		notFound := errors.New("not found")
+		fn, calls := failing(5, Permanent(notFound))
+		_, err := WithRetry(context.Background(), RetryPolicy{Attempts: 3}, fn)
+		require.Equal(t, notFound, err)
+		require.Equal(t, 1, *calls)
+	})
+
+	t.Run("uses the classification of the policy", func(t *testing.T) {
+		fn, calls := failing(5, unavailable)
+		_, err := WithRetry(context.Background(), RetryPolicy{
+			Attempts:  3,
+			Retryable: func(err error) bool { return !errors.Is(err, unavailable) },
+		}, fn)
+		require.ErrorIs(t, err, unavailable)
+		require.Equal(t, 1, *calls)
+	})
+}
+
+func TestDefaultRetryable(t *testing.T) {
+	require.True(t, DefaultRetryable(errors.New("unavailable")))
+	require.True(t, DefaultRetryable(gqlerror.Errorf("unavailable")))
+	require.False(t, DefaultRetryable(nil))
+	require.False(t, DefaultRetryable(Permanent(errors.New("not found"))))
+	require.False(t, DefaultRetryable(fmt.Errorf("loading: %w", Permanent(errors.New("not found")))))
+	require.False(t, DefaultRetryable(fmt.Errorf("loading: %w", context.Canceled)))
+	require.False(t, DefaultRetryable(context.DeadlineExceeded))
+
+	errcode.Register("TEST_RETRY_LATER", errcode.Metadata{Retryable: true})
+	errcode.Register("TEST_FORBIDDEN", errcode.Metadata{})
+	t.Cleanup(func() {
+		errcode.Unregister("TEST_RETRY_LATER")
+		errcode.Unregister("TEST_FORBIDDEN")
+	})
+	retryLater, forbidden := gqlerror.Errorf("retry later"), gqlerror.Errorf("forbidden")
+	errcode.Set(retryLater, "TEST_RETRY_LATER")
+	errcode.Set(forbidden, "TEST_FORBIDDEN")
+	require.True(t, DefaultRetryable(retryLater))
+	require.False(t, DefaultRetryable(forbidden))
+}
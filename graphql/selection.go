@@ -0,0 +1,101 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+const selectionFragment = "selection"
+
+// ParseSelection parses selection, a selection set such as "{ id owner { name } }" optionally followed by the
+// fragments it spreads, on the object type typeName of schema and validates it as part of an operation would be. An
+// empty selection selects every field of the type returning a scalar or enum that has no required arguments. The
+// returned document holds the fragments CollectFields needs to expand the returned selection set.
+func ParseSelection(schema *ast.Schema, typeName string, selection string) (*ast.QueryDocument, ast.SelectionSet, error) {
+	def := schema.Types[typeName]
+	if def == nil || def.Kind != ast.Object {
+		return nil, nil, fmt.Errorf("%s is not an object type of the schema", typeName)
+	}
+	if strings.TrimSpace(selection) == "" {
+		selection = leafSelection(schema, def)
+	}
+
+	doc, err := parser.ParseQuery(&ast.Source{
+		Name:  selectionFragment,
+		Input: "fragment " + selectionFragment + " on " + typeName + " " + selection,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(doc.Operations) > 0 {
+		return nil, nil, fmt.Errorf("selection can not contain operations")
+	}
+
+	var errs gqlerror.List
+	for _, err := range validator.Validate(schema, doc) {
+		// The selection itself is never spread, and neither are fragments only it spreads.
+		if err.Rule != "NoUnusedFragments" {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, nil, errs
+	}
+	for _, fragment := range doc.Fragments {
+		if hasDefer(fragment.SelectionSet) {
+			return nil, nil, fmt.Errorf("@defer is not supported in selections")
+		}
+	}
+
+	return doc, doc.Fragments.ForName(selectionFragment).SelectionSet, nil
+}
+
+func leafSelection(schema *ast.Schema, def *ast.Definition) string {
+	var b strings.Builder
+	b.WriteString("{")
+fields:
+	for _, field := range def.Fields {
+		if strings.HasPrefix(field.Name, "__") {
+			continue
+		}
+		if t := schema.Types[field.Type.Name()]; t == nil || (t.Kind != ast.Scalar && t.Kind != ast.Enum) {
+			continue
+		}
+		for _, arg := range field.Arguments {
+			if arg.Type.NonNull && arg.DefaultValue == nil {
+				continue fields
+			}
+		}
+		b.WriteString(" " + field.Name)
+	}
+	if b.Len() == 1 {
+		b.WriteString(" __typename")
+	}
+	b.WriteString(" }")
+	return b.String()
+}
+
+func hasDefer(sel ast.SelectionSet) bool {
+	for _, s := range sel {
+		switch s := s.(type) {
+		case *ast.Field:
+			if s.Directives.ForName("defer") != nil || hasDefer(s.SelectionSet) {
+				return true
+			}
+		case *ast.InlineFragment:
+			if s.Directives.ForName("defer") != nil || hasDefer(s.SelectionSet) {
+				return true
+			}
+		case *ast.FragmentSpread:
+			if s.Directives.ForName("defer") != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
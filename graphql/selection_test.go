@@ -0,0 +1,59 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestParseSelection(t *testing.T) {
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: `
+		directive @defer(if: Boolean = true, label: String) on FRAGMENT_SPREAD | INLINE_FRAGMENT
+		type Query { user: User }
+		type User {
+			id: ID!
+			name(upper: Boolean = false): String
+			avatar(size: Int!): String
+			friends: [User!]!
+		}
+	`})
+
+	fieldNames := func(sel ast.SelectionSet) []string {
+		var names []string
+		for _, s := range sel {
+			names = append(names, s.(*ast.Field).Name)
+		}
+		return names
+	}
+
+	t.Run("selection set", func(t *testing.T) {
+		doc, sel, err := ParseSelection(schema, "User", `{ id friends { ...F } } fragment F on User { name }`)
+		require.NoError(t, err)
+		require.Equal(t, []string{"id", "friends"}, fieldNames(sel))
+		require.NotNil(t, doc.Fragments.ForName("F"))
+		require.NotNil(t, sel[1].(*ast.Field).Definition)
+	})
+
+	t.Run("all leaf fields", func(t *testing.T) {
+		_, sel, err := ParseSelection(schema, "User", "")
+		require.NoError(t, err)
+		require.Equal(t, []string{"id", "name"}, fieldNames(sel))
+	})
+
+	t.Run("invalid selection", func(t *testing.T) {
+		_, _, err := ParseSelection(schema, "User", "{ email }")
+		require.ErrorContains(t, err, `Cannot query field "email" on type "User".`)
+	})
+
+	t.Run("defer", func(t *testing.T) {
+		_, _, err := ParseSelection(schema, "User", "{ ... @defer { id } }")
+		require.EqualError(t, err, "@defer is not supported in selections")
+	})
+
+	t.Run("not an object", func(t *testing.T) {
+		_, _, err := ParseSelection(schema, "String", "{ id }")
+		require.EqualError(t, err, "String is not an object type of the schema")
+	})
+}
@@ -40,3 +40,12 @@ func mustUnmarshalString(v interface{}) string {
 	}
 	return res
 }
+
+func TestUnsafeString(t *testing.T) {
+	b := []byte("he\tllo")
+	assert.Equal(t, "he\tllo", UnsafeString(b))
+	assert.Equal(t, "", UnsafeString(nil))
+	assert.Equal(t, b, UnsafeBytes("he\tllo"))
+	assert.Nil(t, UnsafeBytes(""))
+	assert.Equal(t, `"he\tllo"`, m2s(MarshalStringBytes(b)))
+}
@@ -0,0 +1,32 @@
+package graphql
+
+import (
+	"io"
+	"unsafe"
+)
+
+// UnsafeString returns a string sharing memory with b instead of copying it, which matters for multi megabyte text
+// fields. The caller must guarantee b is never modified for as long as the returned string, or anything derived from
+// it, is reachable. Never use it with pooled or reused buffers.
+func UnsafeString(b []byte) string {
+	if len(b) == 0 {
+		return ""
+	}
+	return unsafe.String(&b[0], len(b))
+}
+
+// UnsafeBytes returns the bytes backing s without copying them. The returned slice must never be modified.
+func UnsafeBytes(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	return unsafe.Slice(unsafe.StringData(s), len(s))
+}
+
+// MarshalStringBytes marshals b as a graphql String without first converting it to a string, so scalars backed by
+// []byte can be written to the response without an extra copy. b must not be modified until the response is written.
+func MarshalStringBytes(b []byte) Marshaler {
+	return WriterFunc(func(w io.Writer) {
+		writeQuotedString(w, UnsafeString(b))
+	})
+}
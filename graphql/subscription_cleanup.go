@@ -0,0 +1,49 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+)
+
+// SubscriptionCleanup calls the cleanup function returned by a subscription resolver, with subscription_cleanup set,
+// once the subscription ends: when its events run out or when its context is done, whichever comes first. It is never
+// called while the events are read, so it can close what they are read from.
+type SubscriptionCleanup struct {
+	mu   sync.Mutex
+	fn   func()
+	done bool
+	stop func() bool
+}
+
+// NewSubscriptionCleanup returns the SubscriptionCleanup of fn, which is called once ctx is done unless it ran before.
+func NewSubscriptionCleanup(ctx context.Context, fn func()) *SubscriptionCleanup {
+	c := &SubscriptionCleanup{fn: fn}
+	c.stop = context.AfterFunc(ctx, c.Run)
+	return c
+}
+
+// Read calls read unless the cleanup function already ran, and reports whether it did. The cleanup function waits for
+// read to return, which must stop waiting for events once the context of the subscription is done.
+func (c *SubscriptionCleanup) Read(read func()) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done {
+		return false
+	}
+	read()
+	return true
+}
+
+// Run calls the cleanup function, unless it already ran.
+func (c *SubscriptionCleanup) Run() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done {
+		return
+	}
+	c.done = true
+	c.stop()
+	if c.fn != nil {
+		c.fn()
+	}
+}
@@ -0,0 +1,55 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionCleanup(t *testing.T) {
+	t.Run("runs once", func(t *testing.T) {
+		calls := 0
+		c := NewSubscriptionCleanup(context.Background(), func() { calls++ })
+		require.True(t, c.Read(func() {}))
+		c.Run()
+		c.Run()
+		require.Equal(t, 1, calls)
+		require.False(t, c.Read(func() { t.Fatal("read after cleanup") }))
+	})
+
+	t.Run("runs when the context is done, after the read", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var order []string
+		cleaned := make(chan struct{})
+		c := NewSubscriptionCleanup(ctx, func() {
+			order = append(order, "cleanup")
+			close(cleaned)
+		})
+
+		events := make(chan int)
+		reading := make(chan struct{})
+		go c.Read(func() {
+			close(reading)
+			select {
+			case <-events:
+			case <-ctx.Done():
+				time.Sleep(10 * time.Millisecond)
+				order = append(order, "read")
+			}
+		})
+		<-reading
+		cancel()
+
+		<-cleaned
+		require.Equal(t, []string{"read", "cleanup"}, order)
+	})
+
+	t.Run("accepts a nil function", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		c := NewSubscriptionCleanup(ctx, nil)
+		cancel()
+		c.Run()
+	})
+}
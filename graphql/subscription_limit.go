@@ -0,0 +1,136 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SubscriptionLimit caps the number of events a single subscription delivers per second. Events produced while one is
+// waiting to be delivered replace it, or are merged into it by Reduce, so a producer faster than the limit neither
+// floods the client nor piles events up on the server.
+type SubscriptionLimit struct {
+	// Rate is the number of events delivered per second at most, zero disables the limit.
+	Rate float64
+	// Burst is the number of events delivered without waiting after a quiet period, 1 when zero.
+	Burst int
+	// Reduce merges next into the event waiting to be delivered and returns the event delivered instead. Without it,
+	// the waiting event is dropped for next.
+	Reduce func(waiting, next *Response) *Response
+}
+
+// LimitResponses returns a ResponseHandler delivering the responses of next at the rate of limit. Once it is first
+// called, next is read by a goroutine until it returns nil, which must happen once the context is done.
+func LimitResponses(next ResponseHandler, limit SubscriptionLimit) ResponseHandler {
+	if limit.Rate <= 0 {
+		return next
+	}
+	if limit.Burst <= 0 {
+		limit.Burst = 1
+	}
+	l := &limitedResponses{
+		limit:  limit,
+		next:   next,
+		ready:  make(chan struct{}, 1),
+		tokens: float64(limit.Burst),
+	}
+	return l.handle
+}
+
+type limitedResponses struct {
+	limit SubscriptionLimit
+	next  ResponseHandler
+	start sync.Once
+	ready chan struct{}
+
+	mu      sync.Mutex
+	waiting *Response
+	done    bool
+
+	// tokens and last are only used by handle, which is not called concurrently.
+	tokens float64
+	last   time.Time
+}
+
+func (l *limitedResponses) read(ctx context.Context) {
+	for {
+		resp := l.next(ctx)
+		l.mu.Lock()
+		switch {
+		case resp == nil:
+			l.done = true
+		case l.waiting != nil && l.limit.Reduce != nil:
+			l.waiting = l.limit.Reduce(l.waiting, resp)
+		default:
+			l.waiting = resp
+		}
+		l.mu.Unlock()
+
+		select {
+		case l.ready <- struct{}{}:
+		default:
+		}
+		if resp == nil {
+			return
+		}
+	}
+}
+
+func (l *limitedResponses) handle(ctx context.Context) *Response {
+	l.start.Do(func() { go l.read(ctx) })
+
+	for {
+		waiting, done := l.pending()
+		if waiting {
+			break
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-l.ready:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	if wait := l.take(); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	resp := l.waiting
+	l.waiting = nil
+	return resp
+}
+
+// pending reports whether an event is waiting to be delivered and whether the events ran out.
+func (l *limitedResponses) pending() (waiting, done bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.waiting != nil, l.done
+}
+
+// take takes a token from the bucket and returns how long to wait before it is available.
+func (l *limitedResponses) take() time.Duration {
+	now := time.Now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.limit.Rate
+		if burst := float64(l.limit.Burst); l.tokens > burst {
+			l.tokens = burst
+		}
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.limit.Rate * float64(time.Second))
+}
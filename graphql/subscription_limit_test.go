@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func limitTestEvents(values ...string) (ResponseHandler, chan string) {
+	events := make(chan string, 10)
+	for _, v := range values {
+		events <- v
+	}
+	return func(ctx context.Context) *Response {
+		select {
+		case v, ok := <-events:
+			if !ok {
+				return nil
+			}
+			return &Response{Data: json.RawMessage(v)}
+		case <-ctx.Done():
+			return nil
+		}
+	}, events
+}
+
+func TestLimitResponses(t *testing.T) {
+	t.Run("is disabled without a rate", func(t *testing.T) {
+		next, _ := limitTestEvents("1")
+		limited := LimitResponses(next, SubscriptionLimit{})
+		require.Equal(t, `1`, string(limited(context.Background()).Data))
+	})
+
+	t.Run("keeps the latest event", func(t *testing.T) {
+		next, events := limitTestEvents()
+		limited := LimitResponses(next, SubscriptionLimit{Rate: 20})
+		ctx := context.Background()
+
+		events <- "1"
+		require.Equal(t, `1`, string(limited(ctx).Data))
+
+		events <- "2"
+		events <- "3"
+		close(events)
+		require.Equal(t, `3`, string(limited(ctx).Data))
+		require.Nil(t, limited(ctx))
+	})
+
+	t.Run("merges events with the reducer", func(t *testing.T) {
+		next, events := limitTestEvents()
+		limited := LimitResponses(next, SubscriptionLimit{
+			Rate: 20,
+			Reduce: func(waiting, next *Response) *Response {
+				return &Response{Data: append(append(waiting.Data, ','), next.Data...)}
+			},
+		})
+		ctx := context.Background()
+
+		events <- "1"
+		require.Equal(t, `1`, string(limited(ctx).Data))
+
+		events <- "2"
+		events <- "3"
+		close(events)
+		require.Equal(t, `2,3`, string(limited(ctx).Data))
+		require.Nil(t, limited(ctx))
+	})
+
+	t.Run("paces events", func(t *testing.T) {
+		next, events := limitTestEvents()
+		limited := LimitResponses(next, SubscriptionLimit{Rate: 50, Burst: 2})
+		ctx := context.Background()
+
+		start := time.Now()
+		for _, v := range []string{"1", "2", "3"} {
+			events <- v
+			require.Equal(t, v, string(limited(ctx).Data))
+		}
+		require.GreaterOrEqual(t, time.Since(start), 15*time.Millisecond)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		next, _ := limitTestEvents()
+		limited := LimitResponses(next, SubscriptionLimit{Rate: 1})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.Nil(t, limited(ctx))
+	})
+}
@@ -21,7 +21,7 @@ func MarshalUpload(f Upload) Marshaler {
 func UnmarshalUpload(v interface{}) (Upload, error) {
 	upload, ok := v.(Upload)
 	if !ok {
-		return Upload{}, fmt.Errorf("%T is not an Upload", v)
+		return Upload{}, fmt.Errorf("%T is not an Upload, uploads require a multipart request", v)
 	}
 	return upload, nil
 }
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Warning is a non-fatal notice about the response, such as a clamped input or a deprecated field. Warnings are
+// returned in the "warnings" response extension, leaving the data and errors of the response untouched.
+type Warning struct {
+	Message    string                 `json:"message"`
+	Path       ast.Path               `json:"path,omitempty"`
+	Code       string                 `json:"code,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// WarningPresenterFunc rewrites warnings before they are added to the response, like ErrorPresenterFunc does for
+// errors. Returning nil drops the warning.
+type WarningPresenterFunc func(ctx context.Context, w *Warning) *Warning
+
+const warningPresenterCtx key = "warning_presenter"
+
+// WithWarningPresenter sets the presenter warnings added with ctx go through.
+func WithWarningPresenter(ctx context.Context, f WarningPresenterFunc) context.Context {
+	return context.WithValue(ctx, warningPresenterCtx, f)
+}
+
+// AddWarning adds a warning to the "warnings" response extension, first passing it through the warning presenter.
+// Use GetPath(ctx) as the path of warnings about the current field, or nil for warnings about the whole operation.
+func AddWarning(ctx context.Context, path ast.Path, message string, code string) {
+	w := &Warning{Message: message, Path: path, Code: code}
+	if presenter, ok := ctx.Value(warningPresenterCtx).(WarningPresenterFunc); ok && presenter != nil {
+		if w = presenter(ctx, w); w == nil {
+			return
+		}
+	}
+
+	c := getResponseContext(ctx)
+	c.extensionsMu.Lock()
+	defer c.extensionsMu.Unlock()
+
+	if c.extensions == nil {
+		c.extensions = make(map[string]interface{})
+	}
+	warnings, _ := c.extensions["warnings"].([]interface{})
+	c.extensions["warnings"] = append(warnings, w)
+}
+
+// GetWarnings returns the warnings added in the current response context.
+func GetWarnings(ctx context.Context) []*Warning {
+	c := getResponseContext(ctx)
+	c.extensionsMu.Lock()
+	defer c.extensionsMu.Unlock()
+
+	var res []*Warning
+	warnings, _ := c.extensions["warnings"].([]interface{})
+	for _, w := range warnings {
+		if w, ok := w.(*Warning); ok {
+			res = append(res, w)
+		}
+	}
+	return res
+}
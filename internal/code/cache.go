@@ -0,0 +1,286 @@
+package code
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/gcexportdata"
+	"golang.org/x/tools/go/packages"
+)
+
+// cacheFormat changes whenever the files of the persistent cache change, or what they hold does.
+const cacheFormat = "gqlgen-packages-2"
+
+// DefaultCacheDir returns the directory WithPersistentCache is given by the gqlgen command, gqlgen in the user cache
+// directory, ~/.cache/gqlgen on linux. It is empty when the user has no cache directory.
+func DefaultCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "gqlgen")
+}
+
+// WithPersistentCache keeps the types of the packages of modules in the module cache in dir between runs, so they
+// are type checked once rather than on every generate. Only those packages are kept, they can't change without their
+// version changing. Packages of the main module, of a go.work workspace and of modules replaced by a directory are
+// always loaded from source. The packages read from dir have no Syntax or TypesInfo, LoadWithTypes loads them from
+// source again.
+func WithPersistentCache(dir string) func(p *Packages) {
+	return func(p *Packages) {
+		p.cacheDir = dir
+	}
+}
+
+// loadCached returns the packages of importPaths found in the persistent cache. Packages that other packages of
+// importPaths import, directly or not, are left to be loaded from source along with them, so that they all share the
+// same types.
+func (p *Packages) loadCached(importPaths []string) map[string]*packages.Package {
+	if !p.hasCached() {
+		return nil
+	}
+	pkgs, err := p.loadGraph(importPaths)
+	if err != nil {
+		return nil
+	}
+
+	candidates := map[string]*packages.Package{}
+	for _, pkg := range pkgs {
+		if key := p.cacheKey(pkg); key != "" {
+			if _, err := os.Stat(filepath.Join(p.cacheDir, key)); err == nil {
+				candidates[pkg.PkgPath] = pkg
+			}
+		}
+	}
+	seen := map[string]bool{}
+	var dropImports func(pkg *packages.Package)
+	dropImports = func(pkg *packages.Package) {
+		for _, imp := range pkg.Imports {
+			if seen[imp.PkgPath] {
+				continue
+			}
+			seen[imp.PkgPath] = true
+			delete(candidates, imp.PkgPath)
+			dropImports(imp)
+		}
+	}
+	for _, pkg := range pkgs {
+		if candidates[pkg.PkgPath] == nil {
+			dropImports(pkg)
+		}
+	}
+
+	res := map[string]*packages.Package{}
+	for _, pkg := range candidates {
+		path := filepath.Join(p.cacheDir, p.cacheKey(pkg))
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		// mark the file as used, see PruneCache
+		now := time.Now()
+		_ = os.Chtimes(path, now, now)
+		if p.fset == nil {
+			p.fset = token.NewFileSet()
+			p.imports = map[string]*types.Package{}
+		}
+		typesPkg, err := gcexportdata.Read(bufio.NewReader(f), p.fset, p.imports, pkg.PkgPath)
+		_ = f.Close()
+		if err != nil {
+			continue
+		}
+		res[NormalizeVendor(pkg.PkgPath)] = &packages.Package{
+			ID:      pkg.ID,
+			Name:    pkg.Name,
+			PkgPath: pkg.PkgPath,
+			Module:  pkg.Module,
+			Types:   typesPkg,
+			Fset:    p.fset,
+		}
+		p.numCacheHits++
+	}
+	return res
+}
+
+// loadGraph loads the names, modules and imports of importPaths and of the packages they import, which the keys of
+// the persistent cache are made of, and keeps them in p.graph.
+func (p *Packages) loadGraph(importPaths []string) ([]*packages.Package, error) {
+	pkgs, err := packages.Load(&packages.Config{
+		Mode:       packages.NeedName | packages.NeedModule | packages.NeedImports | packages.NeedDeps,
+		BuildFlags: p.buildFlags,
+	}, importPaths...)
+	if err != nil {
+		return nil, err
+	}
+	if p.graph == nil {
+		p.graph = map[string]*packages.Package{}
+	}
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		p.graph[pkg.PkgPath] = pkg
+	})
+	return pkgs, nil
+}
+
+// storeAllCached writes the types of the packages of pkgs that can be kept to the persistent cache.
+func (p *Packages) storeAllCached(pkgs []*packages.Package) {
+	var missing []string
+	for _, pkg := range pkgs {
+		if immutable(pkg) && p.graph[pkg.PkgPath] == nil {
+			missing = append(missing, pkg.PkgPath)
+		}
+	}
+	if len(missing) > 0 {
+		if _, err := p.loadGraph(missing); err != nil {
+			return
+		}
+	}
+	for _, pkg := range pkgs {
+		p.storeCached(pkg)
+	}
+}
+
+// hasCached reports whether the persistent cache holds any package, so that a cold cache doesn't cost the load of
+// loadCached.
+func (p *Packages) hasCached() bool {
+	dir, err := os.Open(p.cacheDir)
+	if err != nil {
+		return false
+	}
+	defer dir.Close()
+	names, _ := dir.Readdirnames(1)
+	return len(names) > 0
+}
+
+// storeCached writes the types of pkg to the persistent cache, when it can be kept there.
+func (p *Packages) storeCached(pkg *packages.Package) {
+	key := p.cacheKey(pkg)
+	if key == "" || pkg.Types == nil || len(pkg.Errors) > 0 || pkg.IllTyped {
+		return
+	}
+	if err := os.MkdirAll(p.cacheDir, 0o755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(p.cacheDir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	w := bufio.NewWriter(tmp)
+	err = gcexportdata.Write(w, pkg.Fset, pkg.Types)
+	if err == nil {
+		err = w.Flush()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		// renaming is atomic, so concurrent runs never read a partial file
+		err = os.Rename(tmp.Name(), filepath.Join(p.cacheDir, key))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// cacheKey returns the name of the file caching pkg, or an empty string for packages that can't be cached. The export
+// data of pkg holds the types of the packages it imports, so the key covers the versions of their modules too, and
+// packages importing a package that can't be cached can't be cached either.
+func (p *Packages) cacheKey(pkg *packages.Package) string {
+	if p.cacheDir == "" || pkg.Module == nil || !immutable(pkg) {
+		return ""
+	}
+	deps, ok := p.dependencies(pkg)
+	if !ok {
+		return ""
+	}
+	h := sha256.New()
+	for _, s := range append([]string{
+		cacheFormat,
+		runtime.Version(),
+		runtime.GOOS,
+		runtime.GOARCH,
+		os.Getenv("GOOS"),
+		os.Getenv("GOARCH"),
+		strings.Join(p.buildFlags, " "),
+		moduleVersion(pkg),
+		pkg.PkgPath,
+	}, deps...) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// dependencies returns the packages pkg imports, directly or not, with the versions of their modules, from p.graph.
+// Packages of the standard library are left out, runtime.Version covers them. It reports false when pkg is not in the
+// graph or imports a package that can't be cached.
+func (p *Packages) dependencies(pkg *packages.Package) ([]string, bool) {
+	node := p.graph[pkg.PkgPath]
+	if node == nil {
+		return nil, false
+	}
+	var deps []string
+	seen := map[string]bool{}
+	var walk func(pkg *packages.Package) bool
+	walk = func(pkg *packages.Package) bool {
+		for _, imp := range pkg.Imports {
+			if seen[imp.PkgPath] {
+				continue
+			}
+			seen[imp.PkgPath] = true
+			if imp.Module != nil {
+				if !immutable(imp) {
+					return false
+				}
+				deps = append(deps, imp.PkgPath+" "+moduleVersion(imp))
+			}
+			if !walk(imp) {
+				return false
+			}
+		}
+		return true
+	}
+	if !walk(node) {
+		return nil, false
+	}
+	sort.Strings(deps)
+	return deps, true
+}
+
+// moduleVersion returns the path and version of the module pkg is loaded from.
+func moduleVersion(pkg *packages.Package) string {
+	m := pkg.Module
+	if m.Replace != nil {
+		m = m.Replace
+	}
+	return m.Path + "@" + m.Version
+}
+
+// PruneCache removes the files of the cache in dir that were not used for maxAge. Reading a file from the cache marks
+// it as used by its modification time.
+func PruneCache(dir string, maxAge time.Duration) error {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		info, err := e.Info()
+		if err != nil || e.IsDir() || time.Since(info.ModTime()) < maxAge {
+			continue
+		}
+		if err := os.Remove(filepath.Join(dir, e.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package code
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"go/types"
+	"io"
+	"sort"
+)
+
+// Fingerprint returns a hash of the declarations of the packages loaded so far and of every named type they reach,
+// such as the fields of a struct embedded from a package that isn't loaded. It changes whenever the types gqlgen binds
+// to may have, but not when only function bodies or comments do.
+func (p *Packages) Fingerprint() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	paths := make([]string, 0, len(p.packages))
+	for path, pkg := range p.packages {
+		if pkg != nil && pkg.Types != nil {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	h := sha256.New()
+	w := &typeWriter{w: h, seen: map[*types.TypeName]bool{}}
+	for _, path := range paths {
+		scope := p.packages[path].Types.Scope()
+		w.writeString(path)
+		for _, name := range scope.Names() {
+			w.writeObject(scope.Lookup(name))
+		}
+	}
+	for len(w.queue) > 0 {
+		named := w.queue[0]
+		w.queue = w.queue[1:]
+		w.writeObject(named.Obj())
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// typeWriter writes declarations with their full package paths, queueing the named types they refer to.
+type typeWriter struct {
+	w     io.Writer
+	seen  map[*types.TypeName]bool
+	queue []*types.Named
+}
+
+func (w *typeWriter) writeString(s string) {
+	_, _ = io.WriteString(w.w, s)
+	_, _ = w.w.Write([]byte{0})
+}
+
+func (w *typeWriter) writeObject(obj types.Object) {
+	w.writeString(types.ObjectString(obj, nil))
+	tn, ok := obj.(*types.TypeName)
+	if !ok {
+		w.visit(obj.Type())
+		return
+	}
+	w.seen[tn] = true
+	named, ok := tn.Type().(*types.Named)
+	if !ok || named.Obj() != tn {
+		w.visit(tn.Type())
+		return
+	}
+	w.visit(named.Underlying())
+	methods := make([]*types.Func, named.NumMethods())
+	for i := range methods {
+		methods[i] = named.Method(i)
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].Name() < methods[j].Name() })
+	for _, m := range methods {
+		w.writeObject(m)
+	}
+}
+
+// visit queues the named types t refers to that were not written yet.
+func (w *typeWriter) visit(t types.Type) {
+	switch t := t.(type) {
+	case *types.Named:
+		if !w.seen[t.Obj()] {
+			w.seen[t.Obj()] = true
+			w.queue = append(w.queue, t.Origin())
+		}
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			w.visit(t.TypeArgs().At(i))
+		}
+	case *types.Pointer:
+		w.visit(t.Elem())
+	case *types.Slice:
+		w.visit(t.Elem())
+	case *types.Array:
+		w.visit(t.Elem())
+	case *types.Map:
+		w.visit(t.Key())
+		w.visit(t.Elem())
+	case *types.Chan:
+		w.visit(t.Elem())
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			w.visit(t.Field(i).Type())
+		}
+	case *types.Signature:
+		for i := 0; i < t.Params().Len(); i++ {
+			w.visit(t.Params().At(i).Type())
+		}
+		for i := 0; i < t.Results().Len(); i++ {
+			w.visit(t.Results().At(i).Type())
+		}
+	case *types.Interface:
+		for i := 0; i < t.NumMethods(); i++ {
+			w.visit(t.Method(i).Type())
+		}
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			w.visit(t.EmbeddedType(i))
+		}
+	case *types.Union:
+		for i := 0; i < t.Len(); i++ {
+			w.visit(t.Term(i).Type())
+		}
+	}
+}
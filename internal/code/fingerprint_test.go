@@ -0,0 +1,20 @@
+package code
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFingerprint(t *testing.T) {
+	fingerprint := initialState(t).Fingerprint()
+	require.Equal(t, fingerprint, initialState(t).Fingerprint(), "loading the same packages again doesn't change it")
+
+	p := initialState(t)
+	p.Load("github.com/99designs/gqlgen/internal/code/testdata/c")
+	require.NotEqual(t, fingerprint, p.Fingerprint())
+
+	p = initialState(t)
+	p.Evict("github.com/99designs/gqlgen/internal/code/testdata/b")
+	require.NotEqual(t, fingerprint, p.Fingerprint())
+}
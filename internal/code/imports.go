@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 var gopaths []string
@@ -53,7 +54,10 @@ type goModuleSearchResult struct {
 	moduleName string
 }
 
-var goModuleRootCache = map[string]goModuleSearchResult{}
+var (
+	goModuleRootMu    sync.Mutex
+	goModuleRootCache = map[string]goModuleSearchResult{}
+)
 
 // goModuleRoot returns the root of the current go module if there is a go.mod file in the directory tree
 // If not, it returns false
@@ -64,6 +68,9 @@ func goModuleRoot(dir string) (string, bool) {
 	}
 	dir = filepath.ToSlash(dir)
 
+	goModuleRootMu.Lock()
+	defer goModuleRootMu.Unlock()
+
 	dirs := []string{dir}
 	result := goModuleSearchResult{}
 
@@ -4,21 +4,17 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"go/token"
+	"go/types"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"runtime/debug"
 	"strings"
 	"sync"
 
 	"golang.org/x/tools/go/packages"
 )
 
-var (
-	once    = sync.Once{}
-	modInfo *debug.BuildInfo
-)
-
 var mode = packages.NeedName |
 	packages.NeedFiles |
 	packages.NeedTypes |
@@ -28,15 +24,27 @@ var mode = packages.NeedName |
 
 type (
 	// Packages is a wrapper around x/tools/go/packages that maintains a (hopefully prewarmed) cache of packages
-	// that can be invalidated as writes are made and packages are known to change.
+	// that can be invalidated as writes are made and packages are known to change. It is safe for concurrent use.
 	Packages struct {
+		mu           sync.Mutex
 		packages     map[string]*packages.Package
 		importToName map[string]string
 		loadErrors   []error
 		buildFlags   []string
 
+		workspaceOnce sync.Once
+		workspace     string
+
+		// the persistent cache, see WithPersistentCache
+		cacheDir string
+		fset     *token.FileSet
+		imports  map[string]*types.Package
+		// the imports of the packages considered for the persistent cache, by path
+		graph map[string]*packages.Package
+
 		numLoadCalls int // stupid test steam. ignore.
 		numNameCalls int // stupid test steam. ignore.
+		numCacheHits int
 	}
 	// Option is a function that can be passed to NewPackages to configure the package loader
 	Option func(p *Packages)
@@ -59,43 +67,67 @@ func NewPackages(opts ...Option) *Packages {
 	return p
 }
 
+// CleanupUserPackages evicts the packages that may have changed since they were loaded, keeping only those of gqlgen
+// itself when it is a dependency in the module cache. Packages of the main module, of every module of the go.work
+// workspace and of modules replaced by a local directory are evicted, along with the other dependencies so that they
+// are loaded again with the packages importing them.
 func (p *Packages) CleanupUserPackages() {
-	once.Do(func() {
-		var ok bool
-		modInfo, ok = debug.ReadBuildInfo()
-		if !ok {
-			modInfo = nil
-		}
-	})
-	// Don't cleanup github.com/99designs/gqlgen prefixed packages,
-	// they haven't changed and do not need to be reloaded
-	if modInfo != nil {
-		var toRemove []string
-		for k := range p.packages {
-			if !strings.HasPrefix(k, modInfo.Main.Path) {
-				toRemove = append(toRemove, k)
-			}
-		}
-		for _, k := range toRemove {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cleanupUserPackages()
+}
+
+func (p *Packages) cleanupUserPackages() {
+	for k, pkg := range p.packages {
+		if !keepOnReload(pkg) {
 			delete(p.packages, k)
 		}
-	} else {
-		p.packages = nil // Cleanup all packages if we don't know for some reason which ones to keep
 	}
 }
 
+const gqlgenModule = "github.com/99designs/gqlgen"
+
+// keepOnReload reports whether pkg is kept by CleanupUserPackages. Other immutable packages can't change either, but
+// packages.Load type checks the imports of the packages it loads again, so a dependency kept from an earlier load has
+// types that aren't identical to those the reloaded packages refer to, and binding them with types.AssignableTo
+// would fail. The types of gqlgen are only ever matched by name, so its packages are safe to keep.
+func keepOnReload(pkg *packages.Package) bool {
+	return immutable(pkg) && pkg.Module.Path == gqlgenModule
+}
+
+// immutable reports whether pkg belongs to a module of the module cache, which can't change without its version
+// changing.
+func immutable(pkg *packages.Package) bool {
+	if pkg == nil || pkg.Module == nil || pkg.Module.Main {
+		return false
+	}
+	m := pkg.Module
+	if m.Replace != nil {
+		m = m.Replace
+	}
+	return m.Version != ""
+}
+
 // ReloadAll will call LoadAll after clearing the package cache, so we can reload
 // packages in the case that the packages have changed
 func (p *Packages) ReloadAll(importPaths ...string) []*packages.Package {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	if p.packages != nil {
-		p.CleanupUserPackages()
+		p.cleanupUserPackages()
 	}
-	return p.LoadAll(importPaths...)
+	return p.loadAll(importPaths...)
 }
 
 // LoadAll will call packages.Load and return the package data for the given packages,
 // but if the package already have been loaded it will return cached values instead.
 func (p *Packages) LoadAll(importPaths ...string) []*packages.Package {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.loadAll(importPaths...)
+}
+
+func (p *Packages) loadAll(importPaths ...string) []*packages.Package {
 	if p.packages == nil {
 		p.packages = map[string]*packages.Package{}
 	}
@@ -108,6 +140,19 @@ func (p *Packages) LoadAll(importPaths ...string) []*packages.Package {
 		missing = append(missing, path)
 	}
 
+	if len(missing) > 0 && p.cacheDir != "" {
+		cached := p.loadCached(missing)
+		uncached := missing[:0]
+		for _, path := range missing {
+			if pkg, ok := cached[NormalizeVendor(path)]; ok {
+				p.addToCache(pkg)
+				continue
+			}
+			uncached = append(uncached, path)
+		}
+		missing = uncached
+	}
+
 	if len(missing) > 0 {
 		p.numLoadCalls++
 		pkgs, err := packages.Load(&packages.Config{
@@ -121,6 +166,9 @@ func (p *Packages) LoadAll(importPaths ...string) []*packages.Package {
 		for _, pkg := range pkgs {
 			p.addToCache(pkg)
 		}
+		if p.cacheDir != "" {
+			p.storeAllCached(pkgs)
+		}
 	}
 
 	res := make([]*packages.Package, 0, len(importPaths))
@@ -137,6 +185,12 @@ func (p *Packages) addToCache(pkg *packages.Package) {
 
 // Load works the same as LoadAll, except a single package at a time.
 func (p *Packages) Load(importPath string) *packages.Package {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.load(importPath)
+}
+
+func (p *Packages) load(importPath string) *packages.Package {
 	// Quick cache check first to avoid expensive allocations of LoadAll()
 	if p.packages != nil {
 		if pkg, ok := p.packages[importPath]; ok {
@@ -144,7 +198,7 @@ func (p *Packages) Load(importPath string) *packages.Package {
 		}
 	}
 
-	pkgs := p.LoadAll(importPath)
+	pkgs := p.loadAll(importPath)
 	if len(pkgs) == 0 {
 		return nil
 	}
@@ -154,7 +208,9 @@ func (p *Packages) Load(importPath string) *packages.Package {
 // LoadWithTypes tries a standard load, which may not have enough type info (TypesInfo== nil) available if the imported package is a
 // second order dependency. Fortunately this doesnt happen very often, so we can just issue a load when we detect it.
 func (p *Packages) LoadWithTypes(importPath string) *packages.Package {
-	pkg := p.Load(importPath)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pkg := p.load(importPath)
 	if pkg == nil || pkg.TypesInfo == nil {
 		p.numLoadCalls++
 		pkgs, err := packages.Load(&packages.Config{
@@ -176,6 +232,8 @@ func (p *Packages) NameForPackage(importPath string) string {
 	if importPath == "" {
 		panic(errors.New("import path can not be empty"))
 	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	if p.importToName == nil {
 		p.importToName = map[string]string{}
 	}
@@ -213,17 +271,50 @@ func (p *Packages) NameForPackage(importPath string) string {
 	return pkg.Name
 }
 
+// Module returns the module of the package at importPath, from the packages loaded so far. It is nil for packages of
+// the standard library and packages that are not loaded.
+func (p *Packages) Module(importPath string) *packages.Module {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pkg := p.packages[NormalizeVendor(importPath)]; pkg != nil {
+		return pkg.Module
+	}
+	return nil
+}
+
+// Workspace returns the go.work file the packages are loaded with, or an empty string outside of workspaces.
+func (p *Packages) Workspace() string {
+	p.workspaceOnce.Do(func() {
+		out, err := exec.Command("go", "env", "GOWORK").Output()
+		if err != nil {
+			return
+		}
+		if work := strings.TrimSpace(string(out)); work != "off" {
+			p.workspace = work
+		}
+	})
+	return p.workspace
+}
+
 // Evict removes a given package import path from the cache. Further calls to Load will fetch it from disk.
 func (p *Packages) Evict(importPath string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	delete(p.packages, importPath)
 }
 
 func (p *Packages) ModTidy() error {
+	p.mu.Lock()
 	p.packages = nil
+	p.mu.Unlock()
 	tidyCmd := exec.Command("go", "mod", "tidy")
 	tidyCmd.Stdout = os.Stdout
 	tidyCmd.Stderr = os.Stdout
 	if err := tidyCmd.Run(); err != nil {
+		if work := p.Workspace(); work != "" {
+			// go mod tidy ignores go.work, so requirements only available in the workspace can't be resolved
+			return fmt.Errorf("go mod tidy failed: %w, the module is in the workspace %s, set skip_mod_tidy if it requires modules only available there", err, work)
+		}
 		return fmt.Errorf("go mod tidy failed: %w", err)
 	}
 	return nil
@@ -231,6 +322,8 @@ func (p *Packages) ModTidy() error {
 
 // Errors returns any errors that were returned by Load, either from the call itself or any of the loaded packages.
 func (p *Packages) Errors() PkgErrors {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	var res []error //nolint:prealloc
 	res = append(res, p.loadErrors...)
 	for _, pkg := range p.packages {
@@ -242,6 +335,8 @@ func (p *Packages) Errors() PkgErrors {
 }
 
 func (p *Packages) Count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	return len(p.packages)
 }
 
@@ -1,10 +1,12 @@
 package code
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
 )
 
 func TestPackages(t *testing.T) {
@@ -64,3 +66,108 @@ func initialState(t *testing.T, opts ...Option) *Packages {
 	require.Equal(t, "b", pkgs[1].Name)
 	return p
 }
+
+func TestReloadAll(t *testing.T) {
+	p := initialState(t)
+	pkgs := p.ReloadAll("github.com/99designs/gqlgen/internal/code/testdata/a")
+	require.Equal(t, 2, p.numLoadCalls)
+	require.Equal(t, "a", pkgs[0].Name)
+	require.Nil(t, p.packages["github.com/99designs/gqlgen/internal/code/testdata/b"])
+
+	for _, tc := range []struct {
+		name   string
+		module *packages.Module
+		keep   bool
+	}{
+		{"gqlgen dependency", &packages.Module{Path: "github.com/99designs/gqlgen", Version: "v0.17.45"}, true},
+		{"gqlgen main module", &packages.Module{Path: "github.com/99designs/gqlgen", Main: true}, false},
+		{"gqlgen replaced by a directory", &packages.Module{Path: "github.com/99designs/gqlgen", Version: "v0.17.45", Replace: &packages.Module{Path: "../gqlgen"}}, false},
+		{"other dependency", &packages.Module{Path: "github.com/stretchr/testify", Version: "v1.9.0"}, false},
+		{"workspace module", &packages.Module{Path: "example.com/models", Main: true}, false},
+		{"standard library", nil, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.keep, keepOnReload(&packages.Package{PkgPath: "example.com/pkg", Module: tc.module}))
+		})
+	}
+}
+
+func TestModule(t *testing.T) {
+	p := initialState(t)
+	p.LoadAll("strings", "github.com/stretchr/testify/require")
+
+	require.Equal(t, "github.com/99designs/gqlgen", p.Module("github.com/99designs/gqlgen/internal/code/testdata/a").Path)
+	require.True(t, p.Module("github.com/99designs/gqlgen/internal/code/testdata/a").Main)
+	require.Equal(t, "github.com/stretchr/testify", p.Module("github.com/stretchr/testify/require").Path)
+	require.False(t, p.Module("github.com/stretchr/testify/require").Main)
+	require.Nil(t, p.Module("strings"))
+	require.Nil(t, p.Module("github.com/99designs/gqlgen/internal/code/testdata/c"))
+}
+
+func TestPersistentCache(t *testing.T) {
+	dir := t.TempDir()
+	const (
+		assertPkg  = "github.com/stretchr/testify/assert"
+		requirePkg = "github.com/stretchr/testify/require"
+		mainPkg    = "github.com/99designs/gqlgen/internal/code/testdata/a"
+	)
+
+	p := NewPackages(WithPersistentCache(dir))
+	require.False(t, p.hasCached(), "an empty cache isn't looked up")
+	p.LoadAll(assertPkg, mainPkg)
+	require.Nil(t, p.Errors())
+	require.Equal(t, 0, p.numCacheHits)
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1, "only packages of the module cache are kept")
+	require.True(t, p.hasCached())
+
+	t.Run("later runs read the cache", func(t *testing.T) {
+		p := NewPackages(WithPersistentCache(dir))
+		pkgs := p.LoadAll(assertPkg, mainPkg)
+		require.Equal(t, 1, p.numCacheHits)
+		require.Equal(t, 1, p.numLoadCalls)
+
+		require.Equal(t, "assert", pkgs[0].Name)
+		require.Equal(t, "github.com/stretchr/testify", pkgs[0].Module.Path)
+		require.NotNil(t, pkgs[0].Types.Scope().Lookup("Equal"))
+		require.Nil(t, pkgs[0].TypesInfo)
+		require.Equal(t, "a", pkgs[1].Name)
+
+		pkg := p.LoadWithTypes(assertPkg)
+		require.Equal(t, 2, p.numLoadCalls, "packages read from the cache are loaded from source when their syntax is needed")
+		require.NotEmpty(t, pkg.TypesInfo.Defs)
+		require.NotNil(t, pkg.Syntax)
+	})
+
+	t.Run("packages imported by packages loaded from source are loaded with them", func(t *testing.T) {
+		p := NewPackages(WithPersistentCache(dir))
+		pkgs := p.LoadAll(assertPkg, requirePkg)
+		require.Equal(t, 0, p.numCacheHits)
+		require.NotNil(t, pkgs[0].Syntax)
+	})
+
+	t.Run("the key covers the modules of the imported packages", func(t *testing.T) {
+		p := NewPackages(WithPersistentCache(dir))
+		pkgs, err := p.loadGraph([]string{assertPkg})
+		require.NoError(t, err)
+		key := p.cacheKey(pkgs[0])
+		require.NotEmpty(t, key)
+
+		spew := p.graph["github.com/davecgh/go-spew/spew"]
+		require.NotNil(t, spew)
+		module := *spew.Module
+		module.Version = "v0.0.0"
+		spew.Module = &module
+		require.NotEqual(t, key, p.cacheKey(pkgs[0]))
+
+		module.Version = ""
+		require.Empty(t, p.cacheKey(pkgs[0]), "packages importing packages that can't be cached can't be cached")
+	})
+
+	t.Run("the cache can be left out", func(t *testing.T) {
+		p := NewPackages()
+		p.LoadAll(assertPkg)
+		require.Equal(t, 0, p.numCacheHits)
+	})
+}
@@ -0,0 +1,289 @@
+// Package configschema generates the JSON schema of gqlgen.yml from the source of the config package: the yaml tags of
+// the Config struct and the types it refers to, their doc comments, and the constants of string types as enums, in the
+// order they are declared.
+package configschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Schema is a JSON schema, draft-07.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	ID                   string             `json:"$id,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Deprecated           bool               `json:"deprecated,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties any                `json:"additionalProperties,omitempty"`
+	Definitions          map[string]*Schema `json:"definitions,omitempty"`
+}
+
+// ID is the URL the schema is published at.
+const ID = "https://raw.githubusercontent.com/99designs/gqlgen/master/gqlgen.schema.json"
+
+// Generate returns the JSON schema of the Config struct of the package in dir, indented and ending with a newline.
+func Generate(dir string) ([]byte, error) {
+	g, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+	root, err := g.structSchema("Config")
+	if err != nil {
+		return nil, err
+	}
+	root.Schema = "http://json-schema.org/draft-07/schema#"
+	root.ID = ID
+	root.Title = "gqlgen config"
+	root.Description = "The config of gqlgen, usually gqlgen.yml."
+	root.Definitions = g.definitions
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(root); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type generator struct {
+	types       map[string]*ast.TypeSpec
+	docs        map[string]*ast.CommentGroup
+	enums       map[string][]string
+	definitions map[string]*Schema
+}
+
+func load(dir string) (*generator, error) {
+	g := &generator{
+		types:       map[string]*ast.TypeSpec{},
+		docs:        map[string]*ast.CommentGroup{},
+		enums:       map[string][]string{},
+		definitions: map[string]*Schema{},
+	}
+	filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	for _, filename := range filenames {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					g.types[spec.Name.Name] = spec
+					g.docs[spec.Name.Name] = spec.Doc
+					if spec.Doc == nil && len(gen.Specs) == 1 {
+						g.docs[spec.Name.Name] = gen.Doc
+					}
+				case *ast.ValueSpec:
+					g.addEnumValues(gen.Tok, spec)
+				}
+			}
+		}
+	}
+	if g.types["Config"] == nil {
+		return nil, fmt.Errorf("no Config type in %s", dir)
+	}
+	return g, nil
+}
+
+// addEnumValues adds the non empty string constants of spec to the enum of their type.
+func (g *generator) addEnumValues(tok token.Token, spec *ast.ValueSpec) {
+	typ, ok := spec.Type.(*ast.Ident)
+	if tok != token.CONST || !ok {
+		return
+	}
+	for _, v := range spec.Values {
+		lit, ok := v.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		if s, err := strconv.Unquote(lit.Value); err == nil && s != "" {
+			g.enums[typ.Name] = append(g.enums[typ.Name], s)
+		}
+	}
+}
+
+// structSchema returns the schema of the struct type name, with the fields it inlines.
+func (g *generator) structSchema(name string) (*Schema, error) {
+	spec := g.types[name]
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a struct", name)
+	}
+	s := &Schema{
+		Type:                 "object",
+		Description:          description(g.docs[name]),
+		Properties:           map[string]*Schema{},
+		AdditionalProperties: false,
+	}
+	for _, field := range st.Fields.List {
+		var tag reflect.StructTag
+		if field.Tag != nil {
+			unquoted, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				return nil, err
+			}
+			tag = reflect.StructTag(unquoted)
+		}
+		key, opts, _ := strings.Cut(tag.Get("yaml"), ",")
+		if key == "-" {
+			continue
+		}
+		if strings.Contains(opts, "inline") {
+			ident, ok := field.Type.(*ast.Ident)
+			if !ok {
+				return nil, fmt.Errorf("%s inlines %T, only structs of the package are supported", name, field.Type)
+			}
+			inlined, err := g.structSchema(ident.Name)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range inlined.Properties {
+				s.Properties[k] = v
+			}
+			continue
+		}
+
+		for _, fieldName := range field.Names {
+			if !fieldName.IsExported() {
+				continue
+			}
+			k := key
+			if k == "" {
+				k = strings.ToLower(fieldName.Name)
+			}
+			prop, err := g.schema(field.Type)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", name, fieldName.Name, err)
+			}
+			doc := field.Doc
+			if doc == nil {
+				doc = field.Comment
+			}
+			if d := description(doc); d != "" {
+				if prop.Ref != "" {
+					// keywords next to $ref are ignored by draft-07
+					prop = &Schema{OneOf: []*Schema{prop}}
+				}
+				prop.Description = d
+				prop.Deprecated = strings.Contains(d, "Deprecated:")
+			}
+			s.Properties[k] = prop
+		}
+	}
+	return s, nil
+}
+
+// schema returns the schema of the values of the go type expr.
+func (g *generator) schema(expr ast.Expr) (*Schema, error) {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return g.schema(expr.X)
+	case *ast.ArrayType:
+		items, err := g.schema(expr.Elt)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case *ast.MapType:
+		values, err := g.schema(expr.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case *ast.InterfaceType, *ast.SelectorExpr:
+		return &Schema{}, nil
+	case *ast.Ident:
+		return g.namedSchema(expr.Name)
+	default:
+		return nil, fmt.Errorf("unsupported type %T", expr)
+	}
+}
+
+func (g *generator) namedSchema(name string) (*Schema, error) {
+	switch name {
+	case "string":
+		return &Schema{Type: "string"}, nil
+	case "bool":
+		return &Schema{Type: "boolean"}, nil
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return &Schema{Type: "integer"}, nil
+	case "float32", "float64":
+		return &Schema{Type: "number"}, nil
+	case "any":
+		return &Schema{}, nil
+	case "StringList":
+		// StringList reads a single string as a list of one
+		return &Schema{OneOf: []*Schema{{Type: "string"}, {Type: "array", Items: &Schema{Type: "string"}}}}, nil
+	}
+
+	spec, ok := g.types[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s", name)
+	}
+	if _, ok := spec.Type.(*ast.StructType); ok {
+		if _, defined := g.definitions[name]; !defined {
+			g.definitions[name] = nil // breaks cycles
+			def, err := g.structSchema(name)
+			if err != nil {
+				return nil, err
+			}
+			g.definitions[name] = def
+		}
+		return &Schema{Ref: "#/definitions/" + name}, nil
+	}
+
+	s, err := g.schema(spec.Type)
+	if err != nil {
+		return nil, err
+	}
+	if values := g.enums[name]; len(values) > 0 && s.Type == "string" {
+		s.Enum = append([]string(nil), values...)
+	}
+	return s, nil
+}
+
+// description returns the text of doc, unwrapping the lines of paragraphs.
+func description(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	paragraphs := strings.Split(strings.TrimSpace(doc.Text()), "\n\n")
+	for i, p := range paragraphs {
+		paragraphs[i] = strings.Join(strings.Fields(p), " ")
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
@@ -0,0 +1,26 @@
+package configschema
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	b, err := Generate("../../codegen/config")
+	require.NoError(t, err)
+
+	existing, err := os.ReadFile("../../gqlgen.schema.json")
+	require.NoError(t, err)
+	require.Equal(t, string(existing), string(b), "gqlgen.schema.json is out of date, run go generate ./codegen/config")
+
+	var s Schema
+	require.NoError(t, json.Unmarshal(b, &s))
+	require.Equal(t, false, s.AdditionalProperties)
+	require.Equal(t, "#/definitions/ExecConfig", s.Properties["exec"].Ref)
+	require.Contains(t, s.Definitions, "TypeMapEntry")
+	require.Equal(t, []string{"error", "null", "map"}, s.Definitions["EnumUnknownValue"].Properties["action"].Enum)
+	require.True(t, s.Properties["federated"].Deprecated)
+}
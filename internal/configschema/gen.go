@@ -0,0 +1,26 @@
+//go:build ignore
+
+// gen writes the JSON schema of the config package in -dir to -out, run by go generate in codegen/config.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/99designs/gqlgen/internal/configschema"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the config package")
+	out := flag.String("out", "gqlgen.schema.json", "file to write the schema to")
+	flag.Parse()
+
+	b, err := configschema.Generate(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, b, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
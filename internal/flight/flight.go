@@ -0,0 +1,45 @@
+// Package flight runs one call of a function at a time per key, sharing its result with the calls made meanwhile.
+package flight
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Group runs calls by key, the zero value is ready to use.
+type Group struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+type call struct {
+	done  chan struct{}
+	value interface{}
+	err   error
+}
+
+// Do calls fn and returns its result, unless a call for key is running, in which case it waits for it and returns its
+// result instead. Calls waiting for a call that panics return an error.
+func (g *Group) Do(key string, fn func() (interface{}, error)) (interface{}, error) {
+	g.mu.Lock()
+	if c, ok := g.calls[key]; ok {
+		g.mu.Unlock()
+		<-c.done
+		return c.value, c.err
+	}
+	if g.calls == nil {
+		g.calls = map[string]*call{}
+	}
+	c := &call{done: make(chan struct{}), err: fmt.Errorf("resolving %s panicked", key)}
+	g.calls[key] = c
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(c.done)
+	}()
+	c.value, c.err = fn()
+	return c.value, c.err
+}
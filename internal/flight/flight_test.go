@@ -0,0 +1,68 @@
+package flight
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDo(t *testing.T) {
+	t.Run("shares running calls", func(t *testing.T) {
+		var g Group
+		var calls atomic.Int32
+		release := make(chan struct{})
+		fn := func() (interface{}, error) {
+			calls.Add(1)
+			<-release
+			return "value", nil
+		}
+
+		var wg sync.WaitGroup
+		values := make([]interface{}, 3)
+		for i := range values {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				values[i], _ = g.Do("key", fn)
+			}(i)
+		}
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		require.EqualValues(t, 1, calls.Load())
+		require.Equal(t, []interface{}{"value", "value", "value"}, values)
+
+		_, err := g.Do("key", func() (interface{}, error) { return nil, nil })
+		require.NoError(t, err)
+		require.Empty(t, g.calls)
+	})
+
+	t.Run("fails waiting calls when the call panics", func(t *testing.T) {
+		var g Group
+		started := make(chan struct{})
+		release := make(chan struct{})
+		go func() {
+			defer func() { _ = recover() }()
+			_, _ = g.Do("key", func() (interface{}, error) {
+				close(started)
+				<-release
+				panic("boom")
+			})
+		}()
+		<-started
+
+		errs := make(chan error)
+		go func() {
+			_, err := g.Do("key", func() (interface{}, error) { return nil, nil })
+			errs <- err
+		}()
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		require.EqualError(t, <-errs, "resolving key panicked")
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"go/printer"
 	"go/token"
 	"strings"
+	"sync"
 
 	"golang.org/x/tools/go/ast/astutil"
 	"golang.org/x/tools/imports"
@@ -16,6 +17,8 @@ import (
 	"github.com/99designs/gqlgen/internal/code"
 )
 
+var prefixMu sync.Mutex
+
 type visitFn func(node ast.Node)
 
 func (fn visitFn) Visit(node ast.Node) ast.Visitor {
@@ -23,8 +26,9 @@ func (fn visitFn) Visit(node ast.Node) ast.Visitor {
 	return fn
 }
 
-// Prune removes any unused imports
-func Prune(filename string, src []byte, packages *code.Packages) ([]byte, error) {
+// Prune removes any unused imports. Imports are grouped as goimports does, with the comma separated prefixes of
+// localPrefix grouped after third party imports.
+func Prune(filename string, src []byte, packages *code.Packages, localPrefix string) ([]byte, error) {
 	fset := token.NewFileSet()
 
 	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments|parser.AllErrors)
@@ -43,6 +47,10 @@ func Prune(filename string, src []byte, packages *code.Packages) ([]byte, error)
 		return nil, err
 	}
 
+	// x/tools/imports only reads the local prefix from this variable
+	prefixMu.Lock()
+	defer prefixMu.Unlock()
+	imports.LocalPrefix = localPrefix
 	return imports.Process(filename, buf.Bytes(), &imports.Options{FormatOnly: true, Comments: true, TabIndent: true, TabWidth: 8})
 }
 
@@ -13,11 +13,35 @@ import (
 func TestPrune(t *testing.T) {
 	// prime the packages cache so that it's not considered uninitialized
 
-	b, err := Prune("testdata/unused.go", mustReadFile("testdata/unused.go"), code.NewPackages())
+	b, err := Prune("testdata/unused.go", mustReadFile("testdata/unused.go"), code.NewPackages(), "")
 	require.NoError(t, err)
 	require.Equal(t, strings.ReplaceAll(string(mustReadFile("testdata/unused.expected.go")), "\r\n", "\n"), string(b))
 }
 
+func TestPruneLocalPrefix(t *testing.T) {
+	src := `package testdata
+
+import (
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"example.com/org/shared"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+var _ = fmt.Sprint(graphql.Null, shared.X, ast.Path{})
+`
+	b, err := Prune("testdata/local.go", []byte(src), code.NewPackages(), "example.com/org")
+	require.NoError(t, err)
+	require.Contains(t, string(b), `import (
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"example.com/org/shared"
+)`)
+}
+
 func mustReadFile(filename string) []byte {
 	b, err := os.ReadFile(filename)
 	if err != nil {
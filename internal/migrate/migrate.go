@@ -0,0 +1,345 @@
+// Package migrate reports the changes the code of a project needs after upgrading gqlgen: resolvers whose signature no
+// longer matches the resolver interfaces gqlgen generates, and references to runtime APIs that were removed or
+// deprecated.
+package migrate
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/templates"
+)
+
+const modulePath = "github.com/99designs/gqlgen"
+
+var mode = packages.NeedName |
+	packages.NeedFiles |
+	packages.NeedImports |
+	packages.NeedTypes |
+	packages.NeedSyntax |
+	packages.NeedTypesInfo
+
+// renamed lists the replacements of the runtime APIs gqlgen removed, by qualified name.
+var renamed = map[string]string{
+	modulePath + "/graphql.ResolverContext":    "use graphql.FieldContext",
+	modulePath + "/graphql.GetResolverContext": "use graphql.GetFieldContext",
+	modulePath + "/graphql.RequestContext":     "use graphql.OperationContext",
+	modulePath + "/graphql.GetRequestContext":  "use graphql.GetOperationContext",
+	modulePath + "/handler.GraphQL":            "use handler.New of " + modulePath + "/graphql/handler",
+	modulePath + "/handler.Playground":         "use playground.Handler of " + modulePath + "/graphql/playground",
+}
+
+// Change is a piece of code that doesn't match the version of gqlgen being run, with a suggested fix if there is one.
+type Change struct {
+	Position token.Position
+	Message  string
+	Fix      string
+}
+
+func (c Change) String() string {
+	if c.Fix == "" {
+		return fmt.Sprintf("%s: %s", c.Position, c.Message)
+	}
+	return fmt.Sprintf("%s: %s\n\t%s", c.Position, c.Message, c.Fix)
+}
+
+// Report is the result of Check, ordered by position.
+type Report []Change
+
+// Err returns an error summarising the report, or nil if no change is needed.
+func (r Report) Err() error {
+	if len(r) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d changes are needed to upgrade gqlgen", len(r))
+}
+
+// Check compares the resolvers gqlgen generates from data with their implementation in the resolver package, and looks
+// up the gqlgen APIs referenced by the packages matching patterns. Generated files are skipped, generating rewrites
+// them.
+func Check(data *codegen.Data, patterns ...string) (Report, error) {
+	var report Report
+	if data.Config.Resolver.IsDefined() {
+		report = append(report, checkResolvers(data)...)
+	}
+
+	var buildFlags []string
+	if len(data.Config.GoBuildTags) > 0 {
+		buildFlags = []string{"-tags", strings.Join(data.Config.GoBuildTags, ",")}
+	}
+	pkgs, err := packages.Load(&packages.Config{Mode: mode, BuildFlags: buildFlags}, patterns...)
+	if err != nil {
+		return nil, err
+	}
+	report = append(report, checkAPIs(pkgs)...)
+
+	sort.SliceStable(report, func(i, j int) bool {
+		a, b := report[i].Position, report[j].Position
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return report, nil
+}
+
+func checkResolvers(data *codegen.Data) Report {
+	cfg := data.Config
+	pkg := cfg.Packages.Load(cfg.Resolver.ImportPath())
+	if pkg == nil || pkg.Types == nil {
+		return nil
+	}
+
+	objects := append(append(codegen.Objects{}, data.Objects...), data.InterfaceResolvers()...)
+	objects = append(objects, data.Inputs...)
+
+	var report Report
+	for _, o := range objects {
+		// structs that don't exist yet are written by generate, with every method
+		structName := templates.LcFirst(o.Name) + templates.UcFirst(cfg.Resolver.Type)
+		impl := pkg.Types.Scope().Lookup(structName)
+		if impl == nil {
+			continue
+		}
+		for _, f := range o.Fields {
+			if !f.IsResolver || f.ResolvedOn != nil {
+				continue
+			}
+			want := resolverSignature(f, pkg.Types)
+			decl := fmt.Sprintf("func (r *%s) %s%s", structName, f.GoFieldName, want)
+
+			obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(impl.Type()), false, pkg.Types, f.GoFieldName)
+			m, ok := obj.(*types.Func)
+			if !ok {
+				report = append(report, Change{
+					Position: pkg.Fset.Position(impl.Pos()),
+					Message:  fmt.Sprintf("%s has no %s method to resolve %s.%s", structName, f.GoFieldName, o.Name, f.Name),
+					Fix:      "generate adds one panicking until it is implemented: " + decl,
+				})
+				continue
+			}
+			if want.matches(m.Type().(*types.Signature)) {
+				continue
+			}
+			report = append(report, Change{
+				Position: pkg.Fset.Position(m.Pos()),
+				Message:  fmt.Sprintf("the signature of %s.%s resolving %s.%s changed", structName, f.GoFieldName, o.Name, f.Name),
+				Fix:      "change it to " + decl,
+			})
+		}
+	}
+	return report
+}
+
+// signature is the signature of a resolver method, with types as written in the resolver package and fully qualified
+// to compare them with the implementation.
+type signature struct {
+	names        []string
+	params       []string
+	short        []string
+	results      []string
+	shortResults []string
+}
+
+func (s *signature) param(name string, t types.Type, qf types.Qualifier) {
+	s.names = append(s.names, name)
+	s.params = append(s.params, types.TypeString(t, nil))
+	s.short = append(s.short, types.TypeString(t, qf))
+}
+
+func resolverSignature(f *codegen.Field, pkg *types.Package) *signature {
+	short := func(p *types.Package) string {
+		if p.Path() == pkg.Path() {
+			return ""
+		}
+		return p.Name()
+	}
+
+	s := &signature{
+		names:  []string{"ctx"},
+		params: []string{"context.Context"},
+		short:  []string{"context.Context"},
+	}
+	if f.IsInputObject() {
+		s.param("obj", f.Object.Reference(), short)
+		s.param("data", f.TypeReference.GO, short)
+		s.results, s.shortResults = []string{"error"}, []string{"error"}
+		return s
+	}
+
+	if !f.Object.Root {
+		s.param("obj", f.Object.Reference(), short)
+	}
+	for _, arg := range f.Args {
+		s.param(arg.VarName, arg.TypeReference.GO, short)
+	}
+	result, shortResult := types.TypeString(f.TypeReference.GO, nil), types.TypeString(f.TypeReference.GO, short)
+	if f.InitialPayload {
+		result, shortResult = modulePath+"/graphql.InitialPayload["+result+"]", "graphql.InitialPayload["+shortResult+"]"
+	} else if f.Object.Stream {
+		result, shortResult = "<-chan "+result, "<-chan "+shortResult
+	}
+	s.results = []string{result, "error"}
+	s.shortResults = []string{shortResult, "error"}
+	if f.Cleanup {
+		s.results = []string{result, "func()", "error"}
+		s.shortResults = []string{shortResult, "func()", "error"}
+	}
+	return s
+}
+
+func (s *signature) matches(sig *types.Signature) bool {
+	return sameTypes(s.params, sig.Params()) && sameTypes(s.results, sig.Results())
+}
+
+func sameTypes(want []string, got *types.Tuple) bool {
+	if len(want) != got.Len() {
+		return false
+	}
+	for i, w := range want {
+		if types.TypeString(got.At(i).Type(), nil) != w {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *signature) String() string {
+	params := make([]string, len(s.names))
+	for i, name := range s.names {
+		params[i] = name + " " + s.short[i]
+	}
+	if len(s.shortResults) == 1 {
+		return fmt.Sprintf("(%s) %s", strings.Join(params, ", "), s.shortResults[0])
+	}
+	return fmt.Sprintf("(%s) (%s)", strings.Join(params, ", "), strings.Join(s.shortResults, ", "))
+}
+
+// checkAPIs reports the references of pkgs to identifiers of gqlgen packages that no longer exist or are deprecated.
+func checkAPIs(pkgs []*packages.Package) Report {
+	deprecated := map[string]map[string]string{}
+
+	var report Report
+	for _, pkg := range pkgs {
+		if pkg.TypesInfo == nil {
+			continue
+		}
+		for _, file := range pkg.Syntax {
+			if ast.IsGenerated(file) {
+				continue
+			}
+			ast.Inspect(file, func(n ast.Node) bool {
+				sel, ok := n.(*ast.SelectorExpr)
+				if !ok {
+					return true
+				}
+				ident, ok := sel.X.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				pkgName, ok := pkg.TypesInfo.Uses[ident].(*types.PkgName)
+				if !ok {
+					return true
+				}
+				imported := pkgName.Imported()
+				path := imported.Path()
+				if path != modulePath && !strings.HasPrefix(path, modulePath+"/") {
+					return true
+				}
+
+				name := pkgName.Name() + "." + sel.Sel.Name
+				pos := pkg.Fset.Position(sel.Pos())
+				if imported.Scope().Lookup(sel.Sel.Name) == nil {
+					if !imported.Complete() {
+						return true
+					}
+					report = append(report, Change{
+						Position: pos,
+						Message:  name + " was removed",
+						Fix:      renamed[path+"."+sel.Sel.Name],
+					})
+					return true
+				}
+
+				if _, ok := deprecated[path]; !ok {
+					deprecated[path] = deprecations(path)
+				}
+				if msg, ok := deprecated[path][sel.Sel.Name]; ok {
+					report = append(report, Change{Position: pos, Message: name + " is deprecated", Fix: msg})
+				}
+				return true
+			})
+		}
+	}
+	return report
+}
+
+// deprecations returns the deprecation notes of the exported package level identifiers of the package at path.
+func deprecations(path string) map[string]string {
+	res := map[string]string{}
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax}, path)
+	if err != nil || len(pkgs) == 0 {
+		return res
+	}
+
+	add := func(name *ast.Ident, docs ...*ast.CommentGroup) {
+		if !name.IsExported() {
+			return
+		}
+		for _, doc := range docs {
+			if note := deprecation(doc); note != "" {
+				res[name.Name] = note
+				return
+			}
+		}
+	}
+	for _, file := range pkgs[0].Syntax {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv == nil {
+					add(decl.Name, decl.Doc)
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						add(spec.Name, spec.Doc, decl.Doc)
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							add(name, spec.Doc, decl.Doc)
+						}
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+// deprecation returns the paragraph of doc starting with "Deprecated:", without it.
+func deprecation(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	text := doc.Text()
+	i := strings.Index(text, "Deprecated:")
+	if i < 0 {
+		return ""
+	}
+	text = text[i+len("Deprecated:"):]
+	if end := strings.Index(text, "\n\n"); end >= 0 {
+		text = text[:end]
+	}
+	return strings.Join(strings.Fields(text), " ")
+}
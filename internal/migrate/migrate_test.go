@@ -0,0 +1,33 @@
+package migrate
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestCheckAPIs(t *testing.T) {
+	pkgs, err := packages.Load(&packages.Config{Mode: mode}, "github.com/99designs/gqlgen/internal/migrate/testdata/api")
+	require.NoError(t, err)
+
+	report := checkAPIs(pkgs)
+	require.Len(t, report, 2)
+
+	require.Equal(t, "api.go", filepath.Base(report[0].Position.Filename))
+	require.Equal(t, 14, report[0].Position.Line)
+	require.Equal(t, "graphql.GetRequestContext is deprecated", report[0].Message)
+	require.Equal(t, "Please update all references to GetOperationContext instead", report[0].Fix)
+
+	require.Equal(t, 18, report[1].Position.Line)
+	require.Equal(t, "graphql.GetResolverTimings was removed", report[1].Message)
+	require.Empty(t, report[1].Fix)
+}
+
+func TestDeprecation(t *testing.T) {
+	require.Equal(t, "", deprecation(nil))
+	require.Equal(t, "Use GetFieldContext instead", deprecations("github.com/99designs/gqlgen/graphql")["GetResolverContext"])
+	require.Contains(t, deprecations("github.com/99designs/gqlgen/graphql"), "ResolverContext")
+	require.NotContains(t, deprecations("github.com/99designs/gqlgen/graphql"), "FieldContext")
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func Path(ctx context.Context) string {
+	return graphql.GetFieldContext(ctx).Path().String()
+}
+
+func Operation(ctx context.Context) string {
+	return graphql.GetRequestContext(ctx).OperationName
+}
+
+func Removed(ctx context.Context) {
+	graphql.GetResolverTimings(ctx)
+}
@@ -0,0 +1,66 @@
+// Package release looks up released versions of gqlgen on the Go module proxy.
+package release
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+const modulePath = "github.com/99designs/gqlgen"
+
+// Proxy returns the first module proxy listed in goproxy, formatted like the GOPROXY environment variable, or "" if it
+// only allows direct downloads or none.
+func Proxy(goproxy string) string {
+	if goproxy == "" {
+		return "https://proxy.golang.org"
+	}
+	for _, proxy := range strings.FieldsFunc(goproxy, func(r rune) bool { return r == ',' || r == '|' }) {
+		switch proxy = strings.TrimSpace(proxy); proxy {
+		case "direct", "off":
+			return ""
+		case "":
+		default:
+			return strings.TrimSuffix(proxy, "/")
+		}
+	}
+	return ""
+}
+
+// Latest returns the latest release of gqlgen known to proxy.
+func Latest(ctx context.Context, client *http.Client, proxy string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, proxy+"/"+modulePath+"/@latest", http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s responded with %s", req.URL, resp.Status)
+	}
+
+	var info struct {
+		Version string
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("decoding %s: %w", req.URL, err)
+	}
+	if !semver.IsValid(info.Version) {
+		return "", fmt.Errorf("%s responded with invalid version %q", req.URL, info.Version)
+	}
+	return info.Version, nil
+}
+
+// Newer reports whether latest is a newer version than current. Development builds of a version, like v0.17.47-dev,
+// are older than its release.
+func Newer(current, latest string) bool {
+	return semver.Compare(latest, current) > 0
+}
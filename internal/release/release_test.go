@@ -0,0 +1,43 @@
+package release
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxy(t *testing.T) {
+	require.Equal(t, "https://proxy.golang.org", Proxy(""))
+	require.Equal(t, "https://goproxy.example.com", Proxy("https://goproxy.example.com/,direct"))
+	require.Equal(t, "https://a.example.com", Proxy("https://a.example.com|https://b.example.com"))
+	require.Equal(t, "", Proxy("direct"))
+	require.Equal(t, "", Proxy("off"))
+}
+
+func TestLatest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/github.com/99designs/gqlgen/@latest" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"Version":"v0.17.49","Time":"2024-06-01T00:00:00Z"}`))
+	}))
+	defer srv.Close()
+
+	latest, err := Latest(context.Background(), srv.Client(), srv.URL)
+	require.NoError(t, err)
+	require.Equal(t, "v0.17.49", latest)
+
+	_, err = Latest(context.Background(), srv.Client(), srv.URL+"/missing")
+	require.ErrorContains(t, err, "404 Not Found")
+}
+
+func TestNewer(t *testing.T) {
+	require.True(t, Newer("v0.17.47-dev", "v0.17.47"))
+	require.True(t, Newer("v0.17.47", "v0.17.48"))
+	require.False(t, Newer("v0.17.48", "v0.17.48"))
+	require.False(t, Newer("v0.17.49-dev", "v0.17.48"))
+}
@@ -0,0 +1,388 @@
+// Package schemafmt prints schema files in a canonical layout, it backs the gqlgen fmt command.
+package schemafmt
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/lexer"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Options controls the layout of formatted schemas.
+type Options struct {
+	// Indent is used for each level of nesting, two spaces when empty.
+	Indent string
+	// Sort orders the definitions of a file: the schema first, then directive definitions, then types by name with
+	// extensions following the type they extend. Definitions are kept in source order otherwise.
+	Sort bool
+}
+
+// Format parses src with the same parser gqlgen loads schemas with and prints it back in a canonical layout:
+//   - one definition per block separated by a blank line, fields and enum values one per line
+//   - directives on the same line as the element they annotate
+//   - single line descriptions as strings and longer ones as block strings
+//   - arguments on one line, or one per line when any of them has a description or comment
+//
+// Comments are kept with the element they precede. A schema with a comment in a place the parser doesn't keep track
+// of, such as inside a directive argument, is returned as an error rather than formatted without it.
+func Format(src *ast.Source, opts Options) ([]byte, error) {
+	doc, err := parser.ParseSchema(src)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Indent == "" {
+		opts.Indent = "  "
+	}
+
+	p := &printer{indent: opts.Indent}
+	for i, b := range blocks(doc, opts.Sort) {
+		if i > 0 {
+			p.buf.WriteString("\n")
+		}
+		b.print(p)
+	}
+	if doc.Comment != nil && len(doc.Comment.List) > 0 {
+		if p.buf.Len() > 0 {
+			p.buf.WriteString("\n")
+		}
+		p.comments(doc.Comment)
+	}
+	out := p.buf.Bytes()
+
+	want, err := countComments(src.Input)
+	if err != nil {
+		return nil, err
+	}
+	if got, err := countComments(string(out)); err != nil {
+		return nil, err
+	} else if got != want {
+		return nil, fmt.Errorf("%s: contains comments in a position that can't be formatted, only comments before definitions, fields, arguments and enum values are supported", src.Name)
+	}
+	return out, nil
+}
+
+type block struct {
+	kind   int
+	name   string
+	extend bool
+	start  int
+	print  func(p *printer)
+}
+
+const (
+	schemaBlock = iota
+	directiveBlock
+	typeBlock
+)
+
+func blocks(doc *ast.SchemaDocument, sorted bool) []block {
+	var list []block
+	for _, def := range doc.Schema {
+		def := def
+		list = append(list, block{kind: schemaBlock, start: start(def.Position), print: func(p *printer) { p.schema(def, false) }})
+	}
+	for _, def := range doc.SchemaExtension {
+		def := def
+		list = append(list, block{kind: schemaBlock, extend: true, start: start(def.Position), print: func(p *printer) { p.schema(def, true) }})
+	}
+	for _, def := range doc.Directives {
+		def := def
+		list = append(list, block{kind: directiveBlock, name: def.Name, start: start(def.Position), print: func(p *printer) { p.directiveDefinition(def) }})
+	}
+	for _, def := range doc.Definitions {
+		def := def
+		list = append(list, block{kind: typeBlock, name: def.Name, start: start(def.Position), print: func(p *printer) { p.definition(def, false) }})
+	}
+	for _, def := range doc.Extensions {
+		def := def
+		list = append(list, block{kind: typeBlock, name: def.Name, extend: true, start: start(def.Position), print: func(p *printer) { p.definition(def, true) }})
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if !sorted {
+			return a.start < b.start
+		}
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		if a.extend != b.extend {
+			return !a.extend
+		}
+		return a.start < b.start
+	})
+	return list
+}
+
+func start(pos *ast.Position) int {
+	if pos == nil {
+		return 0
+	}
+	return pos.Start
+}
+
+type printer struct {
+	buf    bytes.Buffer
+	indent string
+	depth  int
+}
+
+func (p *printer) line(s string) {
+	p.buf.WriteString(strings.Repeat(p.indent, p.depth))
+	p.buf.WriteString(s)
+	p.buf.WriteString("\n")
+}
+
+func (p *printer) comments(group *ast.CommentGroup) {
+	if group == nil {
+		return
+	}
+	for _, c := range group.List {
+		p.line("#" + strings.TrimRight(c.Text(), " \t"))
+	}
+}
+
+// header prints the comments and description that precede an element.
+func (p *printer) header(before *ast.CommentGroup, description string, after *ast.CommentGroup) {
+	p.comments(before)
+	p.description(description)
+	p.comments(after)
+}
+
+func (p *printer) description(s string) {
+	if s == "" {
+		return
+	}
+	if !strings.ContainsAny(s, "\n\"\\") {
+		p.line(`"` + s + `"`)
+		return
+	}
+	p.line(`"""`)
+	for _, l := range strings.Split(s, "\n") {
+		if strings.TrimSpace(l) == "" {
+			p.buf.WriteString("\n")
+			continue
+		}
+		p.line(strings.ReplaceAll(l, `"""`, `\"""`))
+	}
+	p.line(`"""`)
+}
+
+func (p *printer) schema(def *ast.SchemaDefinition, extend bool) {
+	p.header(def.BeforeDescriptionComment, def.Description, def.AfterDescriptionComment)
+	head := "schema" + directives(def.Directives)
+	if extend {
+		head = "extend " + head
+	}
+	if len(def.OperationTypes) == 0 && (def.EndOfDefinitionComment == nil || len(def.EndOfDefinitionComment.List) == 0) {
+		p.line(head)
+		return
+	}
+	p.line(head + " {")
+	p.depth++
+	for _, op := range def.OperationTypes {
+		p.comments(op.Comment)
+		p.line(string(op.Operation) + ": " + op.Type)
+	}
+	p.comments(def.EndOfDefinitionComment)
+	p.depth--
+	p.line("}")
+}
+
+func (p *printer) directiveDefinition(def *ast.DirectiveDefinition) {
+	p.header(def.BeforeDescriptionComment, def.Description, def.AfterDescriptionComment)
+	tail := ""
+	if def.IsRepeatable {
+		tail += " repeatable"
+	}
+	locations := make([]string, len(def.Locations))
+	for i, l := range def.Locations {
+		locations[i] = string(l)
+	}
+	tail += " on " + strings.Join(locations, " | ")
+	p.arguments("directive @"+def.Name, def.Arguments, tail)
+}
+
+func (p *printer) definition(def *ast.Definition, extend bool) {
+	p.header(def.BeforeDescriptionComment, def.Description, def.AfterDescriptionComment)
+
+	head := ""
+	if extend {
+		head = "extend "
+	}
+	switch def.Kind {
+	case ast.Scalar:
+		head += "scalar "
+	case ast.Object:
+		head += "type "
+	case ast.Interface:
+		head += "interface "
+	case ast.Union:
+		head += "union "
+	case ast.Enum:
+		head += "enum "
+	case ast.InputObject:
+		head += "input "
+	}
+	head += def.Name
+	if len(def.Interfaces) > 0 {
+		head += " implements " + strings.Join(def.Interfaces, " & ")
+	}
+	head += directives(def.Directives)
+	if len(def.Types) > 0 {
+		head += " = " + strings.Join(def.Types, " | ")
+	}
+
+	hasEndComment := def.EndOfDefinitionComment != nil && len(def.EndOfDefinitionComment.List) > 0
+	if len(def.Fields) == 0 && len(def.EnumValues) == 0 && !hasEndComment {
+		p.line(head)
+		return
+	}
+
+	p.line(head + " {")
+	p.depth++
+	for _, f := range def.Fields {
+		p.header(f.BeforeDescriptionComment, f.Description, f.AfterDescriptionComment)
+		tail := ": " + f.Type.String()
+		if f.DefaultValue != nil {
+			tail += " = " + value(f.DefaultValue)
+		}
+		p.arguments(f.Name, f.Arguments, tail+directives(f.Directives))
+	}
+	for _, v := range def.EnumValues {
+		p.header(v.BeforeDescriptionComment, v.Description, v.AfterDescriptionComment)
+		p.line(v.Name + directives(v.Directives))
+	}
+	p.comments(def.EndOfDefinitionComment)
+	p.depth--
+	p.line("}")
+}
+
+// arguments prints head followed by args and tail, on a single line unless an argument has a description or comment
+// that needs lines of its own.
+func (p *printer) arguments(head string, args ast.ArgumentDefinitionList, tail string) {
+	if len(args) == 0 {
+		p.line(head + tail)
+		return
+	}
+
+	multiline := false
+	list := make([]string, len(args))
+	for i, a := range args {
+		list[i] = a.Name + ": " + a.Type.String()
+		if a.DefaultValue != nil {
+			list[i] += " = " + value(a.DefaultValue)
+		}
+		list[i] += directives(a.Directives)
+		if a.Description != "" || hasComments(a.BeforeDescriptionComment) || hasComments(a.AfterDescriptionComment) {
+			multiline = true
+		}
+	}
+	if !multiline {
+		p.line(head + "(" + strings.Join(list, ", ") + ")" + tail)
+		return
+	}
+
+	p.line(head + "(")
+	p.depth++
+	for i, a := range args {
+		p.header(a.BeforeDescriptionComment, a.Description, a.AfterDescriptionComment)
+		p.line(list[i])
+	}
+	p.depth--
+	p.line(")" + tail)
+}
+
+func hasComments(group *ast.CommentGroup) bool {
+	return group != nil && len(group.List) > 0
+}
+
+func directives(list ast.DirectiveList) string {
+	var s strings.Builder
+	for _, d := range list {
+		s.WriteString(" @" + d.Name)
+		if len(d.Arguments) > 0 {
+			args := make([]string, len(d.Arguments))
+			for i, a := range d.Arguments {
+				args[i] = a.Name + ": " + value(a.Value)
+			}
+			s.WriteString("(" + strings.Join(args, ", ") + ")")
+		}
+	}
+	return s.String()
+}
+
+func value(v *ast.Value) string {
+	switch v.Kind {
+	case ast.Variable:
+		return "$" + v.Raw
+	case ast.StringValue, ast.BlockValue:
+		return quote(v.Raw)
+	case ast.ListValue:
+		list := make([]string, len(v.Children))
+		for i, c := range v.Children {
+			list[i] = value(c.Value)
+		}
+		return "[" + strings.Join(list, ", ") + "]"
+	case ast.ObjectValue:
+		list := make([]string, len(v.Children))
+		for i, c := range v.Children {
+			list[i] = c.Name + ": " + value(c.Value)
+		}
+		return "{" + strings.Join(list, ", ") + "}"
+	default:
+		return v.Raw
+	}
+}
+
+// quote returns s as a graphql string literal.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04x`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+func countComments(input string) (int, error) {
+	lex := lexer.New(&ast.Source{Input: input})
+	count := 0
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			return 0, err
+		}
+		switch tok.Kind {
+		case lexer.EOF:
+			return count, nil
+		case lexer.Comment:
+			count++
+		}
+	}
+}
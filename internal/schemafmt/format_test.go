@@ -0,0 +1,48 @@
+package schemafmt
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestFormat(t *testing.T) {
+	input, err := os.ReadFile("testdata/schema.graphql")
+	require.NoError(t, err)
+	expected, err := os.ReadFile("testdata/schema.expected.graphql")
+	require.NoError(t, err)
+
+	out, err := Format(&ast.Source{Name: "schema.graphql", Input: string(input)}, Options{})
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(out))
+
+	t.Run("idempotent", func(t *testing.T) {
+		again, err := Format(&ast.Source{Name: "schema.graphql", Input: string(out)}, Options{})
+		require.NoError(t, err)
+		require.Equal(t, string(out), string(again))
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		out, err := Format(&ast.Source{Input: "type B { b: Int }\nextend type A { c: Int }\ndirective @d on FIELD_DEFINITION\ntype A { a: Int }\nschema { query: A }\n"}, Options{Sort: true, Indent: "\t"})
+		require.NoError(t, err)
+		require.Equal(t, "schema {\n\tquery: A\n}\n\ndirective @d on FIELD_DEFINITION\n\ntype A {\n\ta: Int\n}\n\nextend type A {\n\tc: Int\n}\n\ntype B {\n\tb: Int\n}\n", string(out))
+	})
+
+	t.Run("descriptions", func(t *testing.T) {
+		out, err := Format(&ast.Source{Input: "\"\"\"short\"\"\" scalar A \"multi\\nline\" scalar B"}, Options{})
+		require.NoError(t, err)
+		require.Equal(t, "\"short\"\nscalar A\n\n\"\"\"\nmulti\nline\n\"\"\"\nscalar B\n", string(out))
+	})
+
+	t.Run("unsupported comment", func(t *testing.T) {
+		_, err := Format(&ast.Source{Name: "c.graphql", Input: "type A { a(x: Int @foo(# c\n y: 1)): Int }"}, Options{})
+		require.ErrorContains(t, err, "c.graphql: contains comments in a position that can't be formatted")
+	})
+
+	t.Run("parse error", func(t *testing.T) {
+		_, err := Format(&ast.Source{Name: "c.graphql", Input: "type A {"}, Options{})
+		require.Error(t, err)
+	})
+}
@@ -4,22 +4,35 @@ package main
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"html/template"
 	"io"
 	"io/fs"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/urfave/cli/v2"
+	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/example"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/internal/code"
+	"github.com/99designs/gqlgen/internal/migrate"
+	"github.com/99designs/gqlgen/internal/release"
+	"github.com/99designs/gqlgen/internal/schemafmt"
+	"github.com/99designs/gqlgen/plugin/bindings"
+	"github.com/99designs/gqlgen/plugin/lint"
 	"github.com/99designs/gqlgen/plugin/servergen"
 )
 
@@ -167,6 +180,8 @@ var generateCmd = &cli.Command{
 	Flags: []cli.Flag{
 		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
 		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.BoolFlag{Name: "explain-bindings", Usage: "print how each type and field was bound to go"},
+		&cli.BoolFlag{Name: "no-cache", Usage: "type check every dependency and format every file again instead of reading them from the caches"},
 	},
 	Action: func(ctx *cli.Context) error {
 		var cfg *config.Config
@@ -187,18 +202,266 @@ var generateCmd = &cli.Command{
 			}
 		}
 
-		if err = api.Generate(cfg); err != nil {
+		if ctx.Bool("no-cache") {
+			cfg.BuildCache = false
+			cfg.LoadCache = false
+		}
+
+		var options []api.Option
+		if ctx.Bool("explain-bindings") {
+			options = append(options, api.AddPlugin(bindings.New(os.Stdout)))
+		}
+		if err = api.Generate(cfg, options...); err != nil {
 			return err
 		}
 		return nil
 	},
 }
 
+var lintCmd = &cli.Command{
+	Name:  "lint",
+	Usage: "check the schema against the lint rules in the config",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.BoolFlag{Name: "prune-diff", Usage: "print a diff removing models entries that no longer match the schema"},
+	},
+	Action: func(ctx *cli.Context) error {
+		configFilename := ctx.String("config")
+		var cfg *config.Config
+		var err error
+		if configFilename != "" {
+			cfg, err = config.LoadConfig(configFilename)
+		} else {
+			configFilename, err = config.FindConfig()
+			if err == nil {
+				cfg, err = config.LoadConfigFromDefaultLocations()
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = cfg.LoadSchema(); err != nil {
+			return err
+		}
+
+		if ctx.Bool("prune-diff") {
+			return printPruneDiff(configFilename, cfg.UnusedModels())
+		}
+
+		issues, err := lint.LintConfig(cfg)
+		if err != nil {
+			return err
+		}
+		for _, i := range issues {
+			fmt.Println(i.String())
+		}
+		return issues.Err()
+	},
+}
+
+func printPruneDiff(filename string, unused []string) error {
+	if len(unused) == 0 {
+		return nil
+	}
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	pruned, err := config.PruneModels(src, unused)
+	if err != nil {
+		return err
+	}
+
+	name := filepath.Base(filename)
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(src)),
+		B:        difflib.SplitLines(string(pruned)),
+		FromFile: "a/" + name,
+		ToFile:   "b/" + name,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Print(diff)
+	return nil
+}
+
+var fmtCmd = &cli.Command{
+	Name:      "fmt",
+	Usage:     "format the schema files",
+	ArgsUsage: "[files...]",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.BoolFlag{Name: "check", Usage: "list the files that aren't formatted instead of rewriting them, and fail if there are any"},
+		&cli.BoolFlag{Name: "sort", Usage: "order definitions by kind and name"},
+		&cli.IntFlag{Name: "indent", Usage: "the number of spaces to indent with, 0 to use tabs", Value: 2},
+	},
+	Action: func(ctx *cli.Context) error {
+		filenames := ctx.Args().Slice()
+		if len(filenames) == 0 {
+			var cfg *config.Config
+			var err error
+			if configFilename := ctx.String("config"); configFilename != "" {
+				cfg, err = config.LoadConfig(configFilename)
+			} else {
+				cfg, err = config.LoadConfigFromDefaultLocations()
+			}
+			if err != nil {
+				return err
+			}
+			filenames = cfg.SchemaFilename
+		}
+
+		opts := schemafmt.Options{Indent: "\t", Sort: ctx.Bool("sort")}
+		if n := ctx.Int("indent"); n > 0 {
+			opts.Indent = strings.Repeat(" ", n)
+		}
+
+		var unformatted []string
+		for _, filename := range filenames {
+			src, err := os.ReadFile(filename)
+			if err != nil {
+				return err
+			}
+			formatted, err := schemafmt.Format(&ast.Source{Name: filename, Input: string(src)}, opts)
+			if err != nil {
+				return err
+			}
+			if bytes.Equal(src, formatted) {
+				continue
+			}
+			if ctx.Bool("check") {
+				fmt.Println(filename)
+				unformatted = append(unformatted, filename)
+				continue
+			}
+			if err := os.WriteFile(filename, formatted, 0o644); err != nil {
+				return err
+			}
+		}
+		if len(unformatted) > 0 {
+			return fmt.Errorf("%d schema files aren't formatted, run gqlgen fmt", len(unformatted))
+		}
+		return nil
+	},
+}
+
+var migrateCmd = &cli.Command{
+	Name:      "migrate",
+	Usage:     "report the changes the code needs after upgrading gqlgen, without generating anything",
+	ArgsUsage: "[packages...]",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+	},
+	Action: func(ctx *cli.Context) error {
+		var cfg *config.Config
+		var err error
+		if configFilename := ctx.String("config"); configFilename != "" {
+			cfg, err = config.LoadConfig(configFilename)
+		} else {
+			cfg, err = config.LoadConfigFromDefaultLocations()
+		}
+		if err != nil {
+			return err
+		}
+
+		data, err := api.BuildData(cfg)
+		if err != nil {
+			return err
+		}
+		patterns := ctx.Args().Slice()
+		if len(patterns) == 0 {
+			patterns = []string{"./..."}
+		}
+		report, err := migrate.Check(data, patterns...)
+		if err != nil {
+			return err
+		}
+		for _, c := range report {
+			fmt.Println(c.String())
+		}
+		return report.Err()
+	},
+}
+
+var exampleCmd = &cli.Command{
+	Name:      "example",
+	Usage:     "print an example response to an operation, using the @example directives of the schema",
+	ArgsUsage: "<query file>",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "verbose, v", Usage: "show logs"},
+		&cli.StringFlag{Name: "config, c", Usage: "the config filename"},
+		&cli.StringFlag{Name: "operation, o", Usage: "the name of the operation, when the file has several"},
+		&cli.IntFlag{Name: "list-length", Usage: "the number of items of lists", Value: 1},
+	},
+	Action: func(ctx *cli.Context) error {
+		if ctx.NArg() != 1 {
+			return fmt.Errorf("expected a query file")
+		}
+		query, err := os.ReadFile(ctx.Args().First())
+		if err != nil {
+			return err
+		}
+
+		var cfg *config.Config
+		if configFilename := ctx.String("config"); configFilename != "" {
+			cfg, err = config.LoadConfig(configFilename)
+		} else {
+			cfg, err = config.LoadConfigFromDefaultLocations()
+		}
+		if err != nil {
+			return err
+		}
+		if err = cfg.LoadSchema(); err != nil {
+			return err
+		}
+
+		resp, err := example.Response(cfg.Schema, string(query), ctx.String("operation"), example.Options{
+			ListLength: ctx.Int("list-length"),
+		})
+		if err != nil {
+			return err
+		}
+		out, err := json.MarshalIndent(resp, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+		return nil
+	},
+}
+
 var versionCmd = &cli.Command{
 	Name:  "version",
 	Usage: "print the version string",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{Name: "check", Usage: "look up the latest release on the module proxy of GOPROXY, and fail if it is newer"},
+	},
 	Action: func(ctx *cli.Context) error {
 		fmt.Println(graphql.Version)
+		if !ctx.Bool("check") {
+			return nil
+		}
+
+		proxy := release.Proxy(os.Getenv("GOPROXY"))
+		if proxy == "" {
+			return fmt.Errorf("GOPROXY does not list a module proxy to look up releases on")
+		}
+		checkCtx, cancel := context.WithTimeout(ctx.Context, 10*time.Second)
+		defer cancel()
+		latest, err := release.Latest(checkCtx, http.DefaultClient, proxy)
+		if err != nil {
+			return err
+		}
+		if release.Newer(graphql.Version, latest) {
+			return fmt.Errorf("%s is newer, update with go get github.com/99designs/gqlgen@%s", latest, latest)
+		}
+		fmt.Println("up to date")
 		return nil
 	},
 }
@@ -224,6 +487,10 @@ func main() {
 	app.Commands = []*cli.Command{
 		generateCmd,
 		initCmd,
+		lintCmd,
+		fmtCmd,
+		exampleCmd,
+		migrateCmd,
 		versionCmd,
 	}
 
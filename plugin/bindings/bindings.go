@@ -0,0 +1,154 @@
+// Package bindings explains how gqlgen bound each GraphQL type and field to go: which model a type was bound to and
+// why, and whether each field reads a struct field, calls a method or needs a resolver. It runs as part of generate
+// with the --explain-bindings flag.
+package bindings
+
+import (
+	"bytes"
+	"fmt"
+	"go/types"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/plugin"
+)
+
+func New(w io.Writer) plugin.Plugin {
+	return &Plugin{w: w}
+}
+
+type Plugin struct {
+	w io.Writer
+}
+
+var _ plugin.CodeGenerator = &Plugin{}
+
+func (p *Plugin) Name() string {
+	return "bindings"
+}
+
+func (p *Plugin) GenerateCode(data *codegen.Data) error {
+	_, err := io.WriteString(p.w, Explain(data))
+	return err
+}
+
+// Explain returns a report of the bindings of the objects and input objects of the schema, sorted by name.
+func Explain(data *codegen.Data) string {
+	objects := make(codegen.Objects, 0, len(data.Objects)+len(data.Inputs))
+	objects = append(objects, data.Objects...)
+	objects = append(objects, data.Inputs...)
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name < objects[j].Name
+	})
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 4, 2, ' ', 0)
+	for _, obj := range objects {
+		if obj.BuiltIn {
+			continue
+		}
+		keyword := "type"
+		if obj.Kind == ast.InputObject {
+			keyword = "input"
+		}
+		binding := typeBinding(data, obj)
+		if module := moduleOf(data, obj.Type); module != "" {
+			binding += ", " + module
+		}
+		fmt.Fprintf(w, "%s %s: %s\n", keyword, obj.Name, binding)
+		for _, f := range obj.Fields {
+			if f.IsReserved() {
+				continue
+			}
+			fmt.Fprintf(w, "  %s\t%s\n", f.Name, fieldBinding(data, f))
+		}
+	}
+	_ = w.Flush()
+	return buf.String()
+}
+
+func typeBinding(data *codegen.Data, obj *codegen.Object) string {
+	if obj.Root {
+		return "root type"
+	}
+	name := types.TypeString(obj.Type, nil)
+	cfg := data.Config
+	switch {
+	case cfg.AutobindPackage(obj.Name) != "":
+		return fmt.Sprintf("%s, found in autobind package %s", name, cfg.AutobindPackage(obj.Name))
+	case cfg.Models[obj.Name].ForceGenerate:
+		return fmt.Sprintf("%s, generated because of forceGenerate", name)
+	case cfg.Model.IsDefined() && strings.HasPrefix(name, cfg.Model.ImportPath()+"."):
+		return fmt.Sprintf("%s, generated", name)
+	default:
+		return fmt.Sprintf("%s, from models", name)
+	}
+}
+
+// moduleOf describes the module the go type typ was loaded from, as the package of a go.work workspace may come from
+// any of its modules, or an empty string when it isn't known.
+func moduleOf(data *codegen.Data, typ types.Type) string {
+	named, ok := typ.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || data.Config.Packages == nil {
+		return ""
+	}
+	m := data.Config.Packages.Module(named.Obj().Pkg().Path())
+	switch {
+	case m == nil:
+		return ""
+	case m.Main:
+		return "in module " + m.Path
+	case m.Replace != nil && m.Replace.Version == "":
+		return fmt.Sprintf("in module %s replaced by %s", m.Path, m.Replace.Path)
+	case m.Replace != nil:
+		return fmt.Sprintf("in module %s replaced by %s@%s", m.Path, m.Replace.Path, m.Replace.Version)
+	default:
+		return fmt.Sprintf("in module %s@%s", m.Path, m.Version)
+	}
+}
+
+func fieldBinding(data *codegen.Data, f *codegen.Field) string {
+	entry := data.Config.Models[f.Object.Name].Fields[f.Name]
+	var res string
+	switch {
+	case f.ResolvedOn != nil:
+		res = "resolver of interface " + f.ResolvedOn.Name
+	case f.IsResolver && f.Object.Kind == ast.InputObject:
+		res = "input resolver"
+	case f.IsResolver && f.Object.Root:
+		res = "resolver"
+	case f.IsResolver && entry.Resolver:
+		res = "resolver, forced by forceResolver"
+	case f.IsResolver && f.BindError != nil:
+		res = "resolver, because " + f.BindError.Error()
+	case f.IsResolver:
+		res = "resolver"
+	case f.IsMap():
+		res = fmt.Sprintf("map key %q", f.Name)
+	case f.IsMethod() && f.GoReceiverName == "ec":
+		res = "builtin"
+	case f.IsMethod():
+		args := make([]string, 0, len(f.Args)+1)
+		if f.MethodHasContext {
+			args = append(args, "ctx")
+		}
+		for _, arg := range f.Args {
+			args = append(args, arg.VarName)
+		}
+		res = fmt.Sprintf("method %s%s(%s)", f.GoEmbedPath, f.GoFieldName, strings.Join(args, ", "))
+	default:
+		res = "field " + f.GoEmbedPath + f.GoFieldName
+	}
+	if entry.FieldName != "" && !f.IsResolver {
+		res += ", renamed by fieldName"
+	}
+	if f.TypeReference != nil && f.TypeReference.CastType != nil {
+		res += ", cast to " + types.TypeString(f.TypeReference.CastType, nil)
+	}
+	return res
+}
@@ -0,0 +1,45 @@
+package bindings
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin/modelgen"
+)
+
+func TestExplain(t *testing.T) {
+	t.Cleanup(func() { _ = os.RemoveAll("testdata/out") })
+
+	cfg, err := config.LoadConfig("testdata/gqlgen.yml")
+	require.NoError(t, err)
+	require.NoError(t, cfg.Init())
+	require.NoError(t, modelgen.New().(*modelgen.Plugin).MutateConfig(cfg))
+	data, err := codegen.BuildData(cfg)
+	require.NoError(t, err)
+
+	lines := strings.Split(Explain(data), "\n")
+	require.Equal(t, []string{
+		"type Query: root type",
+		"  todos  resolver",
+		"type Todo: github.com/99designs/gqlgen/plugin/bindings/testdata/db.Todo, found in autobind package github.com/99designs/gqlgen/plugin/bindings/testdata/db, in module github.com/99designs/gqlgen",
+		"  id        field ID",
+		"  text      field Body, renamed by fieldName",
+		"  status    field Status, cast to string",
+		"  tags      method Tags(ctx, limit)",
+		"  owner     resolver, forced by forceResolver",
+	}, lines[:8])
+	require.Regexp(t, `^  assignee  resolver, because .*db\.go:5 adding resolver method for Todo\.assignee, nothing matched$`, lines[8])
+	require.Equal(t, []string{
+		"input TodoFilter: github.com/99designs/gqlgen/plugin/bindings/testdata/db.TodoFilter, found in autobind package github.com/99designs/gqlgen/plugin/bindings/testdata/db, in module github.com/99designs/gqlgen",
+		"  text  field Text",
+		"type User: github.com/99designs/gqlgen/plugin/bindings/testdata/out.User, generated, in module github.com/99designs/gqlgen",
+		"  id    field ID",
+		"  name  field Name",
+		"",
+	}, lines[9:])
+}
@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+type Todo struct {
+	ID     string
+	Body   string
+	Status Status
+}
+
+func (t *Todo) Tags(ctx context.Context, limit int) ([]string, error) {
+	return nil, nil
+}
+
+type Status string
+
+type TodoFilter struct {
+	Text *string
+}
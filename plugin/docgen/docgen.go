@@ -0,0 +1,399 @@
+// Package docgen renders the schema gqlgen generated code for into markdown or html documentation. It runs as part of
+// generate when the docs section of the config is present, after every other plugin has modified the schema, so the
+// docs describe exactly what was built.
+package docgen
+
+import (
+	"bytes"
+	_ "embed"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/plugin"
+)
+
+//go:embed docs.md.gotpl
+var markdownTemplate string
+
+//go:embed docs.html.gotpl
+var htmlTemplate string
+
+func New() plugin.Plugin {
+	return &Plugin{}
+}
+
+type Plugin struct{}
+
+var _ plugin.CodeGenerator = &Plugin{}
+
+func (p *Plugin) Name() string {
+	return "docgen"
+}
+
+func (p *Plugin) GenerateCode(data *codegen.Data) error {
+	cfg := data.Config.Docs
+	if !cfg.IsDefined() {
+		// executables other than the main one are not documented
+		return nil
+	}
+	render := Markdown
+	if cfg.IsHTML() {
+		render = HTML
+	}
+	out, err := render(data.Schema, cfg.Title)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(cfg.Filename), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(cfg.Filename, out, 0o644)
+}
+
+// Markdown renders schema as a single markdown page.
+func Markdown(schema *ast.Schema, title string) ([]byte, error) {
+	tpl, err := texttemplate.New("docs").Funcs(texttemplate.FuncMap{
+		"typeRef": func(t *ast.Type) string { return typeRef(schema, t, markdownLink) },
+		"cell":    markdownCell,
+		"notes":   markdownNotes,
+		"anchor":  anchor,
+	}).Parse(markdownTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, newPage(schema, title)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// HTML renders schema as a standalone html page.
+func HTML(schema *ast.Schema, title string) ([]byte, error) {
+	tpl, err := htmltemplate.New("docs").Funcs(htmltemplate.FuncMap{
+		"typeRef": func(t *ast.Type) htmltemplate.HTML { return htmltemplate.HTML(typeRef(schema, t, htmlLink)) },
+		"anchor":  anchor,
+	}).Parse(htmlTemplate)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, newPage(schema, title)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// hiddenDirectives only matter to gqlgen itself, or are shown as a deprecation badge instead.
+var hiddenDirectives = map[string]bool{
+	"deprecated":     true,
+	"goModel":        true,
+	"goField":        true,
+	"goTag":          true,
+	"goExtraField":   true,
+	"goEnum":         true,
+	"entityResolver": true,
+}
+
+type page struct {
+	Title      string
+	Sections   []*section
+	Directives []*directive
+}
+
+type section struct {
+	Title string
+	Types []*typeDoc
+}
+
+type typeDoc struct {
+	Name          string
+	Kind          string
+	Description   string
+	Interfaces    []string
+	PossibleTypes []string
+	Directives    []string
+	Fields        []*field
+	EnumValues    []*enumValue
+}
+
+type field struct {
+	Name         string
+	Description  string
+	Type         *ast.Type
+	DefaultValue string
+	Arguments    []*field
+	Directives   []string
+	Deprecation  *deprecation
+}
+
+type enumValue struct {
+	Name        string
+	Description string
+	Directives  []string
+	Deprecation *deprecation
+}
+
+type deprecation struct {
+	Reason string
+}
+
+type directive struct {
+	Name        string
+	Description string
+	Arguments   []*field
+	Locations   string
+}
+
+func newPage(schema *ast.Schema, title string) *page {
+	if title == "" {
+		title = "Schema"
+	}
+	p := &page{Title: title}
+
+	var roots []*ast.Definition
+	for _, def := range []*ast.Definition{schema.Query, schema.Mutation, schema.Subscription} {
+		if def != nil {
+			roots = append(roots, def)
+		}
+	}
+	isRoot := func(def *ast.Definition) bool {
+		for _, r := range roots {
+			if r == def {
+				return true
+			}
+		}
+		return false
+	}
+
+	sections := []*section{{Title: "Operations"}}
+	byKind := map[ast.DefinitionKind]*section{}
+	for _, kind := range []struct {
+		kind  ast.DefinitionKind
+		title string
+	}{
+		{ast.Object, "Objects"},
+		{ast.Interface, "Interfaces"},
+		{ast.Union, "Unions"},
+		{ast.Enum, "Enums"},
+		{ast.InputObject, "Input objects"},
+		{ast.Scalar, "Scalars"},
+	} {
+		byKind[kind.kind] = &section{Title: kind.title}
+		sections = append(sections, byKind[kind.kind])
+	}
+
+	for _, def := range roots {
+		sections[0].Types = append(sections[0].Types, newType(schema, def))
+	}
+	for _, def := range sortedTypes(schema) {
+		if !isRoot(def) {
+			byKind[def.Kind].Types = append(byKind[def.Kind].Types, newType(schema, def))
+		}
+	}
+	for _, s := range sections {
+		if len(s.Types) > 0 {
+			p.Sections = append(p.Sections, s)
+		}
+	}
+
+	names := make([]string, 0, len(schema.Directives))
+	for name, d := range schema.Directives {
+		if !isBuiltIn(d.Position) && !hiddenDirectives[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		d := schema.Directives[name]
+		locations := make([]string, len(d.Locations))
+		for i, l := range d.Locations {
+			locations[i] = string(l)
+		}
+		p.Directives = append(p.Directives, &directive{
+			Name:        d.Name,
+			Description: d.Description,
+			Arguments:   newArguments(d.Arguments),
+			Locations:   strings.Join(locations, " | "),
+		})
+	}
+	return p
+}
+
+// sortedTypes returns the types declared in the users schema by name, leaving out builtins and types injected by
+// plugins such as _Entity.
+func sortedTypes(schema *ast.Schema) []*ast.Definition {
+	var defs []*ast.Definition
+	for _, def := range schema.Types {
+		if def.BuiltIn || strings.HasPrefix(def.Name, "__") || isBuiltIn(def.Position) {
+			continue
+		}
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+func isBuiltIn(pos *ast.Position) bool {
+	return pos != nil && pos.Src != nil && pos.Src.BuiltIn
+}
+
+func newType(schema *ast.Schema, def *ast.Definition) *typeDoc {
+	t := &typeDoc{
+		Name:        def.Name,
+		Kind:        strings.ToLower(string(def.Kind)),
+		Description: def.Description,
+		Interfaces:  def.Interfaces,
+		Directives:  directives(def.Directives),
+	}
+	if def.Kind == ast.InputObject {
+		t.Kind = "input"
+	}
+	if def.Kind == ast.Union {
+		t.PossibleTypes = def.Types
+	}
+	if def.Kind == ast.Interface {
+		for _, impl := range schema.GetPossibleTypes(def) {
+			t.PossibleTypes = append(t.PossibleTypes, impl.Name)
+		}
+		sort.Strings(t.PossibleTypes)
+	}
+	for _, f := range def.Fields {
+		// introspection and plugin injected fields, like _entities, aren't part of the users api
+		if strings.HasPrefix(f.Name, "__") || isBuiltIn(f.Position) {
+			continue
+		}
+		t.Fields = append(t.Fields, &field{
+			Name:         f.Name,
+			Description:  f.Description,
+			Type:         f.Type,
+			DefaultValue: value(f.DefaultValue),
+			Arguments:    newArguments(f.Arguments),
+			Directives:   directives(f.Directives),
+			Deprecation:  newDeprecation(f.Directives),
+		})
+	}
+	for _, v := range def.EnumValues {
+		t.EnumValues = append(t.EnumValues, &enumValue{
+			Name:        v.Name,
+			Description: v.Description,
+			Directives:  directives(v.Directives),
+			Deprecation: newDeprecation(v.Directives),
+		})
+	}
+	return t
+}
+
+func newArguments(args ast.ArgumentDefinitionList) []*field {
+	var list []*field
+	for _, a := range args {
+		list = append(list, &field{
+			Name:         a.Name,
+			Description:  a.Description,
+			Type:         a.Type,
+			DefaultValue: value(a.DefaultValue),
+			Directives:   directives(a.Directives),
+			Deprecation:  newDeprecation(a.Directives),
+		})
+	}
+	return list
+}
+
+func newDeprecation(list ast.DirectiveList) *deprecation {
+	d := list.ForName("deprecated")
+	if d == nil {
+		return nil
+	}
+	reason := "No longer supported"
+	if arg := d.Arguments.ForName("reason"); arg != nil && arg.Value != nil {
+		reason = arg.Value.Raw
+	}
+	return &deprecation{Reason: reason}
+}
+
+func directives(list ast.DirectiveList) []string {
+	var out []string
+	for _, d := range list {
+		if hiddenDirectives[d.Name] {
+			continue
+		}
+		s := "@" + d.Name
+		if len(d.Arguments) > 0 {
+			args := make([]string, len(d.Arguments))
+			for i, a := range d.Arguments {
+				args[i] = a.Name + ": " + value(a.Value)
+			}
+			s += "(" + strings.Join(args, ", ") + ")"
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func value(v *ast.Value) string {
+	if v == nil {
+		return ""
+	}
+	return v.String()
+}
+
+// typeRef prints t, linking its named type when it is documented on the page.
+func typeRef(schema *ast.Schema, t *ast.Type, link func(name string) string) string {
+	if t.Elem != nil {
+		s := "[" + typeRef(schema, t.Elem, link) + "]"
+		if t.NonNull {
+			s += "!"
+		}
+		return s
+	}
+	name := t.NamedType
+	if def := schema.Types[name]; def != nil && !def.BuiltIn && !isBuiltIn(def.Position) {
+		name = link(name)
+	}
+	if t.NonNull {
+		name += "!"
+	}
+	return name
+}
+
+func markdownLink(name string) string {
+	return "[" + name + "](#" + anchor(name) + ")"
+}
+
+func htmlLink(name string) string {
+	return `<a href="#` + anchor(name) + `">` + name + `</a>`
+}
+
+// anchor is the id of the section documenting the type name, lower cased to match the ids markdown renderers generate
+// for headings.
+func anchor(name string) string {
+	return strings.ToLower(name)
+}
+
+// markdownNotes describes a field or enum value in a single table cell.
+func markdownNotes(d *deprecation, description string, directives []string) string {
+	var lines []string
+	if d != nil {
+		lines = append(lines, "**Deprecated**: "+markdownCell(d.Reason))
+	}
+	if description != "" {
+		lines = append(lines, markdownCell(description))
+	}
+	if len(directives) > 0 {
+		lines = append(lines, "`"+markdownCell(strings.Join(directives, " "))+"`")
+	}
+	return strings.Join(lines, "<br>")
+}
+
+// markdownCell makes s safe to use in a table cell, which can't span lines.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(strings.TrimSpace(s), "|", `\|`)
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
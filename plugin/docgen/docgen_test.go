@@ -0,0 +1,44 @@
+package docgen
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestMarkdown(t *testing.T) {
+	out, err := Markdown(loadSchema(t), "")
+	require.NoError(t, err)
+	expected, err := os.ReadFile("testdata/schema.md")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(out))
+}
+
+func TestHTML(t *testing.T) {
+	out, err := HTML(loadSchema(t), "")
+	require.NoError(t, err)
+	expected, err := os.ReadFile("testdata/schema.html")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(out))
+
+	t.Run("escapes descriptions", func(t *testing.T) {
+		schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Input: `type Query { "<script>" a: Int }`})
+		require.Nil(t, gqlErr)
+		out, err := HTML(schema, "<API>")
+		require.NoError(t, err)
+		require.Contains(t, string(out), "<title>&lt;API&gt;</title>")
+		require.Contains(t, string(out), "<td>&lt;script&gt;</td>")
+	})
+}
+
+func loadSchema(t *testing.T) *ast.Schema {
+	t.Helper()
+	src, err := os.ReadFile("testdata/schema.graphql")
+	require.NoError(t, err)
+	schema, gqlErr := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: string(src)})
+	require.Nil(t, gqlErr)
+	return schema
+}
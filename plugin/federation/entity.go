@@ -1,10 +1,12 @@
 package federation
 
 import (
+	"fmt"
 	"go/types"
 
 	"github.com/vektah/gqlparser/v2/ast"
 
+	"github.com/99designs/gqlgen/codegen"
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/99designs/gqlgen/codegen/templates"
 	"github.com/99designs/gqlgen/plugin/federation/fieldset"
@@ -13,12 +15,13 @@ import (
 // Entity represents a federated type
 // that was declared in the GQL schema.
 type Entity struct {
-	Name      string // The same name as the type declaration
-	Def       *ast.Definition
-	Resolvers []*EntityResolver
-	Requires  []*Requires
-	Multi     bool
-	Type      types.Type
+	Name           string // The same name as the type declaration
+	Def            *ast.Definition
+	Resolvers      []*EntityResolver
+	Requires       []*Requires
+	RequiresFields []*RequiresField // Requires grouped by parent field, used to populate the entity
+	Multi          bool
+	Type           types.Type
 }
 
 type EntityResolver struct {
@@ -45,6 +48,93 @@ type Requires struct {
 	Type  *config.TypeReference // The Go representation of that field type
 }
 
+// RequiresField is a field selected by @requires. Objects and lists of objects hold the fields selected from them in
+// Children, anything else is unmarshalled straight from the representation.
+type RequiresField struct {
+	Name     string                // the name of the field in the representation
+	GoName   string                // the struct field it is stored in
+	Type     *config.TypeReference // The Go representation of that field type
+	Children []*RequiresField
+}
+
+// RequiresValue is a RequiresField being populated by the template: Dst and Src are the Go expressions for the
+// struct field and the representation value, Type is the type at this level, which is the element type inside lists.
+type RequiresValue struct {
+	Field *RequiresField
+	Type  *config.TypeReference
+	Dst   string
+	Src   string
+	Depth int
+}
+
+// PopulateRequires returns the values for the top level required fields of the entity in dst, read from the
+// representation in src.
+func (e *Entity) PopulateRequires(dst, src string) []RequiresValue {
+	values := make([]RequiresValue, len(e.RequiresFields))
+	for i, f := range e.RequiresFields {
+		values[i] = RequiresValue{
+			Field: f,
+			Type:  f.Type,
+			Dst:   dst + "." + f.GoName,
+			Src:   fmt.Sprintf("%s[%q]", src, f.Name),
+		}
+	}
+	return values
+}
+
+// Child returns the value for a field selected from the object in v.
+func (v RequiresValue) Child(f *RequiresField) RequiresValue {
+	return RequiresValue{
+		Field: f,
+		Type:  f.Type,
+		Dst:   v.Dst + "." + f.GoName,
+		Src:   fmt.Sprintf("m%d[%q]", v.Depth, f.Name),
+		Depth: v.Depth + 1,
+	}
+}
+
+// Elem returns the value for an item of the list in v.
+func (v RequiresValue) Elem() RequiresValue {
+	return RequiresValue{
+		Field: v.Field,
+		Type:  v.Type.Elem(),
+		Dst:   fmt.Sprintf("%s[i%d]", v.Dst, v.Depth),
+		Src:   fmt.Sprintf("v%d", v.Depth),
+		Depth: v.Depth + 1,
+	}
+}
+
+// requiresFields groups the flattened @requires paths of e by their parent fields, so nested selections and lists of
+// objects can be populated from the representation instead of assigning through fields that may not exist yet.
+func requiresFields(obj *codegen.Object, objects codegen.Objects, requires []*Requires) ([]*RequiresField, error) {
+	var roots []*RequiresField
+	for _, req := range requires {
+		if len(req.Field) == 0 {
+			continue
+		}
+		level := &roots
+		for i, name := range req.Field {
+			var node *RequiresField
+			for _, n := range *level {
+				if n.Name == name {
+					node = n
+					break
+				}
+			}
+			if node == nil {
+				cgField := req.Field[:i+1].TypeReference(obj, objects)
+				if cgField.IsResolver || cgField.GoFieldType != codegen.GoFieldVariable {
+					return roots, fmt.Errorf("@requires field %s on %s must be bound to a struct field to be populated", req.Field[:i+1].Join("."), obj.Name)
+				}
+				node = &RequiresField{Name: name, GoName: cgField.GoFieldName, Type: cgField.TypeReference}
+				*level = append(*level, node)
+			}
+			level = &node.Children
+		}
+	}
+	return roots, nil
+}
+
 func (e *Entity) allFieldsAreExternal(federationVersion int) bool {
 	for _, field := range e.Def.Fields {
 		if !e.isFieldImplicitlyExternal(field, federationVersion) && field.Directives.ForName("external") == nil {
@@ -74,19 +164,29 @@ func (e *Entity) isFieldImplicitlyExternal(field *ast.FieldDefinition, federatio
 	return false
 }
 
-// Determine if the entity is resolvable.
+// Determine if the entity is resolvable, which it is as long as any of its keys is.
 func (e *Entity) isResolvable() bool {
-	key := e.Def.Directives.ForName("key")
-	if key == nil {
+	keys := e.Def.Directives.ForNames("key")
+	if len(keys) == 0 {
 		// If there is no key directive, the entity is resolvable.
 		return true
 	}
+	for _, key := range keys {
+		if isKeyResolvable(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isKeyResolvable reports whether the router may use key to fetch the entity from this subgraph.
+func isKeyResolvable(key *ast.Directive) bool {
 	resolvable := key.Arguments.ForName("resolvable")
 	if resolvable == nil {
-		// If there is no resolvable argument, the entity is resolvable.
+		// If there is no resolvable argument, the key is resolvable.
 		return true
 	}
-	// only if resolvable: false has been set on the @key directive do we consider the entity non-resolvable.
+	// only if resolvable: false has been set on the @key directive do we consider the key non-resolvable.
 	return resolvable.Value.Raw != "false"
 }
 
@@ -23,19 +23,39 @@ var federationTemplate string
 var explicitRequiresTemplate string
 
 type federation struct {
-	Entities       []*Entity
-	Version        int
-	PackageOptions map[string]bool
+	Entities          []*Entity
+	Version           int
+	PackageOptions    map[string]bool
+	EntityConcurrency int
+	// ServiceSDL replaces the schema sources served from _service when it is set, it holds the sources with the
+	// configured overrides and SDL hooks applied.
+	ServiceSDL string
+
+	sdlHooks []SDLHook
+}
+
+// Option configures the plugin returned by New.
+type Option func(f *federation)
+
+// WithSDLHooks runs hooks, in order, over the SDL served from _service before it is embedded in the generated code.
+func WithSDLHooks(hooks ...SDLHook) Option {
+	return func(f *federation) {
+		f.sdlHooks = append(f.sdlHooks, hooks...)
+	}
 }
 
 // New returns a federation plugin that injects
 // federated directives and types into the schema
-func New(version int) plugin.Plugin {
+func New(version int, options ...Option) plugin.Plugin {
 	if version == 0 {
 		version = 1
 	}
 
-	return &federation{Version: version}
+	f := &federation{Version: version}
+	for _, o := range options {
+		o(f)
+	}
+	return f
 }
 
 // Name returns the plugin name
@@ -96,6 +116,24 @@ func (f *federation) MutateConfig(cfg *config.Config) error {
 		cfg.Directives["policy"] = config.DirectiveConfig{SkipRuntime: true}
 		cfg.Directives["interfaceObject"] = config.DirectiveConfig{SkipRuntime: true}
 		cfg.Directives["composeDirective"] = config.DirectiveConfig{SkipRuntime: true}
+		if err := checkLinks(cfg.Sources); err != nil {
+			return err
+		}
+		for _, name := range namespacedDirectives {
+			cfg.Directives[federationNamespace+name] = config.DirectiveConfig{SkipRuntime: true}
+		}
+		normalizeDirectives(cfg.Schema)
+	}
+
+	if err := checkFieldSets(cfg.Schema, f.Version); err != nil {
+		return err
+	}
+	if err := checkOverrides(cfg.Schema, f.Version, cfg.Federation.Overrides); err != nil {
+		return err
+	}
+	for name, o := range cfg.Federation.Overrides {
+		field, _ := overrideField(cfg.Schema, name)
+		setOverrideDirective(field, o)
 	}
 
 	return nil
@@ -116,7 +154,7 @@ func (f *federation) InjectSourceEarly() *ast.Source {
 	scalar _FieldSet
 `
 	} else if f.Version == 2 {
-		input += `
+		directives := `
 	directive @authenticated on FIELD_DEFINITION | OBJECT | INTERFACE | SCALAR | ENUM
 	directive @composeDirective(name: String!) repeatable on SCHEMA
 	directive @extends on OBJECT | INTERFACE
@@ -134,7 +172,6 @@ func (f *federation) InjectSourceEarly() *ast.Source {
 	  | SCALAR
 	  | UNION
 	directive @interfaceObject on OBJECT
-	directive @link(import: [String!], url: String!) repeatable on SCHEMA
 	directive @override(from: String!, label: String) on FIELD_DEFINITION
 	directive @policy(policies: [[federation__Policy!]!]!) on 
 	  | FIELD_DEFINITION
@@ -162,6 +199,10 @@ func (f *federation) InjectSourceEarly() *ast.Source {
 	  | OBJECT
 	  | SCALAR
 	  | UNION
+`
+		// directives that aren't imported by @link are still available under the federation namespace
+		input += directives + namespaceDirectives(directives) + `
+	directive @link(import: [String!], url: String!) repeatable on SCHEMA
 	scalar _Any
 	scalar FieldSet
 	scalar federation__Policy
@@ -178,6 +219,9 @@ func (f *federation) InjectSourceEarly() *ast.Source {
 // InjectSourceLate creates a GraphQL Entity type with all
 // the fields that had the @key directive
 func (f *federation) InjectSourceLate(schema *ast.Schema) *ast.Source {
+	if f.Version == 2 {
+		normalizeDirectives(schema)
+	}
 	f.setEntities(schema)
 
 	var entities, resolvers, entityResolverInputDefinitions string
@@ -276,6 +320,17 @@ func (f *federation) GenerateCode(data *codegen.Data) error {
 
 	// Save package options on f for template use
 	f.PackageOptions = data.Config.Federation.Options
+	f.EntityConcurrency = data.Config.Federation.EntityConcurrency
+
+	if len(data.Config.Federation.Overrides) > 0 || len(f.sdlHooks) > 0 {
+		sdl, err := serviceSDL(data.Config.Sources, data.Config.Schema, data.Config.Federation.Overrides)
+		if err != nil {
+			return err
+		}
+		if f.ServiceSDL, err = f.applySDLHooks(data.Config.Schema, sdl); err != nil {
+			return err
+		}
+	}
 
 	if len(f.Entities) > 0 {
 		if data.Objects.ByName("Entity") != nil {
@@ -325,6 +380,12 @@ func (f *federation) GenerateCode(data *codegen.Data) error {
 				cgField := reqField.Field.TypeReference(obj, data.Objects)
 				reqField.Type = cgField.TypeReference
 			}
+			// explicit requires leaves populating single entities to the user, so fields that can't be assigned are fine
+			fields, err := requiresFields(obj, data.Objects, e.Requires)
+			if err != nil && !data.Config.Federation.Options["explicit_requires"] {
+				return err
+			}
+			e.RequiresFields = fields
 
 			// add type info to entity
 			e.Type = obj.Type
@@ -409,6 +470,7 @@ func (f *federation) GenerateCode(data *codegen.Data) error {
 			}{*f, existingImports, populators, ""},
 			GeneratedHeader: false,
 			Packages:        data.Config.Packages,
+			Format:          data.Config.Format.Options(),
 			Template:        explicitRequiresTemplate,
 		})
 		if err != nil {
@@ -424,11 +486,27 @@ func (f *federation) GenerateCode(data *codegen.Data) error {
 			UsePointers bool
 		}{*f, data.Config.ResolversAlwaysReturnPointers},
 		GeneratedHeader: true,
+		Provenance:      data.Config.Provenance(),
 		Packages:        data.Config.Packages,
+		Format:          data.Config.Format.Options(),
 		Template:        federationTemplate,
 	})
 }
 
+func (f *federation) applySDLHooks(schema *ast.Schema, sdl string) (string, error) {
+	for _, hook := range f.sdlHooks {
+		var err error
+		if sdl, err = hook(schema, sdl); err != nil {
+			return "", fmt.Errorf("federation sdl hook: %w", err)
+		}
+		// an empty result would fall back to serving the unfiltered sources
+		if strings.TrimSpace(sdl) == "" {
+			return "", fmt.Errorf("federation sdl hook returned an empty schema")
+		}
+	}
+	return sdl, nil
+}
+
 func (f *federation) setEntities(schema *ast.Schema) {
 	for _, schemaType := range schema.Types {
 		keys, ok := isFederatedEntity(schemaType)
@@ -478,6 +556,11 @@ func (f *federation) setEntities(schema *ast.Schema) {
 		//    }
 		if !e.allFieldsAreExternal(f.Version) {
 			for _, dir := range keys {
+				// a non resolvable key only references an entity owned by another subgraph, the router never
+				// fetches it from here, so there is nothing to resolve
+				if !isKeyResolvable(dir) {
+					continue
+				}
 				if len(dir.Arguments) > 2 {
 					panic("More than two arguments provided for @key declaration.")
 				}
@@ -97,6 +97,12 @@ func TestEntityResolver(t *testing.T) {
 
 		require.Contains(t, errMessages, "resolving Entity \"HelloWithErrors\": error (empty key) resolving HelloWithErrorsByName")
 		require.Contains(t, errMessages, "resolving Entity \"HelloWithErrors\": error resolving HelloWithErrorsByName")
+		errPaths := [][]interface{}{
+			entityErrors[0].Path,
+			entityErrors[1].Path,
+		}
+		require.Contains(t, errPaths, []interface{}{"_entities", float64(2)})
+		require.Contains(t, errPaths, []interface{}{"_entities", float64(4)})
 
 		require.Len(t, resp.Entities, 5)
 		require.Equal(t, "first name - 1", resp.Entities[0].Name)
@@ -388,6 +394,84 @@ func TestEntityResolver(t *testing.T) {
 		require.Equal(t, "mars", resp.Entities[1].Name)
 		require.Equal(t, "B", resp.Entities[1].World.Foo)
 	})
+
+	t.Run("PlanetRequiresNested entities with requires directive having nested list field", func(t *testing.T) {
+		representations := []map[string]interface{}{
+			{
+				"__typename": "PlanetRequiresNested",
+				"name":       "earth",
+				"world": map[string]interface{}{
+					"foo": "A",
+				},
+				"worlds": []interface{}{
+					map[string]interface{}{"foo": "B"},
+					map[string]interface{}{"foo": "C"},
+				},
+			},
+		}
+
+		var resp struct {
+			Entities []struct {
+				Name   string `json:"name"`
+				Worlds []struct {
+					Foo string `json:"foo"`
+				} `json:"worlds"`
+			} `json:"_entities"`
+		}
+
+		err := c.Post(
+			entityQuery([]string{
+				"PlanetRequiresNested {name, worlds { foo }}",
+			}),
+			&resp,
+			client.Var("representations", representations),
+		)
+
+		require.NoError(t, err)
+		require.Equal(t, "earth", resp.Entities[0].Name)
+		require.Len(t, resp.Entities[0].Worlds, 2)
+		require.Equal(t, "B", resp.Entities[0].Worlds[0].Foo)
+		require.Equal(t, "C", resp.Entities[0].Worlds[1].Foo)
+	})
+}
+
+func TestEntityResolverConcurrency(t *testing.T) {
+	resolver := &entityresolver.Resolver{}
+	c := client.New(handler.NewDefaultServer(
+		generated.NewExecutableSchema(generated.Config{
+			Resolvers: resolver,
+		}),
+	))
+
+	representations := []map[string]interface{}{}
+	for i := 0; i < 10; i++ {
+		representations = append(representations, map[string]interface{}{
+			"__typename": "Concurrent",
+			"id":         strconv.Itoa(i),
+		})
+	}
+
+	var resp struct {
+		Entities []struct {
+			ID string `json:"id"`
+		} `json:"_entities"`
+	}
+
+	err := c.Post(
+		entityQuery([]string{
+			"Concurrent {id}",
+		}),
+		&resp,
+		client.Var("representations", representations),
+	)
+
+	require.NoError(t, err)
+	require.Len(t, resp.Entities, 10)
+	for i, e := range resp.Entities {
+		require.Equal(t, strconv.Itoa(i), e.ID)
+	}
+	// gqlgen.yml sets entity_concurrency: 2
+	require.LessOrEqual(t, resolver.ConcurrentMax, int32(2))
 }
 
 func TestMultiEntityResolver(t *testing.T) {
@@ -500,11 +584,50 @@ func TestMultiEntityResolver(t *testing.T) {
 			client.Var("representations", representations),
 		)
 
+		require.Error(t, err)
+		entityErrors, err := getEntityErrors(err)
+		require.NoError(t, err)
+		require.Len(t, entityErrors, itemCount)
+		for i, e := range entityErrors {
+			require.Contains(t, e.Message, "error resolving MultiHelloWorldWithError")
+			require.Equal(t, []interface{}{"_entities", float64(i)}, e.Path)
+		}
+		require.Len(t, resp.Entities, itemCount)
+	})
+
+	t.Run("MultiHelloShort entities left out by the resolver are null", func(t *testing.T) {
+		representations := []map[string]interface{}{}
+		for i := 0; i < 3; i++ {
+			representations = append(representations, map[string]interface{}{
+				"__typename": "MultiHelloShort",
+				"name":       "world name - " + strconv.Itoa(i),
+			})
+		}
+
+		var resp struct {
+			Entities []*struct {
+				Name string `json:"name"`
+			} `json:"_entities"`
+		}
+
+		err := c.Post(
+			entityQuery([]string{
+				"MultiHelloShort {name}",
+			}),
+			&resp,
+			client.Var("representations", representations),
+		)
+
 		require.Error(t, err)
 		entityErrors, err := getEntityErrors(err)
 		require.NoError(t, err)
 		require.Len(t, entityErrors, 1)
-		require.Contains(t, entityErrors[0].Message, "error resolving MultiHelloWorldWithError")
+		require.Contains(t, entityErrors[0].Message, "FindManyMultiHelloShortByNames returned 2 entities for 3 representations")
+		require.Equal(t, []interface{}{"_entities", float64(2)}, entityErrors[0].Path)
+		require.Len(t, resp.Entities, 3)
+		require.Equal(t, "world name - 0 - from multiget", resp.Entities[0].Name)
+		require.Equal(t, "world name - 1 - from multiget", resp.Entities[1].Name)
+		require.Nil(t, resp.Entities[2])
 	})
 
 	t.Run("MultiHelloRequires entities with requires directive", func(t *testing.T) {
@@ -636,8 +759,8 @@ func entityQuery(queries []string) string {
 }
 
 type entityResolverError struct {
-	Message string   `json:"message"`
-	Path    []string `json:"path"`
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path"`
 }
 
 func getEntityErrors(err error) ([]*entityResolverError, error) {
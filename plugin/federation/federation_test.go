@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
 
 	"github.com/99designs/gqlgen/codegen"
 	"github.com/99designs/gqlgen/codegen/config"
@@ -168,6 +169,70 @@ func TestCodeGenerationFederation2(t *testing.T) {
 	require.NoError(t, f.GenerateCode(data))
 }
 
+func TestNonResolvableKeys(t *testing.T) {
+	f, cfg := load(t, "testdata/federation2/resolvable.yml")
+	require.NoError(t, f.MutateConfig(cfg))
+
+	require.Equal(t, "Hello", f.Entities[0].Name)
+	require.Empty(t, f.Entities[0].Resolvers)
+	require.Equal(t, "World", f.Entities[1].Name)
+	require.Len(t, f.Entities[1].Resolvers, 1)
+	require.Equal(t, "findWorldByFoo", f.Entities[1].Resolvers[0].ResolverName)
+
+	require.NotNil(t, cfg.Schema.Types["Hello"].Fields.ForName("secondary"))
+	require.Nil(t, cfg.Schema.Types["Entity"].Fields.ForName("findHelloByName"))
+	require.Nil(t, cfg.Schema.Types["Entity"].Fields.ForName("findWorldByBar"))
+}
+
+func TestNamespacedDirectives(t *testing.T) {
+	f, cfg := load(t, "testdata/federation2/namespaced.yml")
+	require.NoError(t, f.MutateConfig(cfg))
+
+	require.Equal(t, "Hello", f.Entities[0].Name)
+	require.Len(t, f.Entities[0].Resolvers, 1)
+	require.Equal(t, "World", f.Entities[1].Name)
+	require.Len(t, f.Entities[1].Resolvers, 1)
+	require.Equal(t, "findWorldByFoo", f.Entities[1].Resolvers[0].ResolverName)
+
+	hello := cfg.Schema.Types["Hello"]
+	require.NotNil(t, hello.Directives.ForName("key"))
+	require.Nil(t, hello.Directives.ForName("federation__key"))
+	require.NotNil(t, hello.Fields.ForName("secondary").Directives.ForName("shareable"))
+	require.True(t, cfg.Directives["federation__key"].SkipRuntime)
+
+	_, err := codegen.BuildData(cfg)
+	require.NoError(t, err)
+}
+
+func TestCheckLinks(t *testing.T) {
+	tests := []struct {
+		name, link, err string
+	}{
+		{"imports", `@link(url: "https://specs.apollo.dev/federation/v2.7", import: ["@key", "@shareable"])`, ""},
+		{"default namespace", `@link(url: "https://specs.apollo.dev/federation/v2.7", as: "federation")`, ""},
+		{"import keeping its name", `@link(url: "https://specs.apollo.dev/federation/v2.7", import: [{name: "@key", as: "@key"}])`, ""},
+		{"other spec", `@link(url: "https://specs.apollo.dev/link/v1.0", as: "lnk", import: [{name: "Import", as: "LinkImport"}])`, ""},
+		{
+			"renamed namespace", `@link(url: "https://specs.apollo.dev/federation/v2.7", as: "fed")`,
+			`schema.graphql:1: @link(as: "fed") is not supported, use the default federation namespace`,
+		},
+		{
+			"renamed import", `@link(url: "https://specs.apollo.dev/federation/v2.7", import: ["@shareable", {name: "@key", as: "@myKey"}])`,
+			`schema.graphql:1: @link import {name:"@key",as:"@myKey"} is not supported, renamed imports must keep their name`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkLinks([]*ast.Source{{Name: "schema.graphql", Input: "extend schema " + tc.link + "\ntype Query { a: Int }"}})
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
 // This test is to ensure that the input arguments are not
 // changed when cfg.OmitSliceElementPointers is false OR true
 func TestMultiWithOmitSliceElemPointersCfg(t *testing.T) {
@@ -277,6 +342,119 @@ func TestInjectSourceLate(t *testing.T) {
 	}
 }
 
+func TestOverrides(t *testing.T) {
+	f, cfg := load(t, "testdata/federation2/federation2.yml")
+	cfg.Federation.Overrides = map[string]config.FederationOverride{
+		"Hello.name": {From: "new-service", Label: "percent(25)"},
+		"World.bar":  {From: "old-service"},
+	}
+	require.NoError(t, f.MutateConfig(cfg))
+
+	dir := cfg.Schema.Types["Hello"].Fields.ForName("name").Directives.ForNames("override")
+	require.Len(t, dir, 1)
+	require.Equal(t, "new-service", dir[0].Arguments.ForName("from").Value.Raw)
+	require.Equal(t, "percent(25)", dir[0].Arguments.ForName("label").Value.Raw)
+
+	sdl, err := serviceSDL(cfg.Sources, cfg.Schema, cfg.Federation.Overrides)
+	require.NoError(t, err)
+	require.Contains(t, sdl, `name: String! @override(from: "new-service", label: "percent(25)")`+"\n")
+	require.Contains(t, sdl, `bar: Int! @override(from: "old-service")`+"\n")
+	require.NotContains(t, sdl, "old-service\", label")
+	require.NotContains(t, sdl, "_entities")
+
+	t.Run("unknown field", func(t *testing.T) {
+		overrides := map[string]config.FederationOverride{"Hello.missing": {From: "a"}}
+		require.EqualError(t, checkOverrides(cfg.Schema, 2, overrides), "federation override Hello.missing: field missing not found on Hello")
+	})
+
+	t.Run("invalid label", func(t *testing.T) {
+		overrides := map[string]config.FederationOverride{"Hello.name": {From: "a", Label: "percent(101)"}}
+		require.ErrorContains(t, checkOverrides(cfg.Schema, 2, overrides), "invalid label")
+	})
+
+	t.Run("federation 1", func(t *testing.T) {
+		f, cfg := load(t, "testdata/allthethings/gqlgen.yml")
+		cfg.Federation.Overrides = map[string]config.FederationOverride{"Hello.name": {From: "a"}}
+		require.EqualError(t, f.MutateConfig(cfg), "federation overrides require federation version 2")
+	})
+}
+
+func TestFieldSets(t *testing.T) {
+	f, cfg := load(t, "testdata/federation2/fieldsets.yml")
+	require.EqualError(t, f.MutateConfig(cfg), `invalid federation field sets:
+testdata/federation2/fieldsets.graphql:10: @requires on Product.missing: field cost not found on Product
+testdata/federation2/fieldsets.graphql:11: @requires on Product.local: field Product.weight must be marked @external, it is resolved by another subgraph
+testdata/federation2/fieldsets.graphql:12: @requires on Product.leaf: field Product.price returns Int, which has no subfields to select
+testdata/federation2/fieldsets.graphql:13: @requires on Product.object: field Product.dimensions returns Dimensions and must select its subfields
+testdata/federation2/fieldsets.graphql:24: @provides on Review.scaled: field Dimensions.scaled takes arguments and can't be part of a field set
+testdata/federation2/fieldsets.graphql:25: @provides on Review.text: field returns String, which is not an object or interface`)
+
+	t.Run("federation 1 doesn't need @external", func(t *testing.T) {
+		f, cfg := load(t, "testdata/allthethings/gqlgen.yml")
+		require.NoError(t, checkFieldSets(cfg.Schema, f.Version))
+	})
+}
+
+func TestSDLHooks(t *testing.T) {
+	sdl := `directive @internal(reason: String = "hidden") on FIELD_DEFINITION | OBJECT
+
+"""
+A greeting
+"""
+type Hello @key(fields: "name") @internal {
+	# kept, comments aren't served
+	"the name"
+	name: String! @internal(reason: "pii") @shareable
+	list(sep: String = ","): [String!] @tag(names: ["a", "b"])
+}
+`
+
+	t.Run("strip directives", func(t *testing.T) {
+		out, err := StripDirectives("internal")(nil, sdl)
+		require.NoError(t, err)
+		require.Equal(t, `
+"""
+A greeting
+"""
+type Hello @key(fields: "name") {
+	# kept, comments aren't served
+	"the name"
+	name: String! @shareable
+	list(sep: String = ","): [String!] @tag(names: ["a", "b"])
+}
+`, out)
+	})
+
+	t.Run("strip descriptions", func(t *testing.T) {
+		out, err := StripDescriptions(nil, sdl)
+		require.NoError(t, err)
+		require.Equal(t, `directive @internal(reason: String = "hidden") on FIELD_DEFINITION | OBJECT
+
+type Hello @key(fields: "name") @internal {
+	# kept, comments aren't served
+	name: String! @internal(reason: "pii") @shareable
+	list(sep: String = ","): [String!] @tag(names: ["a", "b"])
+}
+`, out)
+	})
+
+	t.Run("plugin option", func(t *testing.T) {
+		p := New(2, WithSDLHooks(StripDirectives("shareable"), func(_ *ast.Schema, sdl string) (string, error) {
+			return sdl + "\nextend type Hello @tag(name: \"public\")\n", nil
+		}))
+		out, err := p.(*federation).applySDLHooks(nil, sdl)
+		require.NoError(t, err)
+		require.NotContains(t, out, "@shareable")
+		require.Contains(t, out, `extend type Hello @tag(name: "public")`)
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		p := New(2, WithSDLHooks(func(_ *ast.Schema, sdl string) (string, error) { return "", nil }))
+		_, err := p.(*federation).applySDLHooks(nil, sdl)
+		require.EqualError(t, err, "federation sdl hook returned an empty schema")
+	})
+}
+
 func load(t *testing.T, name string) (*federation, *config.Config) {
 	t.Helper()
 
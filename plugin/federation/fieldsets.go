@@ -0,0 +1,133 @@
+package federation
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/plugin/federation/fieldset"
+)
+
+// checkFieldSets validates the field sets given to @requires and @provides, so a typo or a field this subgraph can't
+// get from the router is reported against the schema here rather than when the router composes the supergraph.
+func checkFieldSets(schema *ast.Schema, version int) error {
+	names := make([]string, 0, len(schema.Types))
+	for name, def := range schema.Types {
+		if def.BuiltIn || def.Position == nil || def.Position.Src == nil || def.Position.Src.BuiltIn {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []string
+	for _, name := range names {
+		def := schema.Types[name]
+		for _, field := range def.Fields {
+			for _, dir := range field.Directives {
+				if dir.Name != "requires" && dir.Name != "provides" {
+					continue
+				}
+				for _, msg := range checkFieldSet(schema, version, def, field, dir) {
+					errs = append(errs, fmt.Sprintf("%s@%s on %s.%s: %s", positionPrefix(dir.Position), dir.Name, def.Name, field.Name, msg))
+				}
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid federation field sets:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func checkFieldSet(schema *ast.Schema, version int, def *ast.Definition, field *ast.FieldDefinition, dir *ast.Directive) []string {
+	arg := dir.Arguments.ForName("fields")
+	if arg == nil || arg.Value == nil || strings.TrimSpace(arg.Value.Raw) == "" {
+		return []string{"fields must be set"}
+	}
+	// fragments aren't understood by the field set parser, leave those to the router
+	if strings.Contains(arg.Value.Raw, "...") {
+		return nil
+	}
+
+	// @requires selects from the entity the field is on, @provides from the type the field returns
+	target := def
+	if dir.Name == "provides" {
+		target = schema.Types[field.Type.Name()]
+		if target == nil || (target.Kind != ast.Object && target.Kind != ast.Interface) {
+			return []string{fmt.Sprintf("field returns %s, which is not an object or interface", field.Type.Name())}
+		}
+	}
+
+	var errs []string
+	for _, path := range fieldset.New(arg.Value.Raw, nil) {
+		if msg := checkFieldSetPath(schema, version, target, path); msg != "" {
+			errs = append(errs, msg)
+		}
+	}
+	return errs
+}
+
+// checkFieldSetPath walks a single path of a field set from def and describes the first problem found on it.
+func checkFieldSetPath(schema *ast.Schema, version int, def *ast.Definition, path fieldset.Field) string {
+	for i, name := range path {
+		field := def.Fields.ForName(name)
+		if field == nil {
+			return fmt.Sprintf("field %s not found on %s", name, def.Name)
+		}
+		if len(field.Arguments) > 0 {
+			return fmt.Sprintf("field %s.%s takes arguments and can't be part of a field set", def.Name, name)
+		}
+		// the router only sends fields this subgraph can't resolve itself, key fields are always part of the
+		// representation. @external on the type marks all of its fields.
+		if i == 0 && version == 2 && !isExternal(def, field) && !isKeyFieldName(def, name) {
+			return fmt.Sprintf("field %s.%s must be marked @external, it is resolved by another subgraph", def.Name, name)
+		}
+
+		fieldType := schema.Types[field.Type.Name()]
+		if fieldType == nil {
+			return fmt.Sprintf("type %s of field %s.%s not found", field.Type.Name(), def.Name, name)
+		}
+		composite := fieldType.Kind == ast.Object || fieldType.Kind == ast.Interface || fieldType.Kind == ast.Union
+		last := i == len(path)-1
+		if last && composite {
+			return fmt.Sprintf("field %s.%s returns %s and must select its subfields", def.Name, name, fieldType.Name)
+		}
+		if !last && !composite {
+			return fmt.Sprintf("field %s.%s returns %s, which has no subfields to select", def.Name, name, fieldType.Name)
+		}
+		def = fieldType
+	}
+	return ""
+}
+
+// isExternal reports whether field of def is resolved by another subgraph, from @external on the field or on def.
+func isExternal(def *ast.Definition, field *ast.FieldDefinition) bool {
+	return field.Directives.ForName("external") != nil || def.Directives.ForName("external") != nil
+}
+
+// isKeyFieldName reports whether name is at the top level of one of the keys of def.
+func isKeyFieldName(def *ast.Definition, name string) bool {
+	for _, key := range def.Directives.ForNames("key") {
+		fields := key.Arguments.ForName("fields")
+		if fields == nil || fields.Value == nil {
+			continue
+		}
+		for _, path := range fieldset.New(fields.Value.Raw, nil) {
+			if len(path) > 0 && path[0] == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func positionPrefix(pos *ast.Position) string {
+	if pos == nil || pos.Src == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d: ", pos.Src.Name, pos.Line)
+}
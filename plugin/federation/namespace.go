@@ -0,0 +1,113 @@
+package federation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// federationNamespace prefixes federation 2 directives that a schema uses without importing them through @link, eg
+// @federation__key. Composition output and other tooling write directives this way.
+const federationNamespace = "federation__"
+
+// namespacedDirectives are the directives declared a second time under federationNamespace.
+var namespacedDirectives = []string{
+	"authenticated", "composeDirective", "extends", "external", "key", "inaccessible", "interfaceObject",
+	"override", "policy", "provides", "requires", "requiresScopes", "shareable", "tag",
+}
+
+var directiveDefinition = regexp.MustCompile(`directive @(\w+)`)
+
+// namespaceDirectives copies the directive definitions in src under federationNamespace.
+func namespaceDirectives(src string) string {
+	return directiveDefinition.ReplaceAllString(src, "directive @"+federationNamespace+"$1")
+}
+
+// normalizeDirectives renames namespaced federation directives used in schema to their plain names, so the rest of
+// the plugin and codegen only ever see @key, @requires and so on. The served SDL is left as written.
+func normalizeDirectives(schema *ast.Schema) {
+	normalize := func(list ast.DirectiveList) {
+		for _, d := range list {
+			name, ok := strings.CutPrefix(d.Name, federationNamespace)
+			if !ok || !isNamespacedDirective(name) {
+				continue
+			}
+			d.Name = name
+			if def := schema.Directives[name]; def != nil {
+				d.Definition = def
+			}
+		}
+	}
+
+	for _, def := range schema.Types {
+		normalize(def.Directives)
+		for _, field := range def.Fields {
+			normalize(field.Directives)
+			for _, arg := range field.Arguments {
+				normalize(arg.Directives)
+			}
+		}
+		for _, value := range def.EnumValues {
+			normalize(value.Directives)
+		}
+	}
+}
+
+func isNamespacedDirective(name string) bool {
+	for _, n := range namespacedDirectives {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkLinks returns an error when a @link to the federation spec in sources renames it, with as or with an aliased
+// import. The plugin only knows the directives under their own names and the federation namespace, so it would
+// otherwise generate code as if the renamed directives were not there.
+func checkLinks(sources []*ast.Source) error {
+	for _, src := range sources {
+		if src.BuiltIn {
+			continue
+		}
+		doc, err := parser.ParseSchema(src)
+		if err != nil {
+			continue
+		}
+		for _, def := range append(doc.Schema, doc.SchemaExtension...) {
+			for _, link := range def.Directives.ForNames("link") {
+				if err := checkLink(link); err != nil {
+					return fmt.Errorf("%s%w", positionPrefix(link.Position), err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkLink(link *ast.Directive) error {
+	url := link.Arguments.ForName("url")
+	if url == nil || !strings.Contains(url.Value.Raw, "specs.apollo.dev/federation/") {
+		return nil
+	}
+	if as := link.Arguments.ForName("as"); as != nil && as.Value.Raw != "federation" {
+		return fmt.Errorf("@link(as: %q) is not supported, use the default federation namespace", as.Value.Raw)
+	}
+	imports := link.Arguments.ForName("import")
+	if imports == nil {
+		return nil
+	}
+	for _, imp := range imports.Value.Children {
+		if imp.Value.Kind != ast.ObjectValue {
+			continue
+		}
+		name, as := imp.Value.Children.ForName("name"), imp.Value.Children.ForName("as")
+		if as != nil && (name == nil || as.Raw != name.Raw) {
+			return fmt.Errorf("@link import %s is not supported, renamed imports must keep their name", imp.Value.String())
+		}
+	}
+	return nil
+}
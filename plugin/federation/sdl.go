@@ -0,0 +1,221 @@
+package federation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/lexer"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+// overrideLabel matches the labels the router understands, either a percentage of traffic or a custom label
+// resolved by a router plugin.
+var overrideLabel = regexp.MustCompile(`^(percent\((100|\d{1,2})(\.\d{1,8})?\)|[a-zA-Z][a-zA-Z0-9_\-:.]*)$`)
+
+// checkOverrides makes sure every configured override points at a field in the schema.
+func checkOverrides(schema *ast.Schema, version int, overrides map[string]config.FederationOverride) error {
+	if len(overrides) > 0 && version != 2 {
+		return fmt.Errorf("federation overrides require federation version 2")
+	}
+	for name, o := range overrides {
+		if _, err := overrideField(schema, name); err != nil {
+			return err
+		}
+		if o.From == "" {
+			return fmt.Errorf("federation override %s: from must be set", name)
+		}
+		if o.Label != "" && !overrideLabel.MatchString(o.Label) {
+			return fmt.Errorf("federation override %s: invalid label %q, expected percent(0-100) or a custom label", name, o.Label)
+		}
+	}
+	return nil
+}
+
+func overrideField(schema *ast.Schema, name string) (*ast.FieldDefinition, error) {
+	typeName, fieldName, ok := strings.Cut(name, ".")
+	if !ok {
+		return nil, fmt.Errorf("federation override %s: expected Type.field", name)
+	}
+	def := schema.Types[typeName]
+	if def == nil {
+		return nil, fmt.Errorf("federation override %s: type %s not found", name, typeName)
+	}
+	field := def.Fields.ForName(fieldName)
+	if field == nil {
+		return nil, fmt.Errorf("federation override %s: field %s not found on %s", name, fieldName, typeName)
+	}
+	return field, nil
+}
+
+// setOverrideDirective puts the directive for o on field, replacing any @override it already has so the schema seen
+// by the rest of codegen matches the SDL.
+func setOverrideDirective(field *ast.FieldDefinition, o config.FederationOverride) {
+	dir := &ast.Directive{
+		Name:     "override",
+		Position: field.Position,
+		Arguments: ast.ArgumentList{
+			{Name: "from", Value: &ast.Value{Kind: ast.StringValue, Raw: o.From}},
+		},
+	}
+	if o.Label != "" {
+		dir.Arguments = append(dir.Arguments, &ast.Argument{Name: "label", Value: &ast.Value{Kind: ast.StringValue, Raw: o.Label}})
+	}
+
+	for i, d := range field.Directives {
+		if d.Name == "override" {
+			dir.Definition = d.Definition
+			field.Directives[i] = dir
+			return
+		}
+	}
+	field.Directives = append(field.Directives, dir)
+}
+
+// overrideEdit replaces the runes between start and end with the directive for override.
+type overrideEdit struct {
+	start, end int
+	insert     bool
+	override   config.FederationOverride
+}
+
+func (o overrideEdit) directive() string {
+	s := "@override(from: " + strconv.Quote(o.override.From)
+	if o.override.Label != "" {
+		s += ", label: " + strconv.Quote(o.override.Label)
+	}
+	s += ")"
+	if o.insert {
+		s = " " + s
+	}
+	return s
+}
+
+// serviceSDL returns the SDL served from _service with the configured overrides written into the schema sources.
+// The edits are made on the source text rather than by formatting the schema, so comments and layout survive.
+func serviceSDL(sources []*ast.Source, schema *ast.Schema, overrides map[string]config.FederationOverride) (string, error) {
+	edits := map[*ast.Source][]overrideEdit{}
+	for name, o := range overrides {
+		field, err := overrideField(schema, name)
+		if err != nil {
+			return "", err
+		}
+		if field.Position == nil || field.Position.Src == nil {
+			return "", fmt.Errorf("federation override %s: field has no source position", name)
+		}
+		edit, err := findOverrideEdit(field.Position)
+		if err != nil {
+			return "", fmt.Errorf("federation override %s: %w", name, err)
+		}
+		edit.override = o
+		edits[field.Position.Src] = append(edits[field.Position.Src], edit)
+	}
+
+	var sdl []string
+	for _, src := range sources {
+		if src.BuiltIn {
+			continue
+		}
+		input := []rune(src.Input)
+		list := edits[src]
+		sort.Slice(list, func(i, j int) bool { return list[i].start > list[j].start })
+		for _, e := range list {
+			input = append(input[:e.start:e.start], append([]rune(e.directive()), input[e.end:]...)...)
+		}
+		sdl = append(sdl, string(input))
+	}
+	return strings.Join(sdl, "\n"), nil
+}
+
+// findOverrideEdit lexes the field definition starting at pos and returns the span of its @override directive, or an
+// empty span just after the field type if it doesn't have one yet.
+func findOverrideEdit(pos *ast.Position) (overrideEdit, error) {
+	lex := lexer.New(pos.Src)
+	var tokens []lexer.Token
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			return overrideEdit{}, err
+		}
+		if tok.Kind == lexer.EOF {
+			break
+		}
+		if tok.Kind == lexer.Comment || tok.Pos.Start < pos.Start {
+			continue
+		}
+		tokens = append(tokens, tok)
+	}
+
+	i := 0
+	next := func() lexer.Token {
+		if i >= len(tokens) {
+			return lexer.Token{Kind: lexer.EOF}
+		}
+		i++
+		return tokens[i-1]
+	}
+	peek := func() lexer.Type {
+		if i >= len(tokens) {
+			return lexer.EOF
+		}
+		return tokens[i].Kind
+	}
+	skipParens := func() {
+		for depth := 0; peek() != lexer.EOF; {
+			switch next().Kind {
+			case lexer.ParenL:
+				depth++
+			case lexer.ParenR:
+				depth--
+			}
+			if depth == 0 {
+				return
+			}
+		}
+	}
+
+	if peek() == lexer.String || peek() == lexer.BlockString {
+		next()
+	}
+	if next().Kind != lexer.Name {
+		return overrideEdit{}, fmt.Errorf("unexpected token at field definition")
+	}
+	if peek() == lexer.ParenL {
+		skipParens()
+	}
+	if next().Kind != lexer.Colon {
+		return overrideEdit{}, fmt.Errorf("expected : after field name")
+	}
+
+	var typeEnd int
+	for peek() == lexer.BracketL {
+		next()
+	}
+	if tok := next(); tok.Kind == lexer.Name {
+		typeEnd = tok.Pos.End
+	} else {
+		return overrideEdit{}, fmt.Errorf("expected field type")
+	}
+	for peek() == lexer.BracketR || peek() == lexer.Bang {
+		typeEnd = next().Pos.End
+	}
+
+	for peek() == lexer.At {
+		at := next()
+		name := next()
+		end := name.Pos.End
+		if peek() == lexer.ParenL {
+			skipParens()
+			end = tokens[i-1].Pos.End
+		}
+		if name.Value == "override" {
+			return overrideEdit{start: at.Pos.Start, end: end}, nil
+		}
+	}
+
+	return overrideEdit{start: typeEnd, end: typeEnd, insert: true}, nil
+}
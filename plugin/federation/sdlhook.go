@@ -0,0 +1,213 @@
+package federation
+
+import (
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/lexer"
+)
+
+// SDLHook transforms the SDL served from _service before it is embedded in the generated code. It receives the loaded
+// schema for reference and the SDL returned by the previous hook, and must return the SDL to serve.
+type SDLHook = func(schema *ast.Schema, sdl string) (string, error)
+
+// StripDirectives returns a hook removing every usage of the named directives, along with their definitions, from the
+// SDL. Names are given without the @.
+func StripDirectives(names ...string) SDLHook {
+	strip := map[string]bool{}
+	for _, n := range names {
+		strip[n] = true
+	}
+
+	return func(_ *ast.Schema, sdl string) (string, error) {
+		tokens, err := sdlTokens(sdl)
+		if err != nil {
+			return "", err
+		}
+		t := &tokenReader{tokens: tokens}
+		var spans []sdlSpan
+		for t.peek() != lexer.EOF {
+			tok := t.next()
+			switch {
+			case tok.Kind == lexer.Name && tok.Value == "directive" && t.peek() == lexer.At:
+				start := tok.Pos.Start
+				if i := t.i - 2; i >= 0 && (tokens[i].Kind == lexer.String || tokens[i].Kind == lexer.BlockString) {
+					start = tokens[i].Pos.Start
+				}
+				t.next()
+				name := t.next()
+				end := t.skipDirectiveDefinition()
+				if strip[name.Value] {
+					spans = append(spans, sdlSpan{start: start, end: end, line: true})
+				}
+			case tok.Kind == lexer.At:
+				name := t.next()
+				end := name.Pos.End
+				if t.peek() == lexer.ParenL {
+					end = t.skipParens()
+				}
+				if strip[name.Value] {
+					spans = append(spans, sdlSpan{start: tok.Pos.Start, end: end})
+				}
+			}
+		}
+		return removeSpans(sdl, spans), nil
+	}
+}
+
+// StripDescriptions is a hook removing every description from the SDL. Comments are left alone, they aren't part of
+// the schema the router sees.
+func StripDescriptions(_ *ast.Schema, sdl string) (string, error) {
+	tokens, err := sdlTokens(sdl)
+	if err != nil {
+		return "", err
+	}
+
+	var spans []sdlSpan
+	brackets := 0
+	for i, tok := range tokens {
+		switch tok.Kind {
+		case lexer.BracketL:
+			brackets++
+		case lexer.BracketR:
+			brackets--
+		case lexer.String, lexer.BlockString:
+			// strings following : or =, or inside a list, are argument and default values
+			if brackets > 0 || (i > 0 && (tokens[i-1].Kind == lexer.Colon || tokens[i-1].Kind == lexer.Equals)) {
+				continue
+			}
+			spans = append(spans, sdlSpan{start: tok.Pos.Start, end: tok.Pos.End, line: true})
+		}
+	}
+	return removeSpans(sdl, spans), nil
+}
+
+// sdlSpan is a range of runes to remove. Removing a line span also removes the rest of the line when nothing else is
+// left on it, so stripped definitions don't leave blank lines behind.
+type sdlSpan struct {
+	start, end int
+	line       bool
+}
+
+func removeSpans(sdl string, spans []sdlSpan) string {
+	input := []rune(sdl)
+	isSpace := func(r rune) bool { return r == ' ' || r == '\t' }
+
+	// spans are found in order and never overlap, so removing them back to front keeps the offsets valid
+	for i := len(spans) - 1; i >= 0; i-- {
+		start, end := spans[i].start, spans[i].end
+		if spans[i].line {
+			lineStart := start
+			for lineStart > 0 && isSpace(input[lineStart-1]) {
+				lineStart--
+			}
+			lineEnd := end
+			for lineEnd < len(input) && isSpace(input[lineEnd]) {
+				lineEnd++
+			}
+			if lineEnd < len(input) && input[lineEnd] == '\r' {
+				lineEnd++
+			}
+			if lineEnd < len(input) && input[lineEnd] == '\n' {
+				lineEnd++
+			}
+			if lineEnd == len(input) || input[lineEnd-1] == '\n' {
+				end = lineEnd
+				if lineStart == 0 || input[lineStart-1] == '\n' {
+					start = lineStart
+				}
+			} else {
+				// something else follows on the same line, only drop the separating space
+				end = lineEnd
+			}
+		} else {
+			for start > 0 && isSpace(input[start-1]) {
+				start--
+			}
+		}
+		input = append(input[:start:start], input[end:]...)
+	}
+	return string(input)
+}
+
+// sdlTokens lexes sdl, dropping comments.
+func sdlTokens(sdl string) ([]lexer.Token, error) {
+	lex := lexer.New(&ast.Source{Input: sdl})
+	var tokens []lexer.Token
+	for {
+		tok, err := lex.ReadToken()
+		if err != nil {
+			return nil, err
+		}
+		if tok.Kind == lexer.EOF {
+			return tokens, nil
+		}
+		if tok.Kind != lexer.Comment {
+			tokens = append(tokens, tok)
+		}
+	}
+}
+
+type tokenReader struct {
+	tokens []lexer.Token
+	i      int
+}
+
+func (t *tokenReader) next() lexer.Token {
+	if t.i >= len(t.tokens) {
+		return lexer.Token{Kind: lexer.EOF}
+	}
+	t.i++
+	return t.tokens[t.i-1]
+}
+
+func (t *tokenReader) peek() lexer.Type {
+	if t.i >= len(t.tokens) {
+		return lexer.EOF
+	}
+	return t.tokens[t.i].Kind
+}
+
+// skipParens consumes a parenthesized group and returns the offset just after it.
+func (t *tokenReader) skipParens() int {
+	end := 0
+	for depth := 0; t.peek() != lexer.EOF; {
+		tok := t.next()
+		end = tok.Pos.End
+		switch tok.Kind {
+		case lexer.ParenL:
+			depth++
+		case lexer.ParenR:
+			depth--
+		}
+		if depth == 0 {
+			break
+		}
+	}
+	return end
+}
+
+// skipDirectiveDefinition consumes the rest of a directive definition after its name and returns the offset just
+// after its last location.
+func (t *tokenReader) skipDirectiveDefinition() int {
+	end := 0
+	if t.peek() == lexer.ParenL {
+		end = t.skipParens()
+	}
+	for t.peek() == lexer.Name {
+		tok := t.next()
+		end = tok.Pos.End
+		if tok.Value == "on" {
+			break
+		}
+	}
+	if t.peek() == lexer.Pipe {
+		t.next()
+	}
+	for t.peek() == lexer.Name {
+		end = t.next().Pos.End
+		if t.peek() != lexer.Pipe {
+			break
+		}
+		t.next()
+	}
+	return end
+}
@@ -9,8 +9,10 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
 	"github.com/99designs/gqlgen/plugin/federation/testdata/allthethings/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 var (
@@ -45,6 +47,23 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		r []map[string]interface{}
 	}{}
 
+	// entityContext scopes ctx to the representation at index i, so errors are reported against the entity they belong
+	// to and the router can null out just that entity while the rest of the batch resolves
+	entityContext := func(i int) context.Context {
+		return graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+	}
+	entityError := func(i int, err error) {
+		ctx := entityContext(i)
+		var gqlErr *gqlerror.Error
+		if errors.As(err, &gqlErr) {
+			// the same error may be reported for every entity in a group, each needs its own path
+			e := *gqlErr
+			e.Path = graphql.GetPath(ctx)
+			err = &e
+		}
+		ec.Error(ctx, err)
+	}
+
 	// We group entities by typename so that we can parallelize their resolution.
 	// This is particularly helpful when there are entity groups in multi mode.
 	buildRepresentationGroups := func(reps []map[string]interface{}) {
@@ -53,7 +72,7 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			if !ok {
 				// If there is no __typename, we just skip the representation;
 				// we just won't be resolving these unknown types.
-				ec.Error(ctx, errors.New("__typename must be an existing string"))
+				ec.Error(entityContext(i), errors.New("__typename must be an existing string"))
 				continue
 			}
 
@@ -184,9 +203,14 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 				if err != nil {
 					return err
 				}
-				entity.Hello.Secondary, err = ec.unmarshalNString2string(ctx, rep["hello"].(map[string]interface{})["secondary"])
-				if err != nil {
-					return err
+				if m0, ok := rep["hello"].(map[string]interface{}); ok {
+					if entity.Hello == nil {
+						entity.Hello = new(model.Hello)
+					}
+					entity.Hello.Secondary, err = ec.unmarshalNString2string(ctx, m0["secondary"])
+					if err != nil {
+						return err
+					}
 				}
 				list[idx[i]] = entity
 				return nil
@@ -265,7 +289,13 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloMultiKeyByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
 					list[idx[i]] = entity
 				}
 				return nil
@@ -289,7 +319,13 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloMultiKeyByKey2s returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
 					list[idx[i]] = entity
 				}
 				return nil
@@ -307,7 +343,10 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		if isMulti(typeName) {
 			err := resolveManyEntities(ctx, typeName, reps, idx)
 			if err != nil {
-				ec.Error(ctx, err)
+				// the whole group failed, every entity in it is null
+				for _, i := range idx {
+					entityError(i, err)
+				}
 			}
 		} else {
 			// if there are multiple entities to resolve, parallelize (similar to
@@ -317,6 +356,7 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			for i, rep := range reps {
 				i, rep := i, rep
 				go func(i int, rep map[string]interface{}) {
+					ctx := entityContext(idx[i])
 					err := resolveEntity(ctx, typeName, rep, idx, i)
 					if err != nil {
 						ec.Error(ctx, err)
@@ -7,11 +7,28 @@ package entityresolver
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/plugin/federation/testdata/entityresolver/generated"
 	"github.com/99designs/gqlgen/plugin/federation/testdata/entityresolver/generated/model"
 )
 
+// FindConcurrentByID is the resolver for the findConcurrentByID field.
+func (r *entityResolver) FindConcurrentByID(ctx context.Context, id string) (*model.Concurrent, error) {
+	active := atomic.AddInt32(&r.concurrentActive, 1)
+	defer atomic.AddInt32(&r.concurrentActive, -1)
+	for {
+		max := atomic.LoadInt32(&r.ConcurrentMax)
+		if active <= max || atomic.CompareAndSwapInt32(&r.ConcurrentMax, max, active) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	return &model.Concurrent{ID: id}, nil
+}
+
 // FindHelloByName is the resolver for the findHelloByName field.
 func (r *entityResolver) FindHelloByName(ctx context.Context, name string) (*model.Hello, error) {
 	return &model.Hello{
@@ -76,6 +93,16 @@ func (r *entityResolver) FindManyMultiHelloRequiresByNames(ctx context.Context,
 	return results, nil
 }
 
+// FindManyMultiHelloShortByNames is the resolver for the findManyMultiHelloShortByNames field.
+func (r *entityResolver) FindManyMultiHelloShortByNames(ctx context.Context, reps []*model.MultiHelloShortByNamesInput) ([]*model.MultiHelloShort, error) {
+	// leaves out the last representation
+	results := make([]*model.MultiHelloShort, 0, len(reps))
+	for _, rep := range reps[:len(reps)-1] {
+		results = append(results, &model.MultiHelloShort{Name: rep.Name + " - from multiget"})
+	}
+	return results, nil
+}
+
 // FindManyMultiHelloWithErrorByNames is the resolver for the findManyMultiHelloWithErrorByNames field.
 func (r *entityResolver) FindManyMultiHelloWithErrorByNames(ctx context.Context, reps []*model.MultiHelloWithErrorByNamesInput) ([]*model.MultiHelloWithError, error) {
 	return nil, fmt.Errorf("error resolving MultiHelloWorldWithError")
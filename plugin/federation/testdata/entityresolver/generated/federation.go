@@ -9,8 +9,10 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
 	"github.com/99designs/gqlgen/plugin/federation/testdata/entityresolver/generated/model"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 var (
@@ -45,6 +47,26 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		r []map[string]interface{}
 	}{}
 
+	// limits how many entity resolvers run at the same time across all types
+	sem := make(chan struct{}, 2)
+
+	// entityContext scopes ctx to the representation at index i, so errors are reported against the entity they belong
+	// to and the router can null out just that entity while the rest of the batch resolves
+	entityContext := func(i int) context.Context {
+		return graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+	}
+	entityError := func(i int, err error) {
+		ctx := entityContext(i)
+		var gqlErr *gqlerror.Error
+		if errors.As(err, &gqlErr) {
+			// the same error may be reported for every entity in a group, each needs its own path
+			e := *gqlErr
+			e.Path = graphql.GetPath(ctx)
+			err = &e
+		}
+		ec.Error(ctx, err)
+	}
+
 	// We group entities by typename so that we can parallelize their resolution.
 	// This is particularly helpful when there are entity groups in multi mode.
 	buildRepresentationGroups := func(reps []map[string]interface{}) {
@@ -53,7 +75,7 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			if !ok {
 				// If there is no __typename, we just skip the representation;
 				// we just won't be resolving these unknown types.
-				ec.Error(ctx, errors.New("__typename must be an existing string"))
+				ec.Error(entityContext(i), errors.New("__typename must be an existing string"))
 				continue
 			}
 
@@ -72,6 +94,8 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			return true
 		case "MultiHelloRequires":
 			return true
+		case "MultiHelloShort":
+			return true
 		case "MultiHelloWithError":
 			return true
 		case "MultiPlanetRequiresNested":
@@ -91,6 +115,26 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		}()
 
 		switch typeName {
+		case "Concurrent":
+			resolverName, err := entityResolverNameForConcurrent(ctx, rep)
+			if err != nil {
+				return fmt.Errorf(`finding resolver for Entity "Concurrent": %w`, err)
+			}
+			switch resolverName {
+
+			case "findConcurrentByID":
+				id0, err := ec.unmarshalNID2string(ctx, rep["id"])
+				if err != nil {
+					return fmt.Errorf(`unmarshalling param 0 for findConcurrentByID(): %w`, err)
+				}
+				entity, err := ec.resolvers.Entity().FindConcurrentByID(ctx, id0)
+				if err != nil {
+					return fmt.Errorf(`resolving Entity "Concurrent": %w`, err)
+				}
+
+				list[idx[i]] = entity
+				return nil
+			}
 		case "Hello":
 			resolverName, err := entityResolverNameForHello(ctx, rep)
 			if err != nil {
@@ -224,9 +268,30 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return fmt.Errorf(`resolving Entity "PlanetRequiresNested": %w`, err)
 				}
 
-				entity.World.Foo, err = ec.unmarshalNString2string(ctx, rep["world"].(map[string]interface{})["foo"])
-				if err != nil {
-					return err
+				if m0, ok := rep["world"].(map[string]interface{}); ok {
+					if entity.World == nil {
+						entity.World = new(model.World)
+					}
+					entity.World.Foo, err = ec.unmarshalNString2string(ctx, m0["foo"])
+					if err != nil {
+						return err
+					}
+				}
+				if l0, ok := rep["worlds"].([]interface{}); ok {
+					if len(entity.Worlds) != len(l0) {
+						entity.Worlds = make([]*model.World, len(l0))
+					}
+					for i0, v0 := range l0 {
+						if m1, ok := v0.(map[string]interface{}); ok {
+							if entity.Worlds[i0] == nil {
+								entity.Worlds[i0] = new(model.World)
+							}
+							entity.Worlds[i0].Foo, err = ec.unmarshalNString2string(ctx, m1["foo"])
+							if err != nil {
+								return err
+							}
+						}
+					}
 				}
 				list[idx[i]] = entity
 				return nil
@@ -353,7 +418,13 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
 					list[idx[i]] = entity
 				}
 				return nil
@@ -388,14 +459,27 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
-					entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
-					if err != nil {
-						return err
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloMultipleRequiresByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
 					}
-					entity.Key2, err = ec.unmarshalNString2string(ctx, reps[i]["key2"])
+					entity := entities[i]
+					err := func() (err error) {
+						entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
+						if err != nil {
+							return err
+						}
+						entity.Key2, err = ec.unmarshalNString2string(ctx, reps[i]["key2"])
+						if err != nil {
+							return err
+						}
+						return nil
+					}()
 					if err != nil {
-						return err
+						entityError(idx[i], err)
+						continue
 					}
 					list[idx[i]] = entity
 				}
@@ -431,11 +515,65 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
-					entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloRequiresByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
+					err := func() (err error) {
+						entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
+						if err != nil {
+							return err
+						}
+						return nil
+					}()
 					if err != nil {
-						return err
+						entityError(idx[i], err)
+						continue
+					}
+					list[idx[i]] = entity
+				}
+				return nil
+
+			default:
+				return fmt.Errorf("unknown resolver: %s", resolverName)
+			}
+
+		case "MultiHelloShort":
+			resolverName, err := entityResolverNameForMultiHelloShort(ctx, reps[0])
+			if err != nil {
+				return fmt.Errorf(`finding resolver for Entity "MultiHelloShort": %w`, err)
+			}
+			switch resolverName {
+
+			case "findManyMultiHelloShortByNames":
+				_reps := make([]*model.MultiHelloShortByNamesInput, len(reps))
+
+				for i, rep := range reps {
+					id0, err := ec.unmarshalNString2string(ctx, rep["name"])
+					if err != nil {
+						return errors.New(fmt.Sprintf("Field %s undefined in schema.", "name"))
+					}
+
+					_reps[i] = &model.MultiHelloShortByNamesInput{
+						Name: id0,
+					}
+				}
+
+				entities, err := ec.resolvers.Entity().FindManyMultiHelloShortByNames(ctx, _reps)
+				if err != nil {
+					return err
+				}
+
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloShortByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
 					}
+					entity := entities[i]
 					list[idx[i]] = entity
 				}
 				return nil
@@ -470,7 +608,13 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloWithErrorByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
 					list[idx[i]] = entity
 				}
 				return nil
@@ -505,10 +649,28 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
-					entity.World.Foo, err = ec.unmarshalNString2string(ctx, reps[i]["world"].(map[string]interface{})["foo"])
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiPlanetRequiresNestedByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
+					err := func() (err error) {
+						if m0, ok := reps[i]["world"].(map[string]interface{}); ok {
+							if entity.World == nil {
+								entity.World = new(model.World)
+							}
+							entity.World.Foo, err = ec.unmarshalNString2string(ctx, m0["foo"])
+							if err != nil {
+								return err
+							}
+						}
+						return nil
+					}()
 					if err != nil {
-						return err
+						entityError(idx[i], err)
+						continue
 					}
 					list[idx[i]] = entity
 				}
@@ -525,9 +687,14 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 
 	resolveEntityGroup := func(typeName string, reps []map[string]interface{}, idx []int) {
 		if isMulti(typeName) {
+			sem <- struct{}{}
+			defer func() { <-sem }()
 			err := resolveManyEntities(ctx, typeName, reps, idx)
 			if err != nil {
-				ec.Error(ctx, err)
+				// the whole group failed, every entity in it is null
+				for _, i := range idx {
+					entityError(i, err)
+				}
 			}
 		} else {
 			// if there are multiple entities to resolve, parallelize (similar to
@@ -536,7 +703,10 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			e.Add(len(reps))
 			for i, rep := range reps {
 				i, rep := i, rep
+				sem <- struct{}{}
 				go func(i int, rep map[string]interface{}) {
+					defer func() { <-sem }()
+					ctx := entityContext(idx[i])
 					err := resolveEntity(ctx, typeName, rep, idx, i)
 					if err != nil {
 						ec.Error(ctx, err)
@@ -571,6 +741,33 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 	}
 }
 
+func entityResolverNameForConcurrent(ctx context.Context, rep map[string]interface{}) (string, error) {
+	for {
+		var (
+			m   map[string]interface{}
+			val interface{}
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["id"]
+		if !ok {
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			break
+		}
+		return "findConcurrentByID", nil
+	}
+	return "", fmt.Errorf("%w for Concurrent", ErrTypeNotFound)
+}
+
 func entityResolverNameForHello(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
@@ -741,6 +938,33 @@ func entityResolverNameForMultiHelloRequires(ctx context.Context, rep map[string
 	return "", fmt.Errorf("%w for MultiHelloRequires", ErrTypeNotFound)
 }
 
+func entityResolverNameForMultiHelloShort(ctx context.Context, rep map[string]interface{}) (string, error) {
+	for {
+		var (
+			m   map[string]interface{}
+			val interface{}
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["name"]
+		if !ok {
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			break
+		}
+		return "findManyMultiHelloShortByNames", nil
+	}
+	return "", fmt.Errorf("%w for MultiHelloShort", ErrTypeNotFound)
+}
+
 func entityResolverNameForMultiHelloWithError(ctx context.Context, rep map[string]interface{}) (string, error) {
 	for {
 		var (
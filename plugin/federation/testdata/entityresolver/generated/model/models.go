@@ -2,6 +2,12 @@
 
 package model
 
+type Concurrent struct {
+	ID string `json:"id"`
+}
+
+func (Concurrent) IsEntity() {}
+
 type Hello struct {
 	Name      string `json:"name"`
 	Secondary string `json:"secondary"`
@@ -57,6 +63,16 @@ type MultiHelloRequiresByNamesInput struct {
 	Name string `json:"Name"`
 }
 
+type MultiHelloShort struct {
+	Name string `json:"name"`
+}
+
+func (MultiHelloShort) IsEntity() {}
+
+type MultiHelloShortByNamesInput struct {
+	Name string `json:"Name"`
+}
+
 type MultiHelloWithError struct {
 	Name string `json:"name"`
 }
@@ -97,9 +113,11 @@ type PlanetRequires struct {
 func (PlanetRequires) IsEntity() {}
 
 type PlanetRequiresNested struct {
-	Name  string `json:"name"`
-	World *World `json:"world"`
-	Size  int    `json:"size"`
+	Name   string   `json:"name"`
+	World  *World   `json:"world"`
+	Worlds []*World `json:"worlds,omitempty"`
+	Size   int      `json:"size"`
+	Sizes  []int    `json:"sizes,omitempty"`
 }
 
 func (PlanetRequiresNested) IsEntity() {}
@@ -4,7 +4,11 @@ package entityresolver
 //
 // It serves as dependency injection for your app, add any dependencies you require here.
 
-type Resolver struct{}
+type Resolver struct {
+	// ConcurrentMax is the highest number of Concurrent entities resolved at the same time
+	ConcurrentMax    int32
+	concurrentActive int32
+}
 
 // FindWorldWithMultipleKeysByHelloNameAndFooBarValue shows we hit the FindWorldWithMultipleKeysByHelloNameAndFoo resolver
 const FindWorldWithMultipleKeysByHelloNameAndFooBarValue = 99
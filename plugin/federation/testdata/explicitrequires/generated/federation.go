@@ -9,7 +9,9 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 var (
@@ -44,6 +46,23 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		r []map[string]interface{}
 	}{}
 
+	// entityContext scopes ctx to the representation at index i, so errors are reported against the entity they belong
+	// to and the router can null out just that entity while the rest of the batch resolves
+	entityContext := func(i int) context.Context {
+		return graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+	}
+	entityError := func(i int, err error) {
+		ctx := entityContext(i)
+		var gqlErr *gqlerror.Error
+		if errors.As(err, &gqlErr) {
+			// the same error may be reported for every entity in a group, each needs its own path
+			e := *gqlErr
+			e.Path = graphql.GetPath(ctx)
+			err = &e
+		}
+		ec.Error(ctx, err)
+	}
+
 	// We group entities by typename so that we can parallelize their resolution.
 	// This is particularly helpful when there are entity groups in multi mode.
 	buildRepresentationGroups := func(reps []map[string]interface{}) {
@@ -52,7 +71,7 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			if !ok {
 				// If there is no __typename, we just skip the representation;
 				// we just won't be resolving these unknown types.
-				ec.Error(ctx, errors.New("__typename must be an existing string"))
+				ec.Error(entityContext(i), errors.New("__typename must be an existing string"))
 				continue
 			}
 
@@ -348,7 +367,13 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
 					list[idx[i]] = entity
 				}
 				return nil
@@ -383,14 +408,27 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
-					entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
-					if err != nil {
-						return err
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloMultipleRequiresByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
 					}
-					entity.Key2, err = ec.unmarshalNString2string(ctx, reps[i]["key2"])
+					entity := entities[i]
+					err := func() (err error) {
+						entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
+						if err != nil {
+							return err
+						}
+						entity.Key2, err = ec.unmarshalNString2string(ctx, reps[i]["key2"])
+						if err != nil {
+							return err
+						}
+						return nil
+					}()
 					if err != nil {
-						return err
+						entityError(idx[i], err)
+						continue
 					}
 					list[idx[i]] = entity
 				}
@@ -426,10 +464,23 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
-					entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloRequiresByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
+					err := func() (err error) {
+						entity.Key1, err = ec.unmarshalNString2string(ctx, reps[i]["key1"])
+						if err != nil {
+							return err
+						}
+						return nil
+					}()
 					if err != nil {
-						return err
+						entityError(idx[i], err)
+						continue
 					}
 					list[idx[i]] = entity
 				}
@@ -465,7 +516,13 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiHelloWithErrorByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
 					list[idx[i]] = entity
 				}
 				return nil
@@ -500,10 +557,28 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 					return err
 				}
 
-				for i, entity := range entities {
-					entity.World.Foo, err = ec.unmarshalNString2string(ctx, reps[i]["world"].(map[string]interface{})["foo"])
+				for i := range reps {
+					if i >= len(entities) {
+						// the representations the resolver left out are null, the others are still resolved
+						entityError(idx[i], fmt.Errorf("FindManyMultiPlanetRequiresNestedByNames returned %d entities for %d representations", len(entities), len(reps)))
+						continue
+					}
+					entity := entities[i]
+					err := func() (err error) {
+						if m0, ok := reps[i]["world"].(map[string]interface{}); ok {
+							if entity.World == nil {
+								entity.World = new(World)
+							}
+							entity.World.Foo, err = ec.unmarshalNString2string(ctx, m0["foo"])
+							if err != nil {
+								return err
+							}
+						}
+						return nil
+					}()
 					if err != nil {
-						return err
+						entityError(idx[i], err)
+						continue
 					}
 					list[idx[i]] = entity
 				}
@@ -522,7 +597,10 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 		if isMulti(typeName) {
 			err := resolveManyEntities(ctx, typeName, reps, idx)
 			if err != nil {
-				ec.Error(ctx, err)
+				// the whole group failed, every entity in it is null
+				for _, i := range idx {
+					entityError(i, err)
+				}
 			}
 		} else {
 			// if there are multiple entities to resolve, parallelize (similar to
@@ -532,6 +610,7 @@ func (ec *executionContext) __resolve_entities(ctx context.Context, representati
 			for i, rep := range reps {
 				i, rep := i, rep
 				go func(i int, rep map[string]interface{}) {
+					ctx := entityContext(idx[i])
 					err := resolveEntity(ctx, typeName, rep, idx, i)
 					if err != nil {
 						ec.Error(ctx, err)
@@ -0,0 +1,295 @@
+// Package lint checks a schema against a configurable set of style rules. It runs as part of generate when the lint
+// section of the config is present, and standalone from the gqlgen lint command.
+package lint
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin"
+)
+
+const (
+	RuleTypeNames         = "type-names"
+	RuleFieldNames        = "field-names"
+	RuleDescriptions      = "descriptions"
+	RuleNullableListItems = "nullable-list-items"
+	RuleUnusedTypes       = "unused-types"
+	RuleUnusedModels      = "unused-models"
+	RuleUnusedAutobind    = "unused-autobind"
+	RuleInterfaceCoverage = "interface-coverage"
+)
+
+// Rules lists every rule name accepted in the config.
+var Rules = []string{
+	RuleTypeNames, RuleFieldNames, RuleDescriptions, RuleNullableListItems, RuleUnusedTypes,
+	RuleUnusedModels, RuleUnusedAutobind, RuleInterfaceCoverage,
+}
+
+// Issue is a single problem found in the schema.
+type Issue struct {
+	Rule     string
+	Severity config.LintSeverity
+	Message  string
+	Position *ast.Position
+}
+
+func (i Issue) String() string {
+	pos := ""
+	if i.Position != nil && i.Position.Src != nil {
+		pos = fmt.Sprintf("%s:%d: ", i.Position.Src.Name, i.Position.Line)
+	}
+	return fmt.Sprintf("%s%s: %s (%s)", pos, i.Severity, i.Message, i.Rule)
+}
+
+// Issues is the result of linting a schema, ordered by position.
+type Issues []Issue
+
+// Err returns an error summarising the issues reported as errors, or nil if there are none.
+func (issues Issues) Err() error {
+	count := 0
+	for _, i := range issues {
+		if i.Severity == config.LintError {
+			count++
+		}
+	}
+	if count == 0 {
+		return nil
+	}
+	return fmt.Errorf("schema has %d lint errors", count)
+}
+
+func New() plugin.Plugin {
+	return &Plugin{out: os.Stderr}
+}
+
+type Plugin struct {
+	out io.Writer
+}
+
+var _ plugin.ConfigMutator = &Plugin{}
+
+func (p *Plugin) Name() string {
+	return "lint"
+}
+
+// MutateConfig lints the loaded schema without modifying the config, it runs as a mutator because that is the first
+// hook with a fully loaded schema.
+func (p *Plugin) MutateConfig(cfg *config.Config) error {
+	if cfg.Lint.SkipOnGenerate {
+		return nil
+	}
+	issues, err := LintConfig(cfg)
+	if err != nil {
+		return err
+	}
+	for _, i := range issues {
+		fmt.Fprintln(p.out, i.String())
+	}
+	return issues.Err()
+}
+
+// LintConfig runs the schema rules along with the rules checking cfg for stale bindings.
+func LintConfig(cfg *config.Config) (Issues, error) {
+	issues, err := Lint(cfg.Schema, cfg.Lint)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &linter{schema: cfg.Schema, cfg: cfg.Lint}
+	for _, name := range cfg.UnusedModels() {
+		l.report(RuleUnusedModels, nil, "models entry %s does not match any schema type", name)
+	}
+	if cfg.Lint.Severity(RuleUnusedAutobind) != config.LintOff {
+		unused, err := cfg.UnusedAutobindStructs()
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range unused {
+			l.report(RuleUnusedAutobind, nil, "autobind struct %s does not match any schema type", name)
+		}
+	}
+	if cfg.Lint.Severity(RuleInterfaceCoverage) != config.LintOff {
+		mismatches, err := cfg.InterfaceCoverage()
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range mismatches {
+			l.report(RuleInterfaceCoverage, cfg.Schema.Types[m.Type].Position, "%s", m.Message)
+		}
+	}
+	return append(issues, l.issues...), nil
+}
+
+// Lint runs every enabled schema rule against schema.
+func Lint(schema *ast.Schema, cfg config.LintConfig) (Issues, error) {
+	for rule := range cfg.Rules {
+		if !isRule(rule) {
+			return nil, fmt.Errorf("unknown lint rule %s, expected one of %s", rule, strings.Join(Rules, ", "))
+		}
+	}
+
+	l := &linter{schema: schema, cfg: cfg}
+	l.typeNames()
+	l.fields()
+	l.unusedTypes()
+
+	sort.SliceStable(l.issues, func(a, b int) bool {
+		pa, pb := l.issues[a].Position, l.issues[b].Position
+		if pa == nil || pa.Src == nil {
+			return false
+		}
+		if pb == nil || pb.Src == nil {
+			return true
+		}
+		if pa.Src.Name != pb.Src.Name {
+			return pa.Src.Name < pb.Src.Name
+		}
+		return pa.Line < pb.Line
+	})
+	return l.issues, nil
+}
+
+func isRule(name string) bool {
+	for _, r := range Rules {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+type linter struct {
+	schema *ast.Schema
+	cfg    config.LintConfig
+	issues Issues
+}
+
+func (l *linter) report(rule string, pos *ast.Position, format string, args ...interface{}) {
+	severity := l.cfg.Severity(rule)
+	if severity == config.LintOff {
+		return
+	}
+	l.issues = append(l.issues, Issue{
+		Rule:     rule,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+		Position: pos,
+	})
+}
+
+// userTypes returns the types declared in the users schema, skipping builtins and anything injected by plugins.
+func (l *linter) userTypes() []*ast.Definition {
+	var defs []*ast.Definition
+	for _, def := range l.schema.Types {
+		if def.BuiltIn || def.Position == nil || def.Position.Src == nil || def.Position.Src.BuiltIn {
+			continue
+		}
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Name < defs[j].Name })
+	return defs
+}
+
+func (l *linter) typeNames() {
+	for _, def := range l.userTypes() {
+		if !isPascalCase(def.Name) {
+			l.report(RuleTypeNames, def.Position, "type %s should be PascalCase", def.Name)
+		}
+	}
+}
+
+func (l *linter) fields() {
+	for _, def := range l.userTypes() {
+		for _, f := range def.Fields {
+			if strings.HasPrefix(f.Name, "__") || (f.Position != nil && f.Position.Src != nil && f.Position.Src.BuiltIn) {
+				continue
+			}
+			if !isCamelCase(f.Name) {
+				l.report(RuleFieldNames, f.Position, "field %s.%s should be camelCase", def.Name, f.Name)
+			}
+			for _, arg := range f.Arguments {
+				if !isCamelCase(arg.Name) {
+					l.report(RuleFieldNames, arg.Position, "argument %s.%s(%s) should be camelCase", def.Name, f.Name, arg.Name)
+				}
+				l.nullableListItems(arg.Type, arg.Position, "argument %s.%s(%s)", def.Name, f.Name, arg.Name)
+			}
+			if def.Kind != ast.InputObject && !strings.HasPrefix(f.Name, "_") && strings.TrimSpace(f.Description) == "" {
+				l.report(RuleDescriptions, f.Position, "field %s.%s has no description", def.Name, f.Name)
+			}
+			l.nullableListItems(f.Type, f.Position, "field %s.%s", def.Name, f.Name)
+		}
+	}
+}
+
+func (l *linter) nullableListItems(t *ast.Type, pos *ast.Position, format string, args ...interface{}) {
+	for ; t != nil && t.Elem != nil; t = t.Elem {
+		if !t.NonNull && !t.Elem.NonNull {
+			l.report(RuleNullableListItems, pos, format+" is a nullable list of nullable items", args...)
+			return
+		}
+	}
+}
+
+// unusedTypes reports types that can't be reached from any operation type or directive argument.
+func (l *linter) unusedTypes() {
+	used := map[string]bool{}
+	var visit func(name string)
+	visit = func(name string) {
+		def := l.schema.Types[name]
+		if def == nil || used[name] {
+			return
+		}
+		used[name] = true
+		for _, f := range def.Fields {
+			visit(f.Type.Name())
+			for _, arg := range f.Arguments {
+				visit(arg.Type.Name())
+			}
+		}
+		for _, i := range def.Interfaces {
+			visit(i)
+		}
+		for _, t := range def.Types {
+			visit(t)
+		}
+		if def.Kind == ast.Interface {
+			for _, impl := range l.schema.GetPossibleTypes(def) {
+				visit(impl.Name)
+			}
+		}
+	}
+
+	for _, root := range []*ast.Definition{l.schema.Query, l.schema.Mutation, l.schema.Subscription} {
+		if root != nil {
+			visit(root.Name)
+		}
+	}
+	for _, d := range l.schema.Directives {
+		for _, arg := range d.Arguments {
+			visit(arg.Type.Name())
+		}
+	}
+
+	for _, def := range l.userTypes() {
+		if !used[def.Name] {
+			l.report(RuleUnusedTypes, def.Position, "type %s is not reachable from any operation", def.Name)
+		}
+	}
+}
+
+func isPascalCase(name string) bool {
+	return name != "" && unicode.IsUpper(rune(name[0])) && !strings.Contains(name, "_")
+}
+
+func isCamelCase(name string) bool {
+	name = strings.TrimLeft(name, "_")
+	return name != "" && unicode.IsLower(rune(name[0])) && !strings.Contains(name, "_")
+}
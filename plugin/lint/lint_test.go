@@ -0,0 +1,100 @@
+package lint
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func loadSchema(t *testing.T) *ast.Schema {
+	b, err := os.ReadFile("testdata/schema.graphql")
+	require.NoError(t, err)
+	return gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: string(b)})
+}
+
+func TestLint(t *testing.T) {
+	issues, err := Lint(loadSchema(t), config.LintConfig{})
+	require.NoError(t, err)
+
+	var lines []string
+	for _, i := range issues {
+		lines = append(lines, i.String())
+	}
+	require.Equal(t, []string{
+		"schema.graphql:4: warn: field Query.search is a nullable list of nullable items (nullable-list-items)",
+		"schema.graphql:5: warn: argument Query.search(by_name) should be camelCase (field-names)",
+		"schema.graphql:6: warn: field Query.undocumented has no description (descriptions)",
+		"schema.graphql:12: warn: field User.first_name should be camelCase (field-names)",
+		"schema.graphql:16: warn: type unused_type should be PascalCase (type-names)",
+		"schema.graphql:16: warn: type unused_type is not reachable from any operation (unused-types)",
+	}, lines)
+	require.NoError(t, issues.Err())
+}
+
+func TestLintSeverity(t *testing.T) {
+	issues, err := Lint(loadSchema(t), config.LintConfig{Rules: map[string]config.LintSeverity{
+		RuleFieldNames:        config.LintError,
+		RuleDescriptions:      config.LintOff,
+		RuleNullableListItems: config.LintOff,
+		RuleUnusedTypes:       config.LintOff,
+		RuleTypeNames:         config.LintOff,
+	}})
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	require.EqualError(t, issues.Err(), "schema has 2 lint errors")
+
+	_, err = Lint(loadSchema(t), config.LintConfig{Rules: map[string]config.LintSeverity{"nope": config.LintWarn}})
+	require.EqualError(t, err, "unknown lint rule nope, expected one of type-names, field-names, descriptions, nullable-list-items, unused-types, unused-models, unused-autobind, interface-coverage")
+}
+
+func TestPlugin(t *testing.T) {
+	var out bytes.Buffer
+	p := &Plugin{out: &out}
+
+	cfg := &config.Config{Schema: loadSchema(t), Lint: config.LintConfig{Rules: map[string]config.LintSeverity{
+		RuleUnusedTypes: config.LintError,
+	}}}
+	require.EqualError(t, p.MutateConfig(cfg), "schema has 1 lint errors")
+	require.Contains(t, out.String(), "schema.graphql:16: error: type unused_type is not reachable from any operation (unused-types)\n")
+
+	out.Reset()
+	cfg.Lint.SkipOnGenerate = true
+	require.NoError(t, p.MutateConfig(cfg))
+	require.Empty(t, out.String())
+}
+
+func TestInterfaceCoverage(t *testing.T) {
+	const pkg = "github.com/99designs/gqlgen/codegen/config/testdata/coverage"
+	cfg := &config.Config{
+		Models: config.TypeMap{
+			"Node": {Model: config.StringList{pkg + ".Node"}},
+			"User": {Model: config.StringList{pkg + ".User"}},
+			"Post": {Model: config.StringList{pkg + ".Post"}},
+		},
+		Lint: config.LintConfig{Rules: map[string]config.LintSeverity{
+			RuleDescriptions:      config.LintOff,
+			RuleInterfaceCoverage: config.LintError,
+		}},
+	}
+	cfg.Schema = gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: `type Query { node: Node }
+interface Node { id: ID }
+type User implements Node { id: ID }
+type Post implements Node { id: ID }
+`})
+
+	issues, err := LintConfig(cfg)
+	require.NoError(t, err)
+	var lines []string
+	for _, i := range issues {
+		lines = append(lines, i.String())
+	}
+	require.Equal(t, []string{
+		"schema.graphql:2: error: " + pkg + ".Comment implements " + pkg + ".Node, but is not the model of a possible type of Node (interface-coverage)",
+	}, lines)
+}
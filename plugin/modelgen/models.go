@@ -194,7 +194,10 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 
 			b.Enums = append(b.Enums, it)
 		case ast.Scalar:
-			b.Scalars = append(b.Scalars, schemaType.Name)
+			// scalars with a map_value are bound to maps by the binder, they need no model
+			if cfg.Models[schemaType.Name].MapValue == "" {
+				b.Scalars = append(b.Scalars, schemaType.Name)
+			}
 		}
 	}
 	sort.Slice(b.Enums, func(i, j int) bool { return b.Enums[i].Name < b.Enums[j].Name })
@@ -319,7 +322,9 @@ func (m *Plugin) MutateConfig(cfg *config.Config) error {
 		Filename:        cfg.Model.Filename,
 		Data:            b,
 		GeneratedHeader: true,
+		Provenance:      cfg.Provenance(),
 		Packages:        cfg.Packages,
+		Format:          cfg.Format.Options(),
 		Template:        newModelTemplate,
 		Funcs:           funcMap,
 	})
@@ -341,52 +346,10 @@ func (m *Plugin) generateFields(cfg *config.Config, schemaType *ast.Definition)
 	var omittableType types.Type
 
 	for _, field := range schemaType.Fields {
-		var typ types.Type
 		fieldDef := cfg.Schema.Types[field.Type.Name()]
-
-		if cfg.Models.UserDefined(field.Type.Name()) {
-			var err error
-			typ, err = binder.FindTypeFromName(cfg.Models[field.Type.Name()].Model[0])
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			switch fieldDef.Kind {
-			case ast.Scalar:
-				// no user defined model, referencing a default scalar
-				typ = types.NewNamed(
-					types.NewTypeName(0, cfg.Model.Pkg(), "string", nil),
-					nil,
-					nil,
-				)
-
-			case ast.Interface, ast.Union:
-				// no user defined model, referencing a generated interface type
-				typ = types.NewNamed(
-					types.NewTypeName(0, cfg.Model.Pkg(), templates.ToGo(field.Type.Name()), nil),
-					types.NewInterfaceType([]*types.Func{}, []types.Type{}),
-					nil,
-				)
-
-			case ast.Enum:
-				// no user defined model, must reference a generated enum
-				typ = types.NewNamed(
-					types.NewTypeName(0, cfg.Model.Pkg(), templates.ToGo(field.Type.Name()), nil),
-					nil,
-					nil,
-				)
-
-			case ast.Object, ast.InputObject:
-				// no user defined model, must reference a generated struct
-				typ = types.NewNamed(
-					types.NewTypeName(0, cfg.Model.Pkg(), templates.ToGo(field.Type.Name()), nil),
-					types.NewStruct(nil, nil),
-					nil,
-				)
-
-			default:
-				panic(fmt.Errorf("unknown ast type %s", fieldDef.Kind))
-			}
+		typ, err := fieldType(cfg, binder, fieldDef)
+		if err != nil {
+			return nil, err
 		}
 
 		name := templates.ToGo(field.Name)
@@ -478,6 +441,63 @@ func (m *Plugin) generateFields(cfg *config.Config, schemaType *ast.Definition)
 	return fields, nil
 }
 
+// fieldType returns the go type of fields of the schema type def, before the modifiers of the field are applied.
+func fieldType(cfg *config.Config, binder *config.Binder, def *ast.Definition) (types.Type, error) {
+	if cfg.Models.UserDefined(def.Name) {
+		return binder.FindTypeFromName(cfg.Models[def.Name].Model[0])
+	}
+
+	switch def.Kind {
+	case ast.Scalar:
+		if valueType := cfg.Models[def.Name].MapValueType(); valueType != nil {
+			// a scalar bound to map[string]T
+			valueDef, ok := cfg.Schema.Types[valueType.Name()]
+			if !ok {
+				return nil, fmt.Errorf("%s: map_value %s was not found", def.Name, valueType.Name())
+			}
+			value, err := fieldType(cfg, binder, valueDef)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewMap(types.Typ[types.String], binder.CopyModifiersFromAst(valueType, value)), nil
+		}
+
+		// no user defined model, referencing a default scalar
+		return types.NewNamed(
+			types.NewTypeName(0, cfg.Model.Pkg(), "string", nil),
+			nil,
+			nil,
+		), nil
+
+	case ast.Interface, ast.Union:
+		// no user defined model, referencing a generated interface type
+		return types.NewNamed(
+			types.NewTypeName(0, cfg.Model.Pkg(), templates.ToGo(def.Name), nil),
+			types.NewInterfaceType([]*types.Func{}, []types.Type{}),
+			nil,
+		), nil
+
+	case ast.Enum:
+		// no user defined model, must reference a generated enum
+		return types.NewNamed(
+			types.NewTypeName(0, cfg.Model.Pkg(), templates.ToGo(def.Name), nil),
+			nil,
+			nil,
+		), nil
+
+	case ast.Object, ast.InputObject:
+		// no user defined model, must reference a generated struct
+		return types.NewNamed(
+			types.NewTypeName(0, cfg.Model.Pkg(), templates.ToGo(def.Name), nil),
+			types.NewStruct(nil, nil),
+			nil,
+		), nil
+
+	default:
+		panic(fmt.Errorf("unknown ast type %s", def.Kind))
+	}
+}
+
 func getStructTagFromField(cfg *config.Config, field *ast.FieldDefinition) string {
 	if !field.Type.NonNull && (cfg.EnableModelJsonOmitemptyTag == nil || *cfg.EnableModelJsonOmitemptyTag) {
 		return `json:"` + field.Name + `,omitempty"`
@@ -21,6 +21,12 @@ type CodeGenerator interface {
 	GenerateCode(cfg *codegen.Data) error
 }
 
+// FileGenerator adds files to the generated code. It is called with the fully built data after the CodeGenerator
+// plugins, and the files it returns are rendered like the files gqlgen generates, see codegen.File.
+type FileGenerator interface {
+	GenerateFiles(data *codegen.Data) ([]codegen.File, error)
+}
+
 // EarlySourceInjector is used to inject things that are required for user schema files to compile.
 type EarlySourceInjector interface {
 	InjectSourceEarly() *ast.Source
@@ -8,6 +8,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"golang.org/x/text/cases"
@@ -59,12 +60,14 @@ func (m *Plugin) generateSingleFile(data *codegen.Data) error {
 		return nil
 	}
 
-	for _, o := range data.Objects {
+	objects := append(append(codegen.Objects{}, data.Objects...), data.InterfaceResolvers()...)
+
+	for _, o := range objects {
 		if o.HasResolvers() {
 			file.Objects = append(file.Objects, o)
 		}
 		for _, f := range o.Fields {
-			if !f.IsResolver {
+			if !f.IsResolver || f.ResolvedOn != nil {
 				continue
 			}
 
@@ -92,7 +95,9 @@ func (m *Plugin) generateSingleFile(data *codegen.Data) error {
 		Filename:    data.Config.Resolver.Filename,
 		Data:        resolverBuild,
 		Packages:    data.Config.Packages,
+		Format:      data.Config.Format.Options(),
 		Template:    newResolverTemplate,
+		Overrides:   data.Config.Resolver.TemplateOverrides,
 	})
 }
 
@@ -103,14 +108,17 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 	}
 
 	files := map[string]*File{}
+	schemaRoot := commonSchemaDir(data.Config.SchemaFilename)
 
-	objects := make(codegen.Objects, len(data.Objects)+len(data.Inputs))
+	interfaces := data.InterfaceResolvers()
+	objects := make(codegen.Objects, len(data.Objects)+len(data.Inputs)+len(interfaces))
 	copy(objects, data.Objects)
 	copy(objects[len(data.Objects):], data.Inputs)
+	copy(objects[len(data.Objects)+len(data.Inputs):], interfaces)
 
 	for _, o := range objects {
 		if o.HasResolvers() {
-			fnCase := gqlToResolverName(data.Config.Resolver.Dir(), o.Position.Src.Name, data.Config.Resolver.FilenameTemplate)
+			fnCase := gqlToResolverName(data.Config.Resolver.Dir(), schemaRoot, o.Position.Src.Name, data.Config.Resolver.FilenameTemplate)
 			fn := strings.ToLower(fnCase)
 			if files[fn] == nil {
 				files[fn] = &File{
@@ -124,7 +132,7 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 			files[fn].Objects = append(files[fn].Objects, o)
 		}
 		for _, f := range o.Fields {
-			if !f.IsResolver {
+			if !f.IsResolver || f.ResolvedOn != nil {
 				continue
 			}
 			structName := templates.LcFirst(o.Name) + templates.UcFirst(data.Config.Resolver.Type)
@@ -143,7 +151,7 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 				implExists = true
 				resolver.ImplementationRender = rImpl.Implement
 			}
-			fnCase := gqlToResolverName(data.Config.Resolver.Dir(), f.Position.Src.Name, data.Config.Resolver.FilenameTemplate)
+			fnCase := gqlToResolverName(data.Config.Resolver.Dir(), schemaRoot, f.Position.Src.Name, data.Config.Resolver.FilenameTemplate)
 			fn := strings.ToLower(fnCase)
 			if files[fn] == nil {
 				files[fn] = &File{
@@ -164,7 +172,15 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 		newResolverTemplate = readResolverTemplate(data.Config.Resolver.ResolverTemplate)
 	}
 
-	for _, file := range files {
+	names := make([]string, 0, len(files))
+	for fn := range files {
+		names = append(names, fn)
+	}
+	sort.Strings(names)
+
+	opts := make([]templates.Options, 0, len(files))
+	for _, fn := range names {
+		file := files[fn]
 		resolverBuild := &ResolverBuild{
 			File:                file,
 			PackageName:         data.Config.Resolver.Package,
@@ -185,17 +201,19 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 			}
 		}
 
-		err := templates.Render(templates.Options{
+		opts = append(opts, templates.Options{
 			PackageName: data.Config.Resolver.Package,
 			FileNotice:  fileNotice.String(),
 			Filename:    file.name,
 			Data:        resolverBuild,
 			Packages:    data.Config.Packages,
+			Format:      data.Config.Format.Options(),
 			Template:    newResolverTemplate,
+			Overrides:   data.Config.Resolver.TemplateOverrides,
 		})
-		if err != nil {
-			return err
-		}
+	}
+	if err := templates.RenderAll(opts); err != nil {
+		return err
 	}
 
 	if _, err := os.Stat(data.Config.Resolver.Filename); errors.Is(err, fs.ErrNotExist) {
@@ -209,6 +227,7 @@ func (m *Plugin) generatePerSchema(data *codegen.Data) error {
 			Filename: data.Config.Resolver.Filename,
 			Data:     data.Config.Resolver.Type,
 			Packages: data.Config.Packages,
+			Format:   data.Config.Format.Options(),
 		})
 		if err != nil {
 			return err
@@ -269,16 +288,47 @@ func (r *Resolver) Implementation() string {
 	return r.ImplementationStr
 }
 
-func gqlToResolverName(base string, gqlname, filenameTmpl string) string {
+// gqlToResolverName returns the resolver file for the schema file gqlname. {name} is replaced by the schema file name,
+// and {path} by its path below root joined with dots, so types extended from schema files in different directories
+// get their resolvers in different files even when the schema files share a name.
+func gqlToResolverName(base string, root string, gqlname, filenameTmpl string) string {
+	path := strings.TrimSuffix(gqlname, filepath.Ext(gqlname))
+	if rel, err := filepath.Rel(root, path); err == nil && !strings.HasPrefix(rel, "..") {
+		path = rel
+	} else {
+		// sources injected by plugins live outside the schema directories
+		path = filepath.Base(path)
+	}
+	path = strings.ReplaceAll(filepath.ToSlash(path), "/", ".")
+
 	gqlname = filepath.Base(gqlname)
 	ext := filepath.Ext(gqlname)
 	if filenameTmpl == "" {
 		filenameTmpl = "{name}.resolvers.go"
 	}
 	filename := strings.ReplaceAll(filenameTmpl, "{name}", strings.TrimSuffix(gqlname, ext))
+	filename = strings.ReplaceAll(filename, "{path}", path)
 	return filepath.Join(base, filename)
 }
 
+// commonSchemaDir returns the deepest directory containing every schema file.
+func commonSchemaDir(filenames []string) string {
+	var root []string
+	for i, filename := range filenames {
+		dir := strings.Split(filepath.ToSlash(filepath.Dir(filename)), "/")
+		if i == 0 {
+			root = dir
+			continue
+		}
+		n := 0
+		for n < len(root) && n < len(dir) && root[n] == dir[n] {
+			n++
+		}
+		root = root[:n]
+	}
+	return strings.Join(root, "/")
+}
+
 func readResolverTemplate(customResolverTemplate string) string {
 	contentBytes, err := os.ReadFile(customResolverTemplate)
 	if err != nil {
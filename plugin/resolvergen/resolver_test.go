@@ -56,6 +56,28 @@ func TestLayoutFollowSchemaWithCustomFilename(t *testing.T) {
 	require.Contains(t, source, "// AUserHelperFunction implementation")
 }
 
+func TestLayoutFollowSchemaWithPathTemplate(t *testing.T) {
+	testFollowSchemaPersistence(t, "testdata/pathtemplate")
+
+	b, err := os.ReadFile("testdata/pathtemplate/out/users.schema.resolvers.go")
+	require.NoError(t, err)
+	require.Contains(t, string(b), "func (r *queryResolver) Users(")
+	require.Contains(t, string(b), "func (r *Resolver) Query() QueryResolver")
+
+	b, err = os.ReadFile("testdata/pathtemplate/out/orders.schema.resolvers.go")
+	require.NoError(t, err)
+	require.Contains(t, string(b), "func (r *queryResolver) Orders(")
+	require.NotContains(t, string(b), "func (r *Resolver) Query() QueryResolver")
+}
+
+func TestGqlToResolverName(t *testing.T) {
+	require.Equal(t, "out/schema.resolvers.go", gqlToResolverName("out", "graph", "graph/users/schema.graphqls", ""))
+	require.Equal(t, "out/users.schema.resolvers.go", gqlToResolverName("out", "graph", "graph/users/schema.graphqls", "{path}.resolvers.go"))
+	require.Equal(t, "out/schema.resolvers.go", gqlToResolverName("out", "graph", "graph/schema.graphqls", "{path}.resolvers.go"))
+	require.Equal(t, "out/entity.resolvers.go", gqlToResolverName("out", "graph", "federation/entity.graphql", "{path}.resolvers.go"))
+	require.Equal(t, "graph", commonSchemaDir([]string{"graph/users/schema.graphqls", "graph/orders/schema.graphqls", "graph/schema.graphqls"}))
+}
+
 func TestLayoutInvalidModelPath(t *testing.T) {
 	cfg, err := config.LoadConfig("testdata/invalid_model_path/gqlgen.yml")
 	require.NoError(t, err)
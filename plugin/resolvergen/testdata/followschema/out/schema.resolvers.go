@@ -2,7 +2,7 @@ package customresolver
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.44-dev
+// Code generated by github.com/99designs/gqlgen version v0.17.47-dev
 
 import (
 	"context"
@@ -30,10 +30,8 @@ func (r *CustomResolverType) Resolver() customresolver.ResolverResolver {
 	return &resolverCustomResolverType{r}
 }
 
-type (
-	queryCustomResolverType    struct{ *CustomResolverType }
-	resolverCustomResolverType struct{ *CustomResolverType }
-)
+type queryCustomResolverType struct{ *CustomResolverType }
+type resolverCustomResolverType struct{ *CustomResolverType }
 
 // !!! WARNING !!!
 // The code below was going to be deleted when updating resolvers. It has been copied here so you have
@@ -0,0 +1,8 @@
+package out
+
+import "context"
+
+type QueryResolver interface {
+	Users(ctx context.Context) ([]string, error)
+	Orders(ctx context.Context) ([]string, error)
+}
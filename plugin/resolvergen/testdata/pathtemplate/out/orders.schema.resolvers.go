@@ -0,0 +1,15 @@
+package out
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.47-dev
+
+import (
+	"context"
+	"fmt"
+)
+
+// Orders is the resolver for the orders field.
+func (r *queryResolver) Orders(ctx context.Context) ([]string, error) {
+	panic(fmt.Errorf("not implemented: Orders - orders"))
+}
@@ -0,0 +1,20 @@
+package out
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.47-dev
+
+import (
+	"context"
+	"fmt"
+)
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context) ([]string, error) {
+	panic(fmt.Errorf("not implemented: Users - users"))
+}
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type queryResolver struct{ *Resolver }
@@ -41,6 +41,7 @@ func (m *Plugin) GenerateCode(data *codegen.Data) error {
 			Filename:    m.filename,
 			Data:        serverBuild,
 			Packages:    data.Config.Packages,
+			Format:      data.Config.Format.Options(),
 			Template:    serverTemplate,
 		})
 	}
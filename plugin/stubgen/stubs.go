@@ -53,7 +53,9 @@ func (m *Plugin) GenerateCode(data *codegen.Data) error {
 			TypeName: m.typeName,
 		},
 		GeneratedHeader: true,
+		Provenance:      data.Config.Provenance(),
 		Packages:        data.Config.Packages,
+		Format:          data.Config.Format.Options(),
 		Template:        stubsTemplate,
 	})
 }
@@ -63,3 +65,8 @@ type ResolverBuild struct {
 
 	TypeName string
 }
+
+// ResolverObjects returns the objects and the interfaces with fields resolved once for all their implementors.
+func (b *ResolverBuild) ResolverObjects() codegen.Objects {
+	return append(append(codegen.Objects{}, b.Objects...), b.InterfaceResolvers()...)
+}